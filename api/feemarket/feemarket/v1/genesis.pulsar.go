@@ -2,6 +2,8 @@
 package feemarketv1
 
 import (
+	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -14,10 +16,169 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_GenesisState_4_list)(nil)
+
+type _GenesisState_4_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_GenesisState_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_4_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_4_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_5_list)(nil)
+
+type _GenesisState_5_list struct {
+	list *[]*MsgTypeMultiplier
+}
+
+func (x *_GenesisState_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgTypeMultiplier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*MsgTypeMultiplier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_5_list) AppendMutable() protoreflect.Value {
+	v := new(MsgTypeMultiplier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_5_list) NewElement() protoreflect.Value {
+	v := new(MsgTypeMultiplier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_5_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_6_list)(nil)
+
+type _GenesisState_6_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_GenesisState_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_6_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_6_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_6_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_GenesisState        protoreflect.MessageDescriptor
-	fd_GenesisState_params protoreflect.FieldDescriptor
-	fd_GenesisState_state  protoreflect.FieldDescriptor
+	md_GenesisState                              protoreflect.MessageDescriptor
+	fd_GenesisState_params                       protoreflect.FieldDescriptor
+	fd_GenesisState_state                        protoreflect.FieldDescriptor
+	fd_GenesisState_enabled_height               protoreflect.FieldDescriptor
+	fd_GenesisState_denom_min_gas_prices         protoreflect.FieldDescriptor
+	fd_GenesisState_msg_type_multipliers         protoreflect.FieldDescriptor
+	fd_GenesisState_accumulated_fees             protoreflect.FieldDescriptor
+	fd_GenesisState_community_pool_contributions protoreflect.FieldDescriptor
+	fd_GenesisState_frozen_height                protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -25,6 +186,12 @@ func init() {
 	md_GenesisState = File_feemarket_feemarket_v1_genesis_proto.Messages().ByName("GenesisState")
 	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
 	fd_GenesisState_state = md_GenesisState.Fields().ByName("state")
+	fd_GenesisState_enabled_height = md_GenesisState.Fields().ByName("enabled_height")
+	fd_GenesisState_denom_min_gas_prices = md_GenesisState.Fields().ByName("denom_min_gas_prices")
+	fd_GenesisState_msg_type_multipliers = md_GenesisState.Fields().ByName("msg_type_multipliers")
+	fd_GenesisState_accumulated_fees = md_GenesisState.Fields().ByName("accumulated_fees")
+	fd_GenesisState_community_pool_contributions = md_GenesisState.Fields().ByName("community_pool_contributions")
+	fd_GenesisState_frozen_height = md_GenesisState.Fields().ByName("frozen_height")
 }
 
 var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
@@ -104,6 +271,42 @@ func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor,
 			return
 		}
 	}
+	if x.EnabledHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.EnabledHeight)
+		if !f(fd_GenesisState_enabled_height, value) {
+			return
+		}
+	}
+	if len(x.DenomMinGasPrices) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.DenomMinGasPrices})
+		if !f(fd_GenesisState_denom_min_gas_prices, value) {
+			return
+		}
+	}
+	if len(x.MsgTypeMultipliers) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.MsgTypeMultipliers})
+		if !f(fd_GenesisState_msg_type_multipliers, value) {
+			return
+		}
+	}
+	if len(x.AccumulatedFees) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.AccumulatedFees})
+		if !f(fd_GenesisState_accumulated_fees, value) {
+			return
+		}
+	}
+	if x.CommunityPoolContributions != nil {
+		value := protoreflect.ValueOfMessage(x.CommunityPoolContributions.ProtoReflect())
+		if !f(fd_GenesisState_community_pool_contributions, value) {
+			return
+		}
+	}
+	if x.FrozenHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.FrozenHeight)
+		if !f(fd_GenesisState_frozen_height, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -123,6 +326,18 @@ func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool
 		return x.Params != nil
 	case "feemarket.feemarket.v1.GenesisState.state":
 		return x.State != nil
+	case "feemarket.feemarket.v1.GenesisState.enabled_height":
+		return x.EnabledHeight != int64(0)
+	case "feemarket.feemarket.v1.GenesisState.denom_min_gas_prices":
+		return len(x.DenomMinGasPrices) != 0
+	case "feemarket.feemarket.v1.GenesisState.msg_type_multipliers":
+		return len(x.MsgTypeMultipliers) != 0
+	case "feemarket.feemarket.v1.GenesisState.accumulated_fees":
+		return len(x.AccumulatedFees) != 0
+	case "feemarket.feemarket.v1.GenesisState.community_pool_contributions":
+		return x.CommunityPoolContributions != nil
+	case "feemarket.feemarket.v1.GenesisState.frozen_height":
+		return x.FrozenHeight != int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.GenesisState"))
@@ -143,6 +358,18 @@ func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
 		x.Params = nil
 	case "feemarket.feemarket.v1.GenesisState.state":
 		x.State = nil
+	case "feemarket.feemarket.v1.GenesisState.enabled_height":
+		x.EnabledHeight = int64(0)
+	case "feemarket.feemarket.v1.GenesisState.denom_min_gas_prices":
+		x.DenomMinGasPrices = nil
+	case "feemarket.feemarket.v1.GenesisState.msg_type_multipliers":
+		x.MsgTypeMultipliers = nil
+	case "feemarket.feemarket.v1.GenesisState.accumulated_fees":
+		x.AccumulatedFees = nil
+	case "feemarket.feemarket.v1.GenesisState.community_pool_contributions":
+		x.CommunityPoolContributions = nil
+	case "feemarket.feemarket.v1.GenesisState.frozen_height":
+		x.FrozenHeight = int64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.GenesisState"))
@@ -165,6 +392,33 @@ func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescripto
 	case "feemarket.feemarket.v1.GenesisState.state":
 		value := x.State
 		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "feemarket.feemarket.v1.GenesisState.enabled_height":
+		value := x.EnabledHeight
+		return protoreflect.ValueOfInt64(value)
+	case "feemarket.feemarket.v1.GenesisState.denom_min_gas_prices":
+		if len(x.DenomMinGasPrices) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.DenomMinGasPrices}
+		return protoreflect.ValueOfList(listValue)
+	case "feemarket.feemarket.v1.GenesisState.msg_type_multipliers":
+		if len(x.MsgTypeMultipliers) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.MsgTypeMultipliers}
+		return protoreflect.ValueOfList(listValue)
+	case "feemarket.feemarket.v1.GenesisState.accumulated_fees":
+		if len(x.AccumulatedFees) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.AccumulatedFees}
+		return protoreflect.ValueOfList(listValue)
+	case "feemarket.feemarket.v1.GenesisState.community_pool_contributions":
+		value := x.CommunityPoolContributions
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "feemarket.feemarket.v1.GenesisState.frozen_height":
+		value := x.FrozenHeight
+		return protoreflect.ValueOfInt64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.GenesisState"))
@@ -189,6 +443,24 @@ func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value
 		x.Params = value.Message().Interface().(*Params)
 	case "feemarket.feemarket.v1.GenesisState.state":
 		x.State = value.Message().Interface().(*State)
+	case "feemarket.feemarket.v1.GenesisState.enabled_height":
+		x.EnabledHeight = value.Int()
+	case "feemarket.feemarket.v1.GenesisState.denom_min_gas_prices":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.DenomMinGasPrices = *clv.list
+	case "feemarket.feemarket.v1.GenesisState.msg_type_multipliers":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.MsgTypeMultipliers = *clv.list
+	case "feemarket.feemarket.v1.GenesisState.accumulated_fees":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.AccumulatedFees = *clv.list
+	case "feemarket.feemarket.v1.GenesisState.community_pool_contributions":
+		x.CommunityPoolContributions = value.Message().Interface().(*CommunityPoolContributions)
+	case "feemarket.feemarket.v1.GenesisState.frozen_height":
+		x.FrozenHeight = value.Int()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.GenesisState"))
@@ -219,6 +491,33 @@ func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) p
 			x.State = new(State)
 		}
 		return protoreflect.ValueOfMessage(x.State.ProtoReflect())
+	case "feemarket.feemarket.v1.GenesisState.denom_min_gas_prices":
+		if x.DenomMinGasPrices == nil {
+			x.DenomMinGasPrices = []*v1beta1.DecCoin{}
+		}
+		value := &_GenesisState_4_list{list: &x.DenomMinGasPrices}
+		return protoreflect.ValueOfList(value)
+	case "feemarket.feemarket.v1.GenesisState.msg_type_multipliers":
+		if x.MsgTypeMultipliers == nil {
+			x.MsgTypeMultipliers = []*MsgTypeMultiplier{}
+		}
+		value := &_GenesisState_5_list{list: &x.MsgTypeMultipliers}
+		return protoreflect.ValueOfList(value)
+	case "feemarket.feemarket.v1.GenesisState.accumulated_fees":
+		if x.AccumulatedFees == nil {
+			x.AccumulatedFees = []*v1beta1.Coin{}
+		}
+		value := &_GenesisState_6_list{list: &x.AccumulatedFees}
+		return protoreflect.ValueOfList(value)
+	case "feemarket.feemarket.v1.GenesisState.community_pool_contributions":
+		if x.CommunityPoolContributions == nil {
+			x.CommunityPoolContributions = new(CommunityPoolContributions)
+		}
+		return protoreflect.ValueOfMessage(x.CommunityPoolContributions.ProtoReflect())
+	case "feemarket.feemarket.v1.GenesisState.enabled_height":
+		panic(fmt.Errorf("field enabled_height of message feemarket.feemarket.v1.GenesisState is not mutable"))
+	case "feemarket.feemarket.v1.GenesisState.frozen_height":
+		panic(fmt.Errorf("field frozen_height of message feemarket.feemarket.v1.GenesisState is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.GenesisState"))
@@ -238,6 +537,22 @@ func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor)
 	case "feemarket.feemarket.v1.GenesisState.state":
 		m := new(State)
 		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "feemarket.feemarket.v1.GenesisState.enabled_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "feemarket.feemarket.v1.GenesisState.denom_min_gas_prices":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "feemarket.feemarket.v1.GenesisState.msg_type_multipliers":
+		list := []*MsgTypeMultiplier{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "feemarket.feemarket.v1.GenesisState.accumulated_fees":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "feemarket.feemarket.v1.GenesisState.community_pool_contributions":
+		m := new(CommunityPoolContributions)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "feemarket.feemarket.v1.GenesisState.frozen_height":
+		return protoreflect.ValueOfInt64(int64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.GenesisState"))
@@ -315,6 +630,34 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			l = options.Size(x.State)
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.EnabledHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.EnabledHeight))
+		}
+		if len(x.DenomMinGasPrices) > 0 {
+			for _, e := range x.DenomMinGasPrices {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.MsgTypeMultipliers) > 0 {
+			for _, e := range x.MsgTypeMultipliers {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.AccumulatedFees) > 0 {
+			for _, e := range x.AccumulatedFees {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CommunityPoolContributions != nil {
+			l = options.Size(x.CommunityPoolContributions)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.FrozenHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.FrozenHeight))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -344,6 +687,78 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.FrozenHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FrozenHeight))
+			i--
+			dAtA[i] = 0x40
+		}
+		if x.CommunityPoolContributions != nil {
+			encoded, err := options.Marshal(x.CommunityPoolContributions)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if len(x.AccumulatedFees) > 0 {
+			for iNdEx := len(x.AccumulatedFees) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.AccumulatedFees[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.MsgTypeMultipliers) > 0 {
+			for iNdEx := len(x.MsgTypeMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.MsgTypeMultipliers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.DenomMinGasPrices) > 0 {
+			for iNdEx := len(x.DenomMinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DenomMinGasPrices[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if x.EnabledHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.EnabledHeight))
+			i--
+			dAtA[i] = 0x18
+		}
 		if x.State != nil {
 			encoded, err := options.Marshal(x.State)
 			if err != nil {
@@ -493,43 +908,219 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EnabledHeight", wireType)
+				}
+				x.EnabledHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.EnabledHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DenomMinGasPrices", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DenomMinGasPrices = append(x.DenomMinGasPrices, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DenomMinGasPrices[len(x.DenomMinGasPrices)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeMultipliers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.MsgTypeMultipliers = append(x.MsgTypeMultipliers, &MsgTypeMultiplier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.MsgTypeMultipliers[len(x.MsgTypeMultipliers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var _ protoreflect.List = (*_State_3_list)(nil)
-
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AccumulatedFees = append(x.AccumulatedFees, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.AccumulatedFees[len(x.AccumulatedFees)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolContributions", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.CommunityPoolContributions == nil {
+					x.CommunityPoolContributions = &CommunityPoolContributions{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CommunityPoolContributions); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FrozenHeight", wireType)
+				}
+				x.FrozenHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FrozenHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_State_3_list)(nil)
+
 type _State_3_list struct {
 	list *[]uint64
 }
@@ -580,6 +1171,7 @@ var (
 	fd_State_learning_rate  protoreflect.FieldDescriptor
 	fd_State_window         protoreflect.FieldDescriptor
 	fd_State_index          protoreflect.FieldDescriptor
+	fd_State_window_sum     protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -589,6 +1181,7 @@ func init() {
 	fd_State_learning_rate = md_State.Fields().ByName("learning_rate")
 	fd_State_window = md_State.Fields().ByName("window")
 	fd_State_index = md_State.Fields().ByName("index")
+	fd_State_window_sum = md_State.Fields().ByName("window_sum")
 }
 
 var _ protoreflect.Message = (*fastReflection_State)(nil)
@@ -680,6 +1273,12 @@ func (x *fastReflection_State) Range(f func(protoreflect.FieldDescriptor, protor
 			return
 		}
 	}
+	if x.WindowSum != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WindowSum)
+		if !f(fd_State_window_sum, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -703,6 +1302,8 @@ func (x *fastReflection_State) Has(fd protoreflect.FieldDescriptor) bool {
 		return len(x.Window) != 0
 	case "feemarket.feemarket.v1.State.index":
 		return x.Index != uint64(0)
+	case "feemarket.feemarket.v1.State.window_sum":
+		return x.WindowSum != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.State"))
@@ -727,6 +1328,8 @@ func (x *fastReflection_State) Clear(fd protoreflect.FieldDescriptor) {
 		x.Window = nil
 	case "feemarket.feemarket.v1.State.index":
 		x.Index = uint64(0)
+	case "feemarket.feemarket.v1.State.window_sum":
+		x.WindowSum = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.State"))
@@ -758,6 +1361,9 @@ func (x *fastReflection_State) Get(descriptor protoreflect.FieldDescriptor) prot
 	case "feemarket.feemarket.v1.State.index":
 		value := x.Index
 		return protoreflect.ValueOfUint64(value)
+	case "feemarket.feemarket.v1.State.window_sum":
+		value := x.WindowSum
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.State"))
@@ -788,6 +1394,8 @@ func (x *fastReflection_State) Set(fd protoreflect.FieldDescriptor, value protor
 		x.Window = *clv.list
 	case "feemarket.feemarket.v1.State.index":
 		x.Index = value.Uint()
+	case "feemarket.feemarket.v1.State.window_sum":
+		x.WindowSum = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.State"))
@@ -820,6 +1428,8 @@ func (x *fastReflection_State) Mutable(fd protoreflect.FieldDescriptor) protoref
 		panic(fmt.Errorf("field learning_rate of message feemarket.feemarket.v1.State is not mutable"))
 	case "feemarket.feemarket.v1.State.index":
 		panic(fmt.Errorf("field index of message feemarket.feemarket.v1.State is not mutable"))
+	case "feemarket.feemarket.v1.State.window_sum":
+		panic(fmt.Errorf("field window_sum of message feemarket.feemarket.v1.State is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.State"))
@@ -842,6 +1452,8 @@ func (x *fastReflection_State) NewField(fd protoreflect.FieldDescriptor) protore
 		return protoreflect.ValueOfList(&_State_3_list{list: &list})
 	case "feemarket.feemarket.v1.State.index":
 		return protoreflect.ValueOfUint64(uint64(0))
+	case "feemarket.feemarket.v1.State.window_sum":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.State"))
@@ -929,6 +1541,9 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 		if x.Index != 0 {
 			n += 1 + runtime.Sov(uint64(x.Index))
 		}
+		if x.WindowSum != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowSum))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -958,6 +1573,11 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.WindowSum != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowSum))
+			i--
+			dAtA[i] = 0x28
+		}
 		if x.Index != 0 {
 			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
 			i--
@@ -1205,6 +1825,25 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 						break
 					}
 				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowSum", wireType)
+				}
+				x.WindowSum = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowSum |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1240,123 +1879,673 @@ func (x *fastReflection_State) ProtoMethods() *protoiface.Methods {
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: feemarket/feemarket/v1/genesis.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+var (
+	md_MsgTypeMultiplier              protoreflect.MessageDescriptor
+	fd_MsgTypeMultiplier_msg_type_url protoreflect.FieldDescriptor
+	fd_MsgTypeMultiplier_multiplier   protoreflect.FieldDescriptor
 )
 
-// GenesisState defines the feemarket module's genesis state.
-type GenesisState struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func init() {
+	file_feemarket_feemarket_v1_genesis_proto_init()
+	md_MsgTypeMultiplier = File_feemarket_feemarket_v1_genesis_proto.Messages().ByName("MsgTypeMultiplier")
+	fd_MsgTypeMultiplier_msg_type_url = md_MsgTypeMultiplier.Fields().ByName("msg_type_url")
+	fd_MsgTypeMultiplier_multiplier = md_MsgTypeMultiplier.Fields().ByName("multiplier")
+}
 
-	// Params are the parameters for the feemarket module. These parameters
-	// can be utilized to implement both the base EIP-1559 fee market and
-	// and the AIMD EIP-1559 fee market.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	// State contains the current state of the AIMD fee market.
-	State *State `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+var _ protoreflect.Message = (*fastReflection_MsgTypeMultiplier)(nil)
+
+type fastReflection_MsgTypeMultiplier MsgTypeMultiplier
+
+func (x *MsgTypeMultiplier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_MsgTypeMultiplier)(x)
 }
 
-func (x *GenesisState) Reset() {
-	*x = GenesisState{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_feemarket_feemarket_v1_genesis_proto_msgTypes[0]
+func (x *MsgTypeMultiplier) slowProtoReflect() protoreflect.Message {
+	mi := &file_feemarket_feemarket_v1_genesis_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
+	return mi.MessageOf(x)
 }
 
-func (x *GenesisState) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
+var _fastReflection_MsgTypeMultiplier_messageType fastReflection_MsgTypeMultiplier_messageType
+var _ protoreflect.MessageType = fastReflection_MsgTypeMultiplier_messageType{}
 
-func (*GenesisState) ProtoMessage() {}
+type fastReflection_MsgTypeMultiplier_messageType struct{}
 
-// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
-func (*GenesisState) Descriptor() ([]byte, []int) {
-	return file_feemarket_feemarket_v1_genesis_proto_rawDescGZIP(), []int{0}
+func (x fastReflection_MsgTypeMultiplier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_MsgTypeMultiplier)(nil)
 }
-
-func (x *GenesisState) GetParams() *Params {
-	if x != nil {
-		return x.Params
-	}
-	return nil
+func (x fastReflection_MsgTypeMultiplier_messageType) New() protoreflect.Message {
+	return new(fastReflection_MsgTypeMultiplier)
 }
-
-func (x *GenesisState) GetState() *State {
-	if x != nil {
-		return x.State
-	}
-	return nil
+func (x fastReflection_MsgTypeMultiplier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTypeMultiplier
 }
 
-// State is utilized to track the current state of the fee market. This includes
-// the current base fee, learning rate, and block utilization within the
-// specified AIMD window.
-type State struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// BaseGasPrice is the current base fee. This is denominated in the fee per
-	// gas unit.
-	BaseGasPrice string `protobuf:"bytes,1,opt,name=base_gas_price,json=baseGasPrice,proto3" json:"base_gas_price,omitempty"`
-	// LearningRate is the current learning rate.
-	LearningRate string `protobuf:"bytes,2,opt,name=learning_rate,json=learningRate,proto3" json:"learning_rate,omitempty"`
-	// Window contains a list of the last blocks' utilization values. This is used
-	// to calculate the next base fee. This stores the number of units of gas
-	// consumed per block.
-	Window []uint64 `protobuf:"varint,3,rep,packed,name=window,proto3" json:"window,omitempty"`
-	// Index is the index of the current block in the block utilization window.
-	Index uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_MsgTypeMultiplier) Descriptor() protoreflect.MessageDescriptor {
+	return md_MsgTypeMultiplier
 }
 
-func (x *State) Reset() {
-	*x = State{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_feemarket_feemarket_v1_genesis_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_MsgTypeMultiplier) Type() protoreflect.MessageType {
+	return _fastReflection_MsgTypeMultiplier_messageType
 }
 
-func (x *State) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_MsgTypeMultiplier) New() protoreflect.Message {
+	return new(fastReflection_MsgTypeMultiplier)
 }
 
-func (*State) ProtoMessage() {}
-
-// Deprecated: Use State.ProtoReflect.Descriptor instead.
-func (*State) Descriptor() ([]byte, []int) {
-	return file_feemarket_feemarket_v1_genesis_proto_rawDescGZIP(), []int{1}
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_MsgTypeMultiplier) Interface() protoreflect.ProtoMessage {
+	return (*MsgTypeMultiplier)(x)
 }
 
-func (x *State) GetBaseGasPrice() string {
-	if x != nil {
-		return x.BaseGasPrice
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_MsgTypeMultiplier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MsgTypeUrl != "" {
+		value := protoreflect.ValueOfString(x.MsgTypeUrl)
+		if !f(fd_MsgTypeMultiplier_msg_type_url, value) {
+			return
+		}
 	}
-	return ""
-}
-
-func (x *State) GetLearningRate() string {
-	if x != nil {
-		return x.LearningRate
+	if x.Multiplier != "" {
+		value := protoreflect.ValueOfString(x.Multiplier)
+		if !f(fd_MsgTypeMultiplier_multiplier, value) {
+			return
+		}
 	}
-	return ""
 }
 
-func (x *State) GetWindow() []uint64 {
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_MsgTypeMultiplier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.MsgTypeMultiplier.msg_type_url":
+		return x.MsgTypeUrl != ""
+	case "feemarket.feemarket.v1.MsgTypeMultiplier.multiplier":
+		return x.Multiplier != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.MsgTypeMultiplier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.MsgTypeMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTypeMultiplier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.MsgTypeMultiplier.msg_type_url":
+		x.MsgTypeUrl = ""
+	case "feemarket.feemarket.v1.MsgTypeMultiplier.multiplier":
+		x.Multiplier = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.MsgTypeMultiplier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.MsgTypeMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_MsgTypeMultiplier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "feemarket.feemarket.v1.MsgTypeMultiplier.msg_type_url":
+		value := x.MsgTypeUrl
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.MsgTypeMultiplier.multiplier":
+		value := x.Multiplier
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.MsgTypeMultiplier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.MsgTypeMultiplier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTypeMultiplier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.MsgTypeMultiplier.msg_type_url":
+		x.MsgTypeUrl = value.Interface().(string)
+	case "feemarket.feemarket.v1.MsgTypeMultiplier.multiplier":
+		x.Multiplier = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.MsgTypeMultiplier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.MsgTypeMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTypeMultiplier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.MsgTypeMultiplier.msg_type_url":
+		panic(fmt.Errorf("field msg_type_url of message feemarket.feemarket.v1.MsgTypeMultiplier is not mutable"))
+	case "feemarket.feemarket.v1.MsgTypeMultiplier.multiplier":
+		panic(fmt.Errorf("field multiplier of message feemarket.feemarket.v1.MsgTypeMultiplier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.MsgTypeMultiplier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.MsgTypeMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_MsgTypeMultiplier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.MsgTypeMultiplier.msg_type_url":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.MsgTypeMultiplier.multiplier":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.MsgTypeMultiplier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.MsgTypeMultiplier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_MsgTypeMultiplier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in feemarket.feemarket.v1.MsgTypeMultiplier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_MsgTypeMultiplier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_MsgTypeMultiplier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_MsgTypeMultiplier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_MsgTypeMultiplier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*MsgTypeMultiplier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MsgTypeUrl)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Multiplier)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTypeMultiplier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Multiplier) > 0 {
+			i -= len(x.Multiplier)
+			copy(dAtA[i:], x.Multiplier)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Multiplier)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MsgTypeUrl) > 0 {
+			i -= len(x.MsgTypeUrl)
+			copy(dAtA[i:], x.MsgTypeUrl)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MsgTypeUrl)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*MsgTypeMultiplier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTypeMultiplier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: MsgTypeMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Multiplier = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: feemarket/feemarket/v1/genesis.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// GenesisState defines the feemarket module's genesis state.
+type GenesisState struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Params are the parameters for the feemarket module. These parameters
+	// can be utilized to implement both the base EIP-1559 fee market and
+	// and the AIMD EIP-1559 fee market.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	// State contains the current state of the AIMD fee market.
+	State *State `protobuf:"bytes,2,opt,name=state,proto3" json:"state,omitempty"`
+	// EnabledHeight is the height at which the fee market was enabled by a
+	// MsgParams, or -1 if it was not. Zero is treated as -1, so that genesis files
+	// exported before the field existed import unchanged.
+	EnabledHeight int64 `protobuf:"varint,3,opt,name=enabled_height,json=enabledHeight,proto3" json:"enabled_height,omitempty"`
+	// DenomMinGasPrices are the minimum gas prices enforced per denom on top of
+	// the market-derived price.
+	DenomMinGasPrices []*v1beta1.DecCoin `protobuf:"bytes,4,rep,name=denom_min_gas_prices,json=denomMinGasPrices,proto3" json:"denom_min_gas_prices,omitempty"`
+	// MsgTypeMultipliers are the multipliers applied to the base gas price per
+	// message type.
+	MsgTypeMultipliers []*MsgTypeMultiplier `protobuf:"bytes,5,rep,name=msg_type_multipliers,json=msgTypeMultipliers,proto3" json:"msg_type_multipliers,omitempty"`
+	// AccumulatedFees are the fees held in the fee market's fee collector for
+	// distribution at the end of the current distribution epoch.
+	AccumulatedFees []*v1beta1.Coin `protobuf:"bytes,6,rep,name=accumulated_fees,json=accumulatedFees,proto3" json:"accumulated_fees,omitempty"`
+	// CommunityPoolContributions are the cumulative contributions to the
+	// community pool from the fees distributed by the fee market.
+	CommunityPoolContributions *CommunityPoolContributions `protobuf:"bytes,7,opt,name=community_pool_contributions,json=communityPoolContributions,proto3" json:"community_pool_contributions,omitempty"`
+	// FrozenHeight is the height at which a MsgParams last disabled the fee
+	// market after it had been enabled, or zero if none did. A disabled fee
+	// market with a frozen height keeps charging its frozen base gas price.
+	FrozenHeight int64 `protobuf:"varint,8,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height,omitempty"`
+}
+
+func (x *GenesisState) Reset() {
+	*x = GenesisState{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feemarket_feemarket_v1_genesis_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GenesisState) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GenesisState) ProtoMessage() {}
+
+// Deprecated: Use GenesisState.ProtoReflect.Descriptor instead.
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_genesis_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *GenesisState) GetParams() *Params {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *GenesisState) GetState() *State {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *GenesisState) GetEnabledHeight() int64 {
+	if x != nil {
+		return x.EnabledHeight
+	}
+	return 0
+}
+
+func (x *GenesisState) GetDenomMinGasPrices() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.DenomMinGasPrices
+	}
+	return nil
+}
+
+func (x *GenesisState) GetMsgTypeMultipliers() []*MsgTypeMultiplier {
+	if x != nil {
+		return x.MsgTypeMultipliers
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAccumulatedFees() []*v1beta1.Coin {
+	if x != nil {
+		return x.AccumulatedFees
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCommunityPoolContributions() *CommunityPoolContributions {
+	if x != nil {
+		return x.CommunityPoolContributions
+	}
+	return nil
+}
+
+func (x *GenesisState) GetFrozenHeight() int64 {
+	if x != nil {
+		return x.FrozenHeight
+	}
+	return 0
+}
+
+// State is utilized to track the current state of the fee market. This includes
+// the current base fee, learning rate, and block utilization within the
+// specified AIMD window.
+type State struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// BaseGasPrice is the current base fee. This is denominated in the fee per
+	// gas unit.
+	BaseGasPrice string `protobuf:"bytes,1,opt,name=base_gas_price,json=baseGasPrice,proto3" json:"base_gas_price,omitempty"`
+	// LearningRate is the current learning rate.
+	LearningRate string `protobuf:"bytes,2,opt,name=learning_rate,json=learningRate,proto3" json:"learning_rate,omitempty"`
+	// Window contains a list of the last blocks' utilization values. This is used
+	// to calculate the next base fee. This stores the number of units of gas
+	// consumed per block.
+	Window []uint64 `protobuf:"varint,3,rep,packed,name=window,proto3" json:"window,omitempty"`
+	// Index is the index of the current block in the block utilization window.
+	Index uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// WindowSum is the running sum of the block utilization values in the window.
+	// It is updated incrementally as blocks enter and leave the window so that the
+	// window does not need to be summed every block.
+	WindowSum uint64 `protobuf:"varint,5,opt,name=window_sum,json=windowSum,proto3" json:"window_sum,omitempty"`
+}
+
+func (x *State) Reset() {
+	*x = State{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feemarket_feemarket_v1_genesis_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *State) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*State) ProtoMessage() {}
+
+// Deprecated: Use State.ProtoReflect.Descriptor instead.
+func (*State) Descriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_genesis_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *State) GetBaseGasPrice() string {
+	if x != nil {
+		return x.BaseGasPrice
+	}
+	return ""
+}
+
+func (x *State) GetLearningRate() string {
+	if x != nil {
+		return x.LearningRate
+	}
+	return ""
+}
+
+func (x *State) GetWindow() []uint64 {
 	if x != nil {
 		return x.Window
 	}
@@ -1370,6 +2559,61 @@ func (x *State) GetIndex() uint64 {
 	return 0
 }
 
+func (x *State) GetWindowSum() uint64 {
+	if x != nil {
+		return x.WindowSum
+	}
+	return 0
+}
+
+// MsgTypeMultiplier is the multiplier applied to the base gas price for a
+// message type.
+type MsgTypeMultiplier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MsgTypeUrl is the type URL of the message type.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Multiplier is the non-negative multiplier applied to the base gas price
+	// for the message type.
+	Multiplier string `protobuf:"bytes,2,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+}
+
+func (x *MsgTypeMultiplier) Reset() {
+	*x = MsgTypeMultiplier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feemarket_feemarket_v1_genesis_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MsgTypeMultiplier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTypeMultiplier) ProtoMessage() {}
+
+// Deprecated: Use MsgTypeMultiplier.ProtoReflect.Descriptor instead.
+func (*MsgTypeMultiplier) Descriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_genesis_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *MsgTypeMultiplier) GetMsgTypeUrl() string {
+	if x != nil {
+		return x.MsgTypeUrl
+	}
+	return ""
+}
+
+func (x *MsgTypeMultiplier) GetMultiplier() string {
+	if x != nil {
+		return x.Multiplier
+	}
+	return ""
+}
+
 var File_feemarket_feemarket_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_feemarket_feemarket_v1_genesis_proto_rawDesc = []byte{
@@ -1382,44 +2626,95 @@ var file_feemarket_feemarket_v1_genesis_proto_rawDesc = []byte{
 	0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x23, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61,
 	0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x22, 0x87, 0x01, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3c, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50,
-	0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x12, 0x39, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66,
-	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74,
-	0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x22, 0xe6,
-	0x01, 0x0a, 0x05, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
-	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61,
-	0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e,
-	0x44, 0x65, 0x63, 0x52, 0x0c, 0x62, 0x61, 0x73, 0x65, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63,
-	0x65, 0x12, 0x56, 0x0a, 0x0d, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61,
-	0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
-	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
-	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x6c, 0x65, 0x61,
-	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e,
-	0x64, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f,
-	0x77, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x42, 0xd9, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e,
-	0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
-	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
-	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
-	0x65, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b,
-	0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x46,
-	0x58, 0xaa, 0x02, 0x16, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x46, 0x65,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x46, 0x65, 0x65,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c,
-	0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42,
-	0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x46, 0x65, 0x65, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x11, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e,
+	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f,
+	0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69,
+	0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x24, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x2f,
+	0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb9, 0x05,
+	0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3c,
+	0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e,
+	0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x04,
+	0xc8, 0xde, 0x1f, 0x00, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x39, 0x0a, 0x05,
+	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x66, 0x65,
+	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00,
+	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x0d, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x87,
+	0x01, 0x0a, 0x14, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65,
+	0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x38, 0xc8, 0xde, 0x1f,
+	0x00, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64,
+	0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x4d, 0x69, 0x6e, 0x47,
+	0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x12, 0x61, 0x0a, 0x14, 0x6d, 0x73, 0x67, 0x5f,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73,
+	0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e,
+	0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65,
+	0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x12, 0x6d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65,
+	0x4d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x73, 0x12, 0x7b, 0x0a, 0x10, 0x61,
+	0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69,
+	0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x75, 0x6d, 0x75, 0x6c,
+	0x61, 0x74, 0x65, 0x64, 0x46, 0x65, 0x65, 0x73, 0x12, 0x7a, 0x0a, 0x1c, 0x63, 0x6f, 0x6d, 0x6d,
+	0x75, 0x6e, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x69, 0x62, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32,
+	0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x75, 0x6e, 0x69, 0x74,
+	0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x1a, 0x63, 0x6f, 0x6d, 0x6d, 0x75, 0x6e,
+	0x69, 0x74, 0x79, 0x50, 0x6f, 0x6f, 0x6c, 0x43, 0x6f, 0x6e, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x7a, 0x65, 0x6e, 0x5f, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x72, 0x6f,
+	0x7a, 0x65, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x85, 0x02, 0x0a, 0x05, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f,
+	0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c,
+	0x62, 0x61, 0x73, 0x65, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x56, 0x0a, 0x0d,
+	0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x6c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67,
+	0x52, 0x61, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x04, 0x52, 0x06, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x75, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53, 0x75,
+	0x6d, 0x22, 0x88, 0x01, 0x0a, 0x11, 0x4d, 0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x4d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x73, 0x67, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d,
+	0x73, 0x67, 0x54, 0x79, 0x70, 0x65, 0x55, 0x72, 0x6c, 0x12, 0x51, 0x0a, 0x0a, 0x6d, 0x75, 0x6c,
+	0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44,
+	0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63,
+	0x52, 0x0a, 0x6d, 0x75, 0x6c, 0x74, 0x69, 0x70, 0x6c, 0x69, 0x65, 0x72, 0x42, 0xd9, 0x01, 0x0a,
+	0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66,
+	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e,
+	0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x65,
+	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x76, 0x31,
+	0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02, 0x16, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca,
+	0x02, 0x16, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d,
+	0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x46, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56,
+	0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18,
+	0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1434,20 +2729,28 @@ func file_feemarket_feemarket_v1_genesis_proto_rawDescGZIP() []byte {
 	return file_feemarket_feemarket_v1_genesis_proto_rawDescData
 }
 
-var file_feemarket_feemarket_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_feemarket_feemarket_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
 var file_feemarket_feemarket_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: feemarket.feemarket.v1.GenesisState
-	(*State)(nil),        // 1: feemarket.feemarket.v1.State
-	(*Params)(nil),       // 2: feemarket.feemarket.v1.Params
+	(*GenesisState)(nil),               // 0: feemarket.feemarket.v1.GenesisState
+	(*State)(nil),                      // 1: feemarket.feemarket.v1.State
+	(*MsgTypeMultiplier)(nil),          // 2: feemarket.feemarket.v1.MsgTypeMultiplier
+	(*Params)(nil),                     // 3: feemarket.feemarket.v1.Params
+	(*v1beta1.DecCoin)(nil),            // 4: cosmos.base.v1beta1.DecCoin
+	(*v1beta1.Coin)(nil),               // 5: cosmos.base.v1beta1.Coin
+	(*CommunityPoolContributions)(nil), // 6: feemarket.feemarket.v1.CommunityPoolContributions
 }
 var file_feemarket_feemarket_v1_genesis_proto_depIdxs = []int32{
-	2, // 0: feemarket.feemarket.v1.GenesisState.params:type_name -> feemarket.feemarket.v1.Params
+	3, // 0: feemarket.feemarket.v1.GenesisState.params:type_name -> feemarket.feemarket.v1.Params
 	1, // 1: feemarket.feemarket.v1.GenesisState.state:type_name -> feemarket.feemarket.v1.State
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	4, // 2: feemarket.feemarket.v1.GenesisState.denom_min_gas_prices:type_name -> cosmos.base.v1beta1.DecCoin
+	2, // 3: feemarket.feemarket.v1.GenesisState.msg_type_multipliers:type_name -> feemarket.feemarket.v1.MsgTypeMultiplier
+	5, // 4: feemarket.feemarket.v1.GenesisState.accumulated_fees:type_name -> cosmos.base.v1beta1.Coin
+	6, // 5: feemarket.feemarket.v1.GenesisState.community_pool_contributions:type_name -> feemarket.feemarket.v1.CommunityPoolContributions
+	6, // [6:6] is the sub-list for method output_type
+	6, // [6:6] is the sub-list for method input_type
+	6, // [6:6] is the sub-list for extension type_name
+	6, // [6:6] is the sub-list for extension extendee
+	0, // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_feemarket_feemarket_v1_genesis_proto_init() }
@@ -1456,6 +2759,7 @@ func file_feemarket_feemarket_v1_genesis_proto_init() {
 		return
 	}
 	file_feemarket_feemarket_v1_params_proto_init()
+	file_feemarket_feemarket_v1_revenue_proto_init()
 	if !protoimpl.UnsafeEnabled {
 		file_feemarket_feemarket_v1_genesis_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GenesisState); i {
@@ -1481,6 +2785,18 @@ func file_feemarket_feemarket_v1_genesis_proto_init() {
 				return nil
 			}
 		}
+		file_feemarket_feemarket_v1_genesis_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MsgTypeMultiplier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -1488,7 +2804,7 @@ func file_feemarket_feemarket_v1_genesis_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feemarket_feemarket_v1_genesis_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   2,
+			NumMessages:   3,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
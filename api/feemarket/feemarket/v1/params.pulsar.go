@@ -14,20 +14,84 @@ import (
 	sync "sync"
 )
 
+var _ protoreflect.List = (*_Params_20_list)(nil)
+
+type _Params_20_list struct {
+	list *[]*FeeDiscountTier
+}
+
+func (x *_Params_20_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_Params_20_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_Params_20_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeDiscountTier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_Params_20_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*FeeDiscountTier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_Params_20_list) AppendMutable() protoreflect.Value {
+	v := new(FeeDiscountTier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_20_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_Params_20_list) NewElement() protoreflect.Value {
+	v := new(FeeDiscountTier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_Params_20_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_Params                       protoreflect.MessageDescriptor
-	fd_Params_alpha                 protoreflect.FieldDescriptor
-	fd_Params_beta                  protoreflect.FieldDescriptor
-	fd_Params_gamma                 protoreflect.FieldDescriptor
-	fd_Params_delta                 protoreflect.FieldDescriptor
-	fd_Params_min_base_gas_price    protoreflect.FieldDescriptor
-	fd_Params_min_learning_rate     protoreflect.FieldDescriptor
-	fd_Params_max_learning_rate     protoreflect.FieldDescriptor
-	fd_Params_max_block_utilization protoreflect.FieldDescriptor
-	fd_Params_window                protoreflect.FieldDescriptor
-	fd_Params_fee_denom             protoreflect.FieldDescriptor
-	fd_Params_enabled               protoreflect.FieldDescriptor
-	fd_Params_distribute_fees       protoreflect.FieldDescriptor
+	md_Params                                protoreflect.MessageDescriptor
+	fd_Params_alpha                          protoreflect.FieldDescriptor
+	fd_Params_beta                           protoreflect.FieldDescriptor
+	fd_Params_gamma                          protoreflect.FieldDescriptor
+	fd_Params_delta                          protoreflect.FieldDescriptor
+	fd_Params_min_base_gas_price             protoreflect.FieldDescriptor
+	fd_Params_min_learning_rate              protoreflect.FieldDescriptor
+	fd_Params_max_learning_rate              protoreflect.FieldDescriptor
+	fd_Params_max_block_utilization          protoreflect.FieldDescriptor
+	fd_Params_window                         protoreflect.FieldDescriptor
+	fd_Params_fee_denom                      protoreflect.FieldDescriptor
+	fd_Params_enabled                        protoreflect.FieldDescriptor
+	fd_Params_distribute_fees                protoreflect.FieldDescriptor
+	fd_Params_warmup_blocks                  protoreflect.FieldDescriptor
+	fd_Params_effective_min_learning_rate    protoreflect.FieldDescriptor
+	fd_Params_reject_gas_above_block_limit   protoreflect.FieldDescriptor
+	fd_Params_target_dead_band               protoreflect.FieldDescriptor
+	fd_Params_account_fee_spend_window       protoreflect.FieldDescriptor
+	fd_Params_distribution_epoch_blocks      protoreflect.FieldDescriptor
+	fd_Params_fee_discount_tiers             protoreflect.FieldDescriptor
+	fd_Params_max_rate_change_per_block      protoreflect.FieldDescriptor
+	fd_Params_base_gas_price_history_size    protoreflect.FieldDescriptor
+	fd_Params_compact_base_gas_price_history protoreflect.FieldDescriptor
+	fd_Params_mode                           protoreflect.FieldDescriptor
+	fd_Params_burn_fraction                  protoreflect.FieldDescriptor
+	fd_Params_record_analytics               protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -45,6 +109,19 @@ func init() {
 	fd_Params_fee_denom = md_Params.Fields().ByName("fee_denom")
 	fd_Params_enabled = md_Params.Fields().ByName("enabled")
 	fd_Params_distribute_fees = md_Params.Fields().ByName("distribute_fees")
+	fd_Params_warmup_blocks = md_Params.Fields().ByName("warmup_blocks")
+	fd_Params_effective_min_learning_rate = md_Params.Fields().ByName("effective_min_learning_rate")
+	fd_Params_reject_gas_above_block_limit = md_Params.Fields().ByName("reject_gas_above_block_limit")
+	fd_Params_target_dead_band = md_Params.Fields().ByName("target_dead_band")
+	fd_Params_account_fee_spend_window = md_Params.Fields().ByName("account_fee_spend_window")
+	fd_Params_distribution_epoch_blocks = md_Params.Fields().ByName("distribution_epoch_blocks")
+	fd_Params_fee_discount_tiers = md_Params.Fields().ByName("fee_discount_tiers")
+	fd_Params_max_rate_change_per_block = md_Params.Fields().ByName("max_rate_change_per_block")
+	fd_Params_base_gas_price_history_size = md_Params.Fields().ByName("base_gas_price_history_size")
+	fd_Params_compact_base_gas_price_history = md_Params.Fields().ByName("compact_base_gas_price_history")
+	fd_Params_mode = md_Params.Fields().ByName("mode")
+	fd_Params_burn_fraction = md_Params.Fields().ByName("burn_fraction")
+	fd_Params_record_analytics = md_Params.Fields().ByName("record_analytics")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -184,6 +261,84 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
+	if x.WarmupBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.WarmupBlocks)
+		if !f(fd_Params_warmup_blocks, value) {
+			return
+		}
+	}
+	if x.EffectiveMinLearningRate != "" {
+		value := protoreflect.ValueOfString(x.EffectiveMinLearningRate)
+		if !f(fd_Params_effective_min_learning_rate, value) {
+			return
+		}
+	}
+	if x.RejectGasAboveBlockLimit != false {
+		value := protoreflect.ValueOfBool(x.RejectGasAboveBlockLimit)
+		if !f(fd_Params_reject_gas_above_block_limit, value) {
+			return
+		}
+	}
+	if x.TargetDeadBand != "" {
+		value := protoreflect.ValueOfString(x.TargetDeadBand)
+		if !f(fd_Params_target_dead_band, value) {
+			return
+		}
+	}
+	if x.AccountFeeSpendWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AccountFeeSpendWindow)
+		if !f(fd_Params_account_fee_spend_window, value) {
+			return
+		}
+	}
+	if x.DistributionEpochBlocks != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.DistributionEpochBlocks)
+		if !f(fd_Params_distribution_epoch_blocks, value) {
+			return
+		}
+	}
+	if len(x.FeeDiscountTiers) != 0 {
+		value := protoreflect.ValueOfList(&_Params_20_list{list: &x.FeeDiscountTiers})
+		if !f(fd_Params_fee_discount_tiers, value) {
+			return
+		}
+	}
+	if x.MaxRateChangePerBlock != "" {
+		value := protoreflect.ValueOfString(x.MaxRateChangePerBlock)
+		if !f(fd_Params_max_rate_change_per_block, value) {
+			return
+		}
+	}
+	if x.BaseGasPriceHistorySize != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.BaseGasPriceHistorySize)
+		if !f(fd_Params_base_gas_price_history_size, value) {
+			return
+		}
+	}
+	if x.CompactBaseGasPriceHistory != false {
+		value := protoreflect.ValueOfBool(x.CompactBaseGasPriceHistory)
+		if !f(fd_Params_compact_base_gas_price_history, value) {
+			return
+		}
+	}
+	if x.Mode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.Mode))
+		if !f(fd_Params_mode, value) {
+			return
+		}
+	}
+	if x.BurnFraction != "" {
+		value := protoreflect.ValueOfString(x.BurnFraction)
+		if !f(fd_Params_burn_fraction, value) {
+			return
+		}
+	}
+	if x.RecordAnalytics != false {
+		value := protoreflect.ValueOfBool(x.RecordAnalytics)
+		if !f(fd_Params_record_analytics, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -223,6 +378,32 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.Enabled != false
 	case "feemarket.feemarket.v1.Params.distribute_fees":
 		return x.DistributeFees != false
+	case "feemarket.feemarket.v1.Params.warmup_blocks":
+		return x.WarmupBlocks != uint64(0)
+	case "feemarket.feemarket.v1.Params.effective_min_learning_rate":
+		return x.EffectiveMinLearningRate != ""
+	case "feemarket.feemarket.v1.Params.reject_gas_above_block_limit":
+		return x.RejectGasAboveBlockLimit != false
+	case "feemarket.feemarket.v1.Params.target_dead_band":
+		return x.TargetDeadBand != ""
+	case "feemarket.feemarket.v1.Params.account_fee_spend_window":
+		return x.AccountFeeSpendWindow != uint64(0)
+	case "feemarket.feemarket.v1.Params.distribution_epoch_blocks":
+		return x.DistributionEpochBlocks != uint64(0)
+	case "feemarket.feemarket.v1.Params.fee_discount_tiers":
+		return len(x.FeeDiscountTiers) != 0
+	case "feemarket.feemarket.v1.Params.max_rate_change_per_block":
+		return x.MaxRateChangePerBlock != ""
+	case "feemarket.feemarket.v1.Params.base_gas_price_history_size":
+		return x.BaseGasPriceHistorySize != uint64(0)
+	case "feemarket.feemarket.v1.Params.compact_base_gas_price_history":
+		return x.CompactBaseGasPriceHistory != false
+	case "feemarket.feemarket.v1.Params.mode":
+		return x.Mode != 0
+	case "feemarket.feemarket.v1.Params.burn_fraction":
+		return x.BurnFraction != ""
+	case "feemarket.feemarket.v1.Params.record_analytics":
+		return x.RecordAnalytics != false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -263,6 +444,32 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.Enabled = false
 	case "feemarket.feemarket.v1.Params.distribute_fees":
 		x.DistributeFees = false
+	case "feemarket.feemarket.v1.Params.warmup_blocks":
+		x.WarmupBlocks = uint64(0)
+	case "feemarket.feemarket.v1.Params.effective_min_learning_rate":
+		x.EffectiveMinLearningRate = ""
+	case "feemarket.feemarket.v1.Params.reject_gas_above_block_limit":
+		x.RejectGasAboveBlockLimit = false
+	case "feemarket.feemarket.v1.Params.target_dead_band":
+		x.TargetDeadBand = ""
+	case "feemarket.feemarket.v1.Params.account_fee_spend_window":
+		x.AccountFeeSpendWindow = uint64(0)
+	case "feemarket.feemarket.v1.Params.distribution_epoch_blocks":
+		x.DistributionEpochBlocks = uint64(0)
+	case "feemarket.feemarket.v1.Params.fee_discount_tiers":
+		x.FeeDiscountTiers = nil
+	case "feemarket.feemarket.v1.Params.max_rate_change_per_block":
+		x.MaxRateChangePerBlock = ""
+	case "feemarket.feemarket.v1.Params.base_gas_price_history_size":
+		x.BaseGasPriceHistorySize = uint64(0)
+	case "feemarket.feemarket.v1.Params.compact_base_gas_price_history":
+		x.CompactBaseGasPriceHistory = false
+	case "feemarket.feemarket.v1.Params.mode":
+		x.Mode = 0
+	case "feemarket.feemarket.v1.Params.burn_fraction":
+		x.BurnFraction = ""
+	case "feemarket.feemarket.v1.Params.record_analytics":
+		x.RecordAnalytics = false
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -315,6 +522,48 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "feemarket.feemarket.v1.Params.distribute_fees":
 		value := x.DistributeFees
 		return protoreflect.ValueOfBool(value)
+	case "feemarket.feemarket.v1.Params.warmup_blocks":
+		value := x.WarmupBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "feemarket.feemarket.v1.Params.effective_min_learning_rate":
+		value := x.EffectiveMinLearningRate
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.reject_gas_above_block_limit":
+		value := x.RejectGasAboveBlockLimit
+		return protoreflect.ValueOfBool(value)
+	case "feemarket.feemarket.v1.Params.target_dead_band":
+		value := x.TargetDeadBand
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.account_fee_spend_window":
+		value := x.AccountFeeSpendWindow
+		return protoreflect.ValueOfUint64(value)
+	case "feemarket.feemarket.v1.Params.distribution_epoch_blocks":
+		value := x.DistributionEpochBlocks
+		return protoreflect.ValueOfUint64(value)
+	case "feemarket.feemarket.v1.Params.fee_discount_tiers":
+		if len(x.FeeDiscountTiers) == 0 {
+			return protoreflect.ValueOfList(&_Params_20_list{})
+		}
+		listValue := &_Params_20_list{list: &x.FeeDiscountTiers}
+		return protoreflect.ValueOfList(listValue)
+	case "feemarket.feemarket.v1.Params.max_rate_change_per_block":
+		value := x.MaxRateChangePerBlock
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.base_gas_price_history_size":
+		value := x.BaseGasPriceHistorySize
+		return protoreflect.ValueOfUint64(value)
+	case "feemarket.feemarket.v1.Params.compact_base_gas_price_history":
+		value := x.CompactBaseGasPriceHistory
+		return protoreflect.ValueOfBool(value)
+	case "feemarket.feemarket.v1.Params.mode":
+		value := x.Mode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "feemarket.feemarket.v1.Params.burn_fraction":
+		value := x.BurnFraction
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.Params.record_analytics":
+		value := x.RecordAnalytics
+		return protoreflect.ValueOfBool(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -359,6 +608,34 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.Enabled = value.Bool()
 	case "feemarket.feemarket.v1.Params.distribute_fees":
 		x.DistributeFees = value.Bool()
+	case "feemarket.feemarket.v1.Params.warmup_blocks":
+		x.WarmupBlocks = value.Uint()
+	case "feemarket.feemarket.v1.Params.effective_min_learning_rate":
+		x.EffectiveMinLearningRate = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.reject_gas_above_block_limit":
+		x.RejectGasAboveBlockLimit = value.Bool()
+	case "feemarket.feemarket.v1.Params.target_dead_band":
+		x.TargetDeadBand = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.account_fee_spend_window":
+		x.AccountFeeSpendWindow = value.Uint()
+	case "feemarket.feemarket.v1.Params.distribution_epoch_blocks":
+		x.DistributionEpochBlocks = value.Uint()
+	case "feemarket.feemarket.v1.Params.fee_discount_tiers":
+		lv := value.List()
+		clv := lv.(*_Params_20_list)
+		x.FeeDiscountTiers = *clv.list
+	case "feemarket.feemarket.v1.Params.max_rate_change_per_block":
+		x.MaxRateChangePerBlock = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.base_gas_price_history_size":
+		x.BaseGasPriceHistorySize = value.Uint()
+	case "feemarket.feemarket.v1.Params.compact_base_gas_price_history":
+		x.CompactBaseGasPriceHistory = value.Bool()
+	case "feemarket.feemarket.v1.Params.mode":
+		x.Mode = (Mode)(value.Enum())
+	case "feemarket.feemarket.v1.Params.burn_fraction":
+		x.BurnFraction = value.Interface().(string)
+	case "feemarket.feemarket.v1.Params.record_analytics":
+		x.RecordAnalytics = value.Bool()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -379,6 +656,12 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 // Mutable is a mutating operation and unsafe for concurrent use.
 func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
 	switch fd.FullName() {
+	case "feemarket.feemarket.v1.Params.fee_discount_tiers":
+		if x.FeeDiscountTiers == nil {
+			x.FeeDiscountTiers = []*FeeDiscountTier{}
+		}
+		value := &_Params_20_list{list: &x.FeeDiscountTiers}
+		return protoreflect.ValueOfList(value)
 	case "feemarket.feemarket.v1.Params.alpha":
 		panic(fmt.Errorf("field alpha of message feemarket.feemarket.v1.Params is not mutable"))
 	case "feemarket.feemarket.v1.Params.beta":
@@ -403,6 +686,30 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		panic(fmt.Errorf("field enabled of message feemarket.feemarket.v1.Params is not mutable"))
 	case "feemarket.feemarket.v1.Params.distribute_fees":
 		panic(fmt.Errorf("field distribute_fees of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.warmup_blocks":
+		panic(fmt.Errorf("field warmup_blocks of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.effective_min_learning_rate":
+		panic(fmt.Errorf("field effective_min_learning_rate of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.reject_gas_above_block_limit":
+		panic(fmt.Errorf("field reject_gas_above_block_limit of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.target_dead_band":
+		panic(fmt.Errorf("field target_dead_band of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.account_fee_spend_window":
+		panic(fmt.Errorf("field account_fee_spend_window of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.distribution_epoch_blocks":
+		panic(fmt.Errorf("field distribution_epoch_blocks of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.max_rate_change_per_block":
+		panic(fmt.Errorf("field max_rate_change_per_block of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.base_gas_price_history_size":
+		panic(fmt.Errorf("field base_gas_price_history_size of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.compact_base_gas_price_history":
+		panic(fmt.Errorf("field compact_base_gas_price_history of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.mode":
+		panic(fmt.Errorf("field mode of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.burn_fraction":
+		panic(fmt.Errorf("field burn_fraction of message feemarket.feemarket.v1.Params is not mutable"))
+	case "feemarket.feemarket.v1.Params.record_analytics":
+		panic(fmt.Errorf("field record_analytics of message feemarket.feemarket.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -440,6 +747,33 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfBool(false)
 	case "feemarket.feemarket.v1.Params.distribute_fees":
 		return protoreflect.ValueOfBool(false)
+	case "feemarket.feemarket.v1.Params.warmup_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "feemarket.feemarket.v1.Params.effective_min_learning_rate":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.reject_gas_above_block_limit":
+		return protoreflect.ValueOfBool(false)
+	case "feemarket.feemarket.v1.Params.target_dead_band":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.account_fee_spend_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "feemarket.feemarket.v1.Params.distribution_epoch_blocks":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "feemarket.feemarket.v1.Params.fee_discount_tiers":
+		list := []*FeeDiscountTier{}
+		return protoreflect.ValueOfList(&_Params_20_list{list: &list})
+	case "feemarket.feemarket.v1.Params.max_rate_change_per_block":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.base_gas_price_history_size":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "feemarket.feemarket.v1.Params.compact_base_gas_price_history":
+		return protoreflect.ValueOfBool(false)
+	case "feemarket.feemarket.v1.Params.mode":
+		return protoreflect.ValueOfEnum(0)
+	case "feemarket.feemarket.v1.Params.burn_fraction":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.Params.record_analytics":
+		return protoreflect.ValueOfBool(false)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.Params"))
@@ -553,6 +887,52 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if x.DistributeFees {
 			n += 2
 		}
+		if x.WarmupBlocks != 0 {
+			n += 1 + runtime.Sov(uint64(x.WarmupBlocks))
+		}
+		l = len(x.EffectiveMinLearningRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.RejectGasAboveBlockLimit {
+			n += 2
+		}
+		l = len(x.TargetDeadBand)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.AccountFeeSpendWindow != 0 {
+			n += 2 + runtime.Sov(uint64(x.AccountFeeSpendWindow))
+		}
+		if x.DistributionEpochBlocks != 0 {
+			n += 2 + runtime.Sov(uint64(x.DistributionEpochBlocks))
+		}
+		if len(x.FeeDiscountTiers) > 0 {
+			for _, e := range x.FeeDiscountTiers {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		l = len(x.MaxRateChangePerBlock)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.BaseGasPriceHistorySize != 0 {
+			n += 2 + runtime.Sov(uint64(x.BaseGasPriceHistorySize))
+		}
+		if x.CompactBaseGasPriceHistory {
+			n += 3
+		}
+		if x.Mode != 0 {
+			n += 2 + runtime.Sov(uint64(x.Mode))
+		}
+		l = len(x.BurnFraction)
+		if l > 0 {
+			n += 2 + l + runtime.Sov(uint64(l))
+		}
+		if x.RecordAnalytics {
+			n += 3
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -582,6 +962,125 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RecordAnalytics {
+			i--
+			if x.RecordAnalytics {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xd0
+		}
+		if len(x.BurnFraction) > 0 {
+			i -= len(x.BurnFraction)
+			copy(dAtA[i:], x.BurnFraction)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.BurnFraction)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+		if x.Mode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Mode))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc0
+		}
+		if x.CompactBaseGasPriceHistory {
+			i--
+			if x.CompactBaseGasPriceHistory {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb8
+		}
+		if x.BaseGasPriceHistorySize != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.BaseGasPriceHistorySize))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb0
+		}
+		if len(x.MaxRateChangePerBlock) > 0 {
+			i -= len(x.MaxRateChangePerBlock)
+			copy(dAtA[i:], x.MaxRateChangePerBlock)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxRateChangePerBlock)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+		if len(x.FeeDiscountTiers) > 0 {
+			for iNdEx := len(x.FeeDiscountTiers) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.FeeDiscountTiers[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
+			}
+		}
+		if x.DistributionEpochBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.DistributionEpochBlocks))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x98
+		}
+		if x.AccountFeeSpendWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AccountFeeSpendWindow))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x88
+		}
+		if len(x.TargetDeadBand) > 0 {
+			i -= len(x.TargetDeadBand)
+			copy(dAtA[i:], x.TargetDeadBand)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TargetDeadBand)))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+		if x.RejectGasAboveBlockLimit {
+			i--
+			if x.RejectGasAboveBlockLimit {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.EffectiveMinLearningRate) > 0 {
+			i -= len(x.EffectiveMinLearningRate)
+			copy(dAtA[i:], x.EffectiveMinLearningRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.EffectiveMinLearningRate)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.WarmupBlocks != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WarmupBlocks))
+			i--
+			dAtA[i] = 0x68
+		}
 		if x.DistributeFees {
 			i--
 			if x.DistributeFees {
@@ -1051,72 +1550,923 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 				}
 				x.DistributeFees = bool(v != 0)
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WarmupBlocks", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
+				x.WarmupBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WarmupBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field EffectiveMinLearningRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if (iNdEx + skippy) > l {
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.EffectiveMinLearningRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 15:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RejectGasAboveBlockLimit", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: feemarket/feemarket/v1/params.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// Params contains the required set of parameters for the EIP1559 fee market
-// plugin implementation.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	// Alpha is the amount we additively increase the learning rate
-	// when it is above or below the target +/- threshold.
-	//
-	// Must be > 0.
-	Alpha string `protobuf:"bytes,1,opt,name=alpha,proto3" json:"alpha,omitempty"`
-	// Beta is the amount we multiplicatively decrease the learning rate
-	// when it is within the target +/- threshold.
-	//
-	// Must be [0, 1].
-	Beta string `protobuf:"bytes,2,opt,name=beta,proto3" json:"beta,omitempty"`
-	// Gamma is the threshold for the learning rate. If the learning rate is
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RejectGasAboveBlockLimit = bool(v != 0)
+			case 16:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TargetDeadBand", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TargetDeadBand = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 17:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AccountFeeSpendWindow", wireType)
+				}
+				x.AccountFeeSpendWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AccountFeeSpendWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 19:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DistributionEpochBlocks", wireType)
+				}
+				x.DistributionEpochBlocks = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.DistributionEpochBlocks |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 20:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FeeDiscountTiers", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FeeDiscountTiers = append(x.FeeDiscountTiers, &FeeDiscountTier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.FeeDiscountTiers[len(x.FeeDiscountTiers)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 21:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxRateChangePerBlock", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxRateChangePerBlock = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 22:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BaseGasPriceHistorySize", wireType)
+				}
+				x.BaseGasPriceHistorySize = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.BaseGasPriceHistorySize |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 23:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CompactBaseGasPriceHistory", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.CompactBaseGasPriceHistory = bool(v != 0)
+			case 24:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+				}
+				x.Mode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Mode |= Mode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 25:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BurnFraction", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BurnFraction = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 26:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RecordAnalytics", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.RecordAnalytics = bool(v != 0)
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_FeeDiscountTier           protoreflect.MessageDescriptor
+	fd_FeeDiscountTier_min_spend protoreflect.FieldDescriptor
+	fd_FeeDiscountTier_discount  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_feemarket_feemarket_v1_params_proto_init()
+	md_FeeDiscountTier = File_feemarket_feemarket_v1_params_proto.Messages().ByName("FeeDiscountTier")
+	fd_FeeDiscountTier_min_spend = md_FeeDiscountTier.Fields().ByName("min_spend")
+	fd_FeeDiscountTier_discount = md_FeeDiscountTier.Fields().ByName("discount")
+}
+
+var _ protoreflect.Message = (*fastReflection_FeeDiscountTier)(nil)
+
+type fastReflection_FeeDiscountTier FeeDiscountTier
+
+func (x *FeeDiscountTier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FeeDiscountTier)(x)
+}
+
+func (x *FeeDiscountTier) slowProtoReflect() protoreflect.Message {
+	mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FeeDiscountTier_messageType fastReflection_FeeDiscountTier_messageType
+var _ protoreflect.MessageType = fastReflection_FeeDiscountTier_messageType{}
+
+type fastReflection_FeeDiscountTier_messageType struct{}
+
+func (x fastReflection_FeeDiscountTier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FeeDiscountTier)(nil)
+}
+func (x fastReflection_FeeDiscountTier_messageType) New() protoreflect.Message {
+	return new(fastReflection_FeeDiscountTier)
+}
+func (x fastReflection_FeeDiscountTier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDiscountTier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FeeDiscountTier) Descriptor() protoreflect.MessageDescriptor {
+	return md_FeeDiscountTier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FeeDiscountTier) Type() protoreflect.MessageType {
+	return _fastReflection_FeeDiscountTier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FeeDiscountTier) New() protoreflect.Message {
+	return new(fastReflection_FeeDiscountTier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FeeDiscountTier) Interface() protoreflect.ProtoMessage {
+	return (*FeeDiscountTier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FeeDiscountTier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.MinSpend != "" {
+		value := protoreflect.ValueOfString(x.MinSpend)
+		if !f(fd_FeeDiscountTier_min_spend, value) {
+			return
+		}
+	}
+	if x.Discount != "" {
+		value := protoreflect.ValueOfString(x.Discount)
+		if !f(fd_FeeDiscountTier_discount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FeeDiscountTier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeDiscountTier.min_spend":
+		return x.MinSpend != ""
+	case "feemarket.feemarket.v1.FeeDiscountTier.discount":
+		return x.Discount != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeDiscountTier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeDiscountTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDiscountTier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeDiscountTier.min_spend":
+		x.MinSpend = ""
+	case "feemarket.feemarket.v1.FeeDiscountTier.discount":
+		x.Discount = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeDiscountTier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeDiscountTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FeeDiscountTier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "feemarket.feemarket.v1.FeeDiscountTier.min_spend":
+		value := x.MinSpend
+		return protoreflect.ValueOfString(value)
+	case "feemarket.feemarket.v1.FeeDiscountTier.discount":
+		value := x.Discount
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeDiscountTier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeDiscountTier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDiscountTier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeDiscountTier.min_spend":
+		x.MinSpend = value.Interface().(string)
+	case "feemarket.feemarket.v1.FeeDiscountTier.discount":
+		x.Discount = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeDiscountTier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeDiscountTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDiscountTier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeDiscountTier.min_spend":
+		panic(fmt.Errorf("field min_spend of message feemarket.feemarket.v1.FeeDiscountTier is not mutable"))
+	case "feemarket.feemarket.v1.FeeDiscountTier.discount":
+		panic(fmt.Errorf("field discount of message feemarket.feemarket.v1.FeeDiscountTier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeDiscountTier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeDiscountTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FeeDiscountTier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "feemarket.feemarket.v1.FeeDiscountTier.min_spend":
+		return protoreflect.ValueOfString("")
+	case "feemarket.feemarket.v1.FeeDiscountTier.discount":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: feemarket.feemarket.v1.FeeDiscountTier"))
+		}
+		panic(fmt.Errorf("message feemarket.feemarket.v1.FeeDiscountTier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FeeDiscountTier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in feemarket.feemarket.v1.FeeDiscountTier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FeeDiscountTier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FeeDiscountTier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FeeDiscountTier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FeeDiscountTier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FeeDiscountTier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.MinSpend)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Discount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDiscountTier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Discount) > 0 {
+			i -= len(x.Discount)
+			copy(dAtA[i:], x.Discount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Discount)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.MinSpend) > 0 {
+			i -= len(x.MinSpend)
+			copy(dAtA[i:], x.MinSpend)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MinSpend)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FeeDiscountTier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDiscountTier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FeeDiscountTier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MinSpend", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MinSpend = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Discount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: feemarket/feemarket/v1/params.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// Mode is the algorithm with which the fee market updates the base gas price.
+type Mode int32
+
+const (
+	// MODE_AIMD adjusts the learning rate additively or multiplicatively by the
+	// average utilization of the window.
+	Mode_MODE_AIMD Mode = 0
+	// MODE_EMA smooths the window utilization with an exponential moving average.
+	Mode_MODE_EMA Mode = 1
+)
+
+// Enum value maps for Mode.
+var (
+	Mode_name = map[int32]string{
+		0: "MODE_AIMD",
+		1: "MODE_EMA",
+	}
+	Mode_value = map[string]int32{
+		"MODE_AIMD": 0,
+		"MODE_EMA":  1,
+	}
+)
+
+func (x Mode) Enum() *Mode {
+	p := new(Mode)
+	*p = x
+	return p
+}
+
+func (x Mode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Mode) Descriptor() protoreflect.EnumDescriptor {
+	return file_feemarket_feemarket_v1_params_proto_enumTypes[0].Descriptor()
+}
+
+func (Mode) Type() protoreflect.EnumType {
+	return &file_feemarket_feemarket_v1_params_proto_enumTypes[0]
+}
+
+func (x Mode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Mode.Descriptor instead.
+func (Mode) EnumDescriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{0}
+}
+
+// Params contains the required set of parameters for the EIP1559 fee market
+// plugin implementation.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Alpha is the amount we additively increase the learning rate
+	// when it is above or below the target +/- threshold.
+	//
+	// Must be > 0.
+	Alpha string `protobuf:"bytes,1,opt,name=alpha,proto3" json:"alpha,omitempty"`
+	// Beta is the amount we multiplicatively decrease the learning rate
+	// when it is within the target +/- threshold.
+	//
+	// Must be [0, 1].
+	Beta string `protobuf:"bytes,2,opt,name=beta,proto3" json:"beta,omitempty"`
+	// Gamma is the threshold for the learning rate. If the learning rate is
 	// above or below the target +/- threshold, we additively increase the
 	// learning rate by Alpha. Otherwise, we multiplicatively decrease the
 	// learning rate by Beta.
@@ -1147,6 +2497,73 @@ type Params struct {
 	// DistributeFees is a boolean that determines whether the fees are burned or
 	// distributed to all stakers.
 	DistributeFees bool `protobuf:"varint,12,opt,name=distribute_fees,json=distributeFees,proto3" json:"distribute_fees,omitempty"`
+	// WarmupBlocks is the number of blocks after the fee market is enabled
+	// during which the learning rate adjustment is dampened. The dampening
+	// factor ramps linearly from 1/WarmupBlocks to 1 as the window fills. A
+	// value of zero disables the warmup.
+	WarmupBlocks uint64 `protobuf:"varint,13,opt,name=warmup_blocks,json=warmupBlocks,proto3" json:"warmup_blocks,omitempty"`
+	// EffectiveMinLearningRate is the minimum learning rate applied to the base
+	// gas price adjustment when the current block's utilization is extreme (within
+	// Gamma of empty or full), regardless of how low the nominal learning rate has
+	// been clamped. This guarantees the market always reacts to extreme
+	// conditions. A value of zero disables the guarantee.
+	EffectiveMinLearningRate string `protobuf:"bytes,14,opt,name=effective_min_learning_rate,json=effectiveMinLearningRate,proto3" json:"effective_min_learning_rate,omitempty"`
+	// RejectGasAboveBlockLimit is a boolean that determines whether transactions
+	// requesting more gas than a single block can hold (the lower of
+	// MaxBlockUtilization and the consensus block gas limit) are rejected by the
+	// ante handler, since they can never be included in a block.
+	RejectGasAboveBlockLimit bool `protobuf:"varint,15,opt,name=reject_gas_above_block_limit,json=rejectGasAboveBlockLimit,proto3" json:"reject_gas_above_block_limit,omitempty"`
+	// TargetDeadBand is the maximum relative deviation of the current block's
+	// utilization from the target, as a fraction of the target, within which the
+	// base gas price is left unchanged. This reduces price jitter caused by small
+	// deviations from the target. A value of zero disables the dead-band.
+	TargetDeadBand string `protobuf:"bytes,16,opt,name=target_dead_band,json=targetDeadBand,proto3" json:"target_dead_band,omitempty"`
+	// AccountFeeSpendWindow is the number of blocks over which the fees paid by
+	// each account are retained for spending reports. A value of zero disables
+	// per-account fee tracking.
+	AccountFeeSpendWindow uint64 `protobuf:"varint,17,opt,name=account_fee_spend_window,json=accountFeeSpendWindow,proto3" json:"account_fee_spend_window,omitempty"`
+	// DistributionEpochBlocks is the number of blocks per fee distribution epoch.
+	// When DistributeFees is enabled and this is non-zero, fees are accumulated in
+	// the module account and distributed at the end of the last block of each
+	// epoch rather than in every transaction. A value of zero distributes fees in
+	// every transaction.
+	DistributionEpochBlocks uint64 `protobuf:"varint,19,opt,name=distribution_epoch_blocks,json=distributionEpochBlocks,proto3" json:"distribution_epoch_blocks,omitempty"`
+	// FeeDiscountTiers are the loyalty discounts offered to accounts based on the
+	// fees they paid, in the fee denom, over the account fee spend window. An
+	// account receives the discount of the highest tier whose min spend it has
+	// reached. The tiers must be sorted by strictly increasing min spend.
+	FeeDiscountTiers []*FeeDiscountTier `protobuf:"bytes,20,rep,name=fee_discount_tiers,json=feeDiscountTiers,proto3" json:"fee_discount_tiers,omitempty"`
+	// MaxRateChangePerBlock is the maximum relative change, per block, of the rate
+	// at which the denom resolver converts the fee denom into another denom. A
+	// conversion whose rate moved further from the last good rate is flagged and
+	// priced at the last good rate instead. A value of zero disables the guard.
+	MaxRateChangePerBlock string `protobuf:"bytes,21,opt,name=max_rate_change_per_block,json=maxRateChangePerBlock,proto3" json:"max_rate_change_per_block,omitempty"`
+	// BaseGasPriceHistorySize is the number of most recent blocks for which the
+	// base gas price is retained for the base gas price history query. A value of
+	// zero disables the history.
+	BaseGasPriceHistorySize uint64 `protobuf:"varint,22,opt,name=base_gas_price_history_size,json=baseGasPriceHistorySize,proto3" json:"base_gas_price_history_size,omitempty"`
+	// CompactBaseGasPriceHistory stores the base gas price history as a
+	// delta-encoded series of the price changes instead of one absolute price per
+	// block, which saves space when the price changes less often than every
+	// block, e.g. on archival nodes or at the floor.
+	CompactBaseGasPriceHistory bool `protobuf:"varint,23,opt,name=compact_base_gas_price_history,json=compactBaseGasPriceHistory,proto3" json:"compact_base_gas_price_history,omitempty"`
+	// Mode selects how the base gas price responds to the block utilization. AIMD,
+	// the default, adjusts the learning rate every block and moves the price by
+	// the utilization of the current block. EMA holds the learning rate steady
+	// and moves the price by an exponential moving average of the utilization of
+	// the window, using Alpha as the smoothing factor.
+	Mode Mode `protobuf:"varint,24,opt,name=mode,proto3,enum=feemarket.feemarket.v1.Mode" json:"mode,omitempty"`
+	// BurnFraction is the fraction, in [0, 1], of the base fee of each
+	// transaction that is burned rather than paid to the fee collector. The tip
+	// is never burned. A value of zero sends the entire base fee to the fee
+	// collector.
+	BurnFraction string `protobuf:"bytes,25,opt,name=burn_fraction,json=burnFraction,proto3" json:"burn_fraction,omitempty"`
+	// RecordAnalytics determines whether the fee market records the fee revenue
+	// of each transaction by its primary message type and the base gas price and
+	// utilization observed each block, for the RevenueByMsgType query and the
+	// empirical price elasticity. Recording the revenue is charged to the
+	// transaction.
+	RecordAnalytics bool `protobuf:"varint,26,opt,name=record_analytics,json=recordAnalytics,proto3" json:"record_analytics,omitempty"`
 }
 
 func (x *Params) Reset() {
@@ -1253,6 +2670,145 @@ func (x *Params) GetDistributeFees() bool {
 	return false
 }
 
+func (x *Params) GetWarmupBlocks() uint64 {
+	if x != nil {
+		return x.WarmupBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetEffectiveMinLearningRate() string {
+	if x != nil {
+		return x.EffectiveMinLearningRate
+	}
+	return ""
+}
+
+func (x *Params) GetRejectGasAboveBlockLimit() bool {
+	if x != nil {
+		return x.RejectGasAboveBlockLimit
+	}
+	return false
+}
+
+func (x *Params) GetTargetDeadBand() string {
+	if x != nil {
+		return x.TargetDeadBand
+	}
+	return ""
+}
+
+func (x *Params) GetAccountFeeSpendWindow() uint64 {
+	if x != nil {
+		return x.AccountFeeSpendWindow
+	}
+	return 0
+}
+
+func (x *Params) GetDistributionEpochBlocks() uint64 {
+	if x != nil {
+		return x.DistributionEpochBlocks
+	}
+	return 0
+}
+
+func (x *Params) GetFeeDiscountTiers() []*FeeDiscountTier {
+	if x != nil {
+		return x.FeeDiscountTiers
+	}
+	return nil
+}
+
+func (x *Params) GetMaxRateChangePerBlock() string {
+	if x != nil {
+		return x.MaxRateChangePerBlock
+	}
+	return ""
+}
+
+func (x *Params) GetBaseGasPriceHistorySize() uint64 {
+	if x != nil {
+		return x.BaseGasPriceHistorySize
+	}
+	return 0
+}
+
+func (x *Params) GetCompactBaseGasPriceHistory() bool {
+	if x != nil {
+		return x.CompactBaseGasPriceHistory
+	}
+	return false
+}
+
+func (x *Params) GetMode() Mode {
+	if x != nil {
+		return x.Mode
+	}
+	return Mode_MODE_AIMD
+}
+
+func (x *Params) GetBurnFraction() string {
+	if x != nil {
+		return x.BurnFraction
+	}
+	return ""
+}
+
+func (x *Params) GetRecordAnalytics() bool {
+	if x != nil {
+		return x.RecordAnalytics
+	}
+	return false
+}
+
+// FeeDiscountTier is a loyalty discount offered to accounts that paid at least
+// a minimum amount of fees over the account fee spend window.
+type FeeDiscountTier struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// MinSpend is the minimum amount of fees, in the fee denom, an account must
+	// have paid over the account fee spend window to receive the discount.
+	MinSpend string `protobuf:"bytes,1,opt,name=min_spend,json=minSpend,proto3" json:"min_spend,omitempty"`
+	// Discount is the fraction of the fee that is waived, in [0, 1).
+	Discount string `protobuf:"bytes,2,opt,name=discount,proto3" json:"discount,omitempty"`
+}
+
+func (x *FeeDiscountTier) Reset() {
+	*x = FeeDiscountTier{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_feemarket_feemarket_v1_params_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FeeDiscountTier) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FeeDiscountTier) ProtoMessage() {}
+
+// Deprecated: Use FeeDiscountTier.ProtoReflect.Descriptor instead.
+func (*FeeDiscountTier) Descriptor() ([]byte, []int) {
+	return file_feemarket_feemarket_v1_params_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *FeeDiscountTier) GetMinSpend() string {
+	if x != nil {
+		return x.MinSpend
+	}
+	return ""
+}
+
+func (x *FeeDiscountTier) GetDiscount() string {
+	if x != nil {
+		return x.Discount
+	}
+	return ""
+}
+
 var File_feemarket_feemarket_v1_params_proto protoreflect.FileDescriptor
 
 var file_feemarket_feemarket_v1_params_proto_rawDesc = []byte{
@@ -1262,8 +2818,8 @@ var file_feemarket_feemarket_v1_params_proto_rawDesc = []byte{
 	0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x1a, 0x19, 0x63,
 	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f, 0x73, 0x6d,
 	0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf4,
-	0x05, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x05, 0x61, 0x6c, 0x70,
+	0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xb7,
+	0x0d, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x47, 0x0a, 0x05, 0x61, 0x6c, 0x70,
 	0x68, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
 	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
 	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
@@ -1310,21 +2866,94 @@ var file_feemarket_feemarket_v1_params_proto_rawDesc = []byte{
 	0x01, 0x28, 0x08, 0x52, 0x07, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f,
 	0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18,
 	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
-	0x65, 0x46, 0x65, 0x65, 0x73, 0x42, 0xd8, 0x01, 0x0a, 0x1a, 0x63, 0x6f, 0x6d, 0x2e, 0x66, 0x65,
-	0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x50, 0x72, 0x6f, 0x74,
-	0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
-	0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f,
-	0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x66, 0x65, 0x65,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x46, 0x46, 0x58, 0xaa, 0x02,
-	0x16, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x46, 0x65, 0x65, 0x6d, 0x61,
-	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72,
-	0x6b, 0x65, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31,
-	0xe2, 0x02, 0x22, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x46, 0x65, 0x65,
-	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74,
-	0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
-	0x74, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x56, 0x31,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x77, 0x61, 0x72, 0x6d, 0x75, 0x70, 0x5f,
+	0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x77, 0x61,
+	0x72, 0x6d, 0x75, 0x70, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x70, 0x0a, 0x1b, 0x65, 0x66,
+	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x65, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44,
+	0x65, 0x63, 0x52, 0x18, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x4d, 0x69, 0x6e,
+	0x4c, 0x65, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x61, 0x74, 0x65, 0x12, 0x3e, 0x0a, 0x1c,
+	0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x61, 0x62, 0x6f, 0x76, 0x65,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x18, 0x72, 0x65, 0x6a, 0x65, 0x63, 0x74, 0x47, 0x61, 0x73, 0x41, 0x62, 0x6f,
+	0x76, 0x65, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x5b, 0x0a, 0x10,
+	0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x5f, 0x62, 0x61, 0x6e, 0x64,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b,
+	0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74,
+	0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65,
+	0x74, 0x44, 0x65, 0x61, 0x64, 0x42, 0x61, 0x6e, 0x64, 0x12, 0x37, 0x0a, 0x18, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x77,
+	0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18, 0x11, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x46, 0x65, 0x65, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x57, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x12, 0x3a, 0x0a, 0x19, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x65, 0x70, 0x6f, 0x63, 0x68, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x64, 0x69, 0x73, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x70, 0x6f, 0x63, 0x68, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x5b,
+	0x0a, 0x12, 0x66, 0x65, 0x65, 0x5f, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x74,
+	0x69, 0x65, 0x72, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x66, 0x65, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74,
+	0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54,
+	0x69, 0x65, 0x72, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x00, 0x52, 0x10, 0x66, 0x65, 0x65, 0x44, 0x69,
+	0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69, 0x65, 0x72, 0x73, 0x12, 0x6b, 0x0a, 0x19, 0x6d,
+	0x61, 0x78, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x70,
+	0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x15, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31,
+	0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64,
+	0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79,
+	0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x52, 0x15, 0x6d, 0x61, 0x78, 0x52, 0x61, 0x74, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
+	0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x3c, 0x0a, 0x1b, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x16, 0x20, 0x01, 0x28, 0x04, 0x52, 0x17, 0x62,
+	0x61, 0x73, 0x65, 0x47, 0x61, 0x73, 0x50, 0x72, 0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f,
+	0x72, 0x79, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x42, 0x0a, 0x1e, 0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63,
+	0x74, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65,
+	0x5f, 0x68, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1a,
+	0x63, 0x6f, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x42, 0x61, 0x73, 0x65, 0x47, 0x61, 0x73, 0x50, 0x72,
+	0x69, 0x63, 0x65, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x12, 0x30, 0x0a, 0x04, 0x6d, 0x6f,
+	0x64, 0x65, 0x18, 0x18, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76,
+	0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x65, 0x52, 0x04, 0x6d, 0x6f, 0x64, 0x65, 0x12, 0x56, 0x0a, 0x0d,
+	0x62, 0x75, 0x72, 0x6e, 0x5f, 0x66, 0x72, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x19, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c,
+	0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x0c, 0x62, 0x75, 0x72, 0x6e, 0x46, 0x72, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x5f, 0x61,
+	0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f,
+	0x72, 0x65, 0x63, 0x6f, 0x72, 0x64, 0x41, 0x6e, 0x61, 0x6c, 0x79, 0x74, 0x69, 0x63, 0x73, 0x4a,
+	0x04, 0x08, 0x12, 0x10, 0x13, 0x52, 0x11, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x75, 0x6e,
+	0x75, 0x73, 0x65, 0x64, 0x5f, 0x67, 0x61, 0x73, 0x22, 0xaa, 0x01, 0x0a, 0x0f, 0x46, 0x65, 0x65,
+	0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x54, 0x69, 0x65, 0x72, 0x12, 0x48, 0x0a, 0x09,
+	0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x2b, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xd2, 0xb4,
+	0x2d, 0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x08, 0x6d, 0x69,
+	0x6e, 0x53, 0x70, 0x65, 0x6e, 0x64, 0x12, 0x4d, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x31, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
+	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
+	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xd2, 0xb4, 0x2d,
+	0x0a, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x44, 0x65, 0x63, 0x52, 0x08, 0x64, 0x69, 0x73,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x2a, 0x23, 0x0a, 0x04, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x0d, 0x0a,
+	0x09, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x49, 0x4d, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08,
+	0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x45, 0x4d, 0x41, 0x10, 0x01, 0x42, 0xd8, 0x01, 0x0a, 0x1a, 0x63,
+	0x6f, 0x6d, 0x2e, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x66, 0x65, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x76, 0x31, 0x42, 0x0b, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x33, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73,
+	0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61,
+	0x72, 0x6b, 0x65, 0x74, 0x2f, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2f, 0x76,
+	0x31, 0x3b, 0x66, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x76, 0x31, 0xa2, 0x02, 0x03,
+	0x46, 0x46, 0x58, 0xaa, 0x02, 0x16, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e,
+	0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x16, 0x46,
+	0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b,
+	0x65, 0x74, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x22, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x5c, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x5c, 0x56, 0x31, 0x5c, 0x47,
+	0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x18, 0x46, 0x65, 0x65,
+	0x6d, 0x61, 0x72, 0x6b, 0x65, 0x74, 0x3a, 0x3a, 0x46, 0x65, 0x65, 0x6d, 0x61, 0x72, 0x6b, 0x65,
+	0x74, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1339,16 +2968,21 @@ func file_feemarket_feemarket_v1_params_proto_rawDescGZIP() []byte {
 	return file_feemarket_feemarket_v1_params_proto_rawDescData
 }
 
-var file_feemarket_feemarket_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
+var file_feemarket_feemarket_v1_params_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
+var file_feemarket_feemarket_v1_params_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
 var file_feemarket_feemarket_v1_params_proto_goTypes = []interface{}{
-	(*Params)(nil), // 0: feemarket.feemarket.v1.Params
+	(Mode)(0),               // 0: feemarket.feemarket.v1.Mode
+	(*Params)(nil),          // 1: feemarket.feemarket.v1.Params
+	(*FeeDiscountTier)(nil), // 2: feemarket.feemarket.v1.FeeDiscountTier
 }
 var file_feemarket_feemarket_v1_params_proto_depIdxs = []int32{
-	0, // [0:0] is the sub-list for method output_type
-	0, // [0:0] is the sub-list for method input_type
-	0, // [0:0] is the sub-list for extension type_name
-	0, // [0:0] is the sub-list for extension extendee
-	0, // [0:0] is the sub-list for field type_name
+	2, // 0: feemarket.feemarket.v1.Params.fee_discount_tiers:type_name -> feemarket.feemarket.v1.FeeDiscountTier
+	0, // 1: feemarket.feemarket.v1.Params.mode:type_name -> feemarket.feemarket.v1.Mode
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_feemarket_feemarket_v1_params_proto_init() }
@@ -1369,19 +3003,32 @@ func file_feemarket_feemarket_v1_params_proto_init() {
 				return nil
 			}
 		}
+		file_feemarket_feemarket_v1_params_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FeeDiscountTier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_feemarket_feemarket_v1_params_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   1,
+			NumEnums:      1,
+			NumMessages:   2,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
 		GoTypes:           file_feemarket_feemarket_v1_params_proto_goTypes,
 		DependencyIndexes: file_feemarket_feemarket_v1_params_proto_depIdxs,
+		EnumInfos:         file_feemarket_feemarket_v1_params_proto_enumTypes,
 		MessageInfos:      file_feemarket_feemarket_v1_params_proto_msgTypes,
 	}.Build()
 	File_feemarket_feemarket_v1_params_proto = out.File
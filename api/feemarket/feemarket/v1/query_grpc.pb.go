@@ -19,10 +19,19 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Query_Params_FullMethodName    = "/feemarket.feemarket.v1.Query/Params"
-	Query_State_FullMethodName     = "/feemarket.feemarket.v1.Query/State"
-	Query_GasPrice_FullMethodName  = "/feemarket.feemarket.v1.Query/GasPrice"
-	Query_GasPrices_FullMethodName = "/feemarket.feemarket.v1.Query/GasPrices"
+	Query_Params_FullMethodName                     = "/feemarket.feemarket.v1.Query/Params"
+	Query_State_FullMethodName                      = "/feemarket.feemarket.v1.Query/State"
+	Query_GasPrice_FullMethodName                   = "/feemarket.feemarket.v1.Query/GasPrice"
+	Query_GasPrices_FullMethodName                  = "/feemarket.feemarket.v1.Query/GasPrices"
+	Query_MinGasPriceConfig_FullMethodName          = "/feemarket.feemarket.v1.Query/MinGasPriceConfig"
+	Query_RevenueByMsgType_FullMethodName           = "/feemarket.feemarket.v1.Query/RevenueByMsgType"
+	Query_AccountFeeSpend_FullMethodName            = "/feemarket.feemarket.v1.Query/AccountFeeSpend"
+	Query_EIP1559Equivalent_FullMethodName          = "/feemarket.feemarket.v1.Query/EIP1559Equivalent"
+	Query_BaseGasPriceHistory_FullMethodName        = "/feemarket.feemarket.v1.Query/BaseGasPriceHistory"
+	Query_CommunityPoolContributions_FullMethodName = "/feemarket.feemarket.v1.Query/CommunityPoolContributions"
+	Query_Utilization_FullMethodName                = "/feemarket.feemarket.v1.Query/Utilization"
+	Query_ProjectGasPrice_FullMethodName            = "/feemarket.feemarket.v1.Query/ProjectGasPrice"
+	Query_MarketInfo_FullMethodName                 = "/feemarket.feemarket.v1.Query/MarketInfo"
 )
 
 // QueryClient is the client API for Query service.
@@ -41,6 +50,36 @@ type QueryClient interface {
 	// GasPrices returns the current feemarket module list of gas prices
 	// in all available denoms.
 	GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error)
+	// MinGasPriceConfig returns the current minimum gas price formatted as a
+	// cosmos-sdk minimum-gas-prices string that can be used in a node's config.
+	MinGasPriceConfig(ctx context.Context, in *MinGasPriceConfigRequest, opts ...grpc.CallOption) (*MinGasPriceConfigResponse, error)
+	// RevenueByMsgType returns the fee revenue collected over the window,
+	// attributed to the primary message type of each transaction.
+	RevenueByMsgType(ctx context.Context, in *RevenueByMsgTypeRequest, opts ...grpc.CallOption) (*RevenueByMsgTypeResponse, error)
+	// AccountFeeSpend returns the fees paid by an account over the account fee
+	// spend window.
+	AccountFeeSpend(ctx context.Context, in *AccountFeeSpendRequest, opts ...grpc.CallOption) (*AccountFeeSpendResponse, error)
+	// EIP1559Equivalent returns the current parameters translated into their
+	// EIP-1559 equivalents.
+	EIP1559Equivalent(ctx context.Context, in *EIP1559EquivalentRequest, opts ...grpc.CallOption) (*EIP1559EquivalentResponse, error)
+	// BaseGasPriceHistory returns the base gas prices of the most recent blocks
+	// retained by the base gas price history, ordered by height.
+	BaseGasPriceHistory(ctx context.Context, in *BaseGasPriceHistoryRequest, opts ...grpc.CallOption) (*BaseGasPriceHistoryResponse, error)
+	// CommunityPoolContributions returns the share of the fees distributed by the
+	// fee market that was allocated to the community pool, cumulatively and over
+	// the window.
+	CommunityPoolContributions(ctx context.Context, in *CommunityPoolContributionsRequest, opts ...grpc.CallOption) (*CommunityPoolContributionsResponse, error)
+	// Utilization returns how full the blocks of the window have been, as the
+	// ratio of the gas used over the window to the window's capacity.
+	Utilization(ctx context.Context, in *UtilizationRequest, opts ...grpc.CallOption) (*UtilizationResponse, error)
+	// ProjectGasPrice returns the base gas price and learning rate projected a
+	// number of blocks into the future, assuming every block has the same
+	// utilization.
+	ProjectGasPrice(ctx context.Context, in *ProjectGasPriceRequest, opts ...grpc.CallOption) (*ProjectGasPriceResponse, error)
+	// MarketInfo returns the current feemarket module parameters and state along
+	// with the current minimum gas price in the fee denom, so that a fee quote
+	// can be built from a single request.
+	MarketInfo(ctx context.Context, in *MarketInfoRequest, opts ...grpc.CallOption) (*MarketInfoResponse, error)
 }
 
 type queryClient struct {
@@ -91,6 +130,96 @@ func (c *queryClient) GasPrices(ctx context.Context, in *GasPricesRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) MinGasPriceConfig(ctx context.Context, in *MinGasPriceConfigRequest, opts ...grpc.CallOption) (*MinGasPriceConfigResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MinGasPriceConfigResponse)
+	err := c.cc.Invoke(ctx, Query_MinGasPriceConfig_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RevenueByMsgType(ctx context.Context, in *RevenueByMsgTypeRequest, opts ...grpc.CallOption) (*RevenueByMsgTypeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevenueByMsgTypeResponse)
+	err := c.cc.Invoke(ctx, Query_RevenueByMsgType_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountFeeSpend(ctx context.Context, in *AccountFeeSpendRequest, opts ...grpc.CallOption) (*AccountFeeSpendResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AccountFeeSpendResponse)
+	err := c.cc.Invoke(ctx, Query_AccountFeeSpend_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EIP1559Equivalent(ctx context.Context, in *EIP1559EquivalentRequest, opts ...grpc.CallOption) (*EIP1559EquivalentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(EIP1559EquivalentResponse)
+	err := c.cc.Invoke(ctx, Query_EIP1559Equivalent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseGasPriceHistory(ctx context.Context, in *BaseGasPriceHistoryRequest, opts ...grpc.CallOption) (*BaseGasPriceHistoryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BaseGasPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_BaseGasPriceHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommunityPoolContributions(ctx context.Context, in *CommunityPoolContributionsRequest, opts ...grpc.CallOption) (*CommunityPoolContributionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CommunityPoolContributionsResponse)
+	err := c.cc.Invoke(ctx, Query_CommunityPoolContributions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Utilization(ctx context.Context, in *UtilizationRequest, opts ...grpc.CallOption) (*UtilizationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UtilizationResponse)
+	err := c.cc.Invoke(ctx, Query_Utilization_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectGasPrice(ctx context.Context, in *ProjectGasPriceRequest, opts ...grpc.CallOption) (*ProjectGasPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ProjectGasPriceResponse)
+	err := c.cc.Invoke(ctx, Query_ProjectGasPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketInfo(ctx context.Context, in *MarketInfoRequest, opts ...grpc.CallOption) (*MarketInfoResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarketInfoResponse)
+	err := c.cc.Invoke(ctx, Query_MarketInfo_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -107,6 +236,36 @@ type QueryServer interface {
 	// GasPrices returns the current feemarket module list of gas prices
 	// in all available denoms.
 	GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error)
+	// MinGasPriceConfig returns the current minimum gas price formatted as a
+	// cosmos-sdk minimum-gas-prices string that can be used in a node's config.
+	MinGasPriceConfig(context.Context, *MinGasPriceConfigRequest) (*MinGasPriceConfigResponse, error)
+	// RevenueByMsgType returns the fee revenue collected over the window,
+	// attributed to the primary message type of each transaction.
+	RevenueByMsgType(context.Context, *RevenueByMsgTypeRequest) (*RevenueByMsgTypeResponse, error)
+	// AccountFeeSpend returns the fees paid by an account over the account fee
+	// spend window.
+	AccountFeeSpend(context.Context, *AccountFeeSpendRequest) (*AccountFeeSpendResponse, error)
+	// EIP1559Equivalent returns the current parameters translated into their
+	// EIP-1559 equivalents.
+	EIP1559Equivalent(context.Context, *EIP1559EquivalentRequest) (*EIP1559EquivalentResponse, error)
+	// BaseGasPriceHistory returns the base gas prices of the most recent blocks
+	// retained by the base gas price history, ordered by height.
+	BaseGasPriceHistory(context.Context, *BaseGasPriceHistoryRequest) (*BaseGasPriceHistoryResponse, error)
+	// CommunityPoolContributions returns the share of the fees distributed by the
+	// fee market that was allocated to the community pool, cumulatively and over
+	// the window.
+	CommunityPoolContributions(context.Context, *CommunityPoolContributionsRequest) (*CommunityPoolContributionsResponse, error)
+	// Utilization returns how full the blocks of the window have been, as the
+	// ratio of the gas used over the window to the window's capacity.
+	Utilization(context.Context, *UtilizationRequest) (*UtilizationResponse, error)
+	// ProjectGasPrice returns the base gas price and learning rate projected a
+	// number of blocks into the future, assuming every block has the same
+	// utilization.
+	ProjectGasPrice(context.Context, *ProjectGasPriceRequest) (*ProjectGasPriceResponse, error)
+	// MarketInfo returns the current feemarket module parameters and state along
+	// with the current minimum gas price in the fee denom, so that a fee quote
+	// can be built from a single request.
+	MarketInfo(context.Context, *MarketInfoRequest) (*MarketInfoResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -126,6 +285,33 @@ func (UnimplementedQueryServer) GasPrice(context.Context, *GasPriceRequest) (*Ga
 func (UnimplementedQueryServer) GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
 }
+func (UnimplementedQueryServer) MinGasPriceConfig(context.Context, *MinGasPriceConfigRequest) (*MinGasPriceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinGasPriceConfig not implemented")
+}
+func (UnimplementedQueryServer) RevenueByMsgType(context.Context, *RevenueByMsgTypeRequest) (*RevenueByMsgTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevenueByMsgType not implemented")
+}
+func (UnimplementedQueryServer) AccountFeeSpend(context.Context, *AccountFeeSpendRequest) (*AccountFeeSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountFeeSpend not implemented")
+}
+func (UnimplementedQueryServer) EIP1559Equivalent(context.Context, *EIP1559EquivalentRequest) (*EIP1559EquivalentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EIP1559Equivalent not implemented")
+}
+func (UnimplementedQueryServer) BaseGasPriceHistory(context.Context, *BaseGasPriceHistoryRequest) (*BaseGasPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseGasPriceHistory not implemented")
+}
+func (UnimplementedQueryServer) CommunityPoolContributions(context.Context, *CommunityPoolContributionsRequest) (*CommunityPoolContributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolContributions not implemented")
+}
+func (UnimplementedQueryServer) Utilization(context.Context, *UtilizationRequest) (*UtilizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Utilization not implemented")
+}
+func (UnimplementedQueryServer) ProjectGasPrice(context.Context, *ProjectGasPriceRequest) (*ProjectGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectGasPrice not implemented")
+}
+func (UnimplementedQueryServer) MarketInfo(context.Context, *MarketInfoRequest) (*MarketInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketInfo not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -211,6 +397,168 @@ func _Query_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_MinGasPriceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MinGasPriceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinGasPriceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MinGasPriceConfig_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinGasPriceConfig(ctx, req.(*MinGasPriceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RevenueByMsgType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevenueByMsgTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RevenueByMsgType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RevenueByMsgType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RevenueByMsgType(ctx, req.(*RevenueByMsgTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountFeeSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountFeeSpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountFeeSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_AccountFeeSpend_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountFeeSpend(ctx, req.(*AccountFeeSpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EIP1559Equivalent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EIP1559EquivalentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EIP1559Equivalent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_EIP1559Equivalent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EIP1559Equivalent(ctx, req.(*EIP1559EquivalentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseGasPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseGasPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseGasPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_BaseGasPriceHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseGasPriceHistory(ctx, req.(*BaseGasPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunityPoolContributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommunityPoolContributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityPoolContributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CommunityPoolContributions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityPoolContributions(ctx, req.(*CommunityPoolContributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Utilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UtilizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Utilization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Utilization_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Utilization(ctx, req.(*UtilizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ProjectGasPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectGasPrice(ctx, req.(*ProjectGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MarketInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketInfo(ctx, req.(*MarketInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -234,6 +582,42 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GasPrices",
 			Handler:    _Query_GasPrices_Handler,
 		},
+		{
+			MethodName: "MinGasPriceConfig",
+			Handler:    _Query_MinGasPriceConfig_Handler,
+		},
+		{
+			MethodName: "RevenueByMsgType",
+			Handler:    _Query_RevenueByMsgType_Handler,
+		},
+		{
+			MethodName: "AccountFeeSpend",
+			Handler:    _Query_AccountFeeSpend_Handler,
+		},
+		{
+			MethodName: "EIP1559Equivalent",
+			Handler:    _Query_EIP1559Equivalent_Handler,
+		},
+		{
+			MethodName: "BaseGasPriceHistory",
+			Handler:    _Query_BaseGasPriceHistory_Handler,
+		},
+		{
+			MethodName: "CommunityPoolContributions",
+			Handler:    _Query_CommunityPoolContributions_Handler,
+		},
+		{
+			MethodName: "Utilization",
+			Handler:    _Query_Utilization_Handler,
+		},
+		{
+			MethodName: "ProjectGasPrice",
+			Handler:    _Query_ProjectGasPrice_Handler,
+		},
+		{
+			MethodName: "MarketInfo",
+			Handler:    _Query_MarketInfo_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feemarket/feemarket/v1/query.proto",
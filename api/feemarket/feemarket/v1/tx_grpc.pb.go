@@ -19,7 +19,11 @@ import (
 const _ = grpc.SupportPackageIsVersion8
 
 const (
-	Msg_Params_FullMethodName = "/feemarket.feemarket.v1.Msg/Params"
+	Msg_Params_FullMethodName                          = "/feemarket.feemarket.v1.Msg/Params"
+	Msg_ResetCommunityPoolContributions_FullMethodName = "/feemarket.feemarket.v1.Msg/ResetCommunityPoolContributions"
+	Msg_SetResolver_FullMethodName                     = "/feemarket.feemarket.v1.Msg/SetResolver"
+	Msg_SetMsgTypeMultiplier_FullMethodName            = "/feemarket.feemarket.v1.Msg/SetMsgTypeMultiplier"
+	Msg_SetDenomMinGasPrice_FullMethodName             = "/feemarket.feemarket.v1.Msg/SetDenomMinGasPrice"
 )
 
 // MsgClient is the client API for Msg service.
@@ -31,6 +35,18 @@ const (
 type MsgClient interface {
 	// Params defines a method for updating the feemarket module parameters.
 	Params(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error)
+	// ResetCommunityPoolContributions defines a method for resetting the
+	// cumulative community pool contributions.
+	ResetCommunityPoolContributions(ctx context.Context, in *MsgResetCommunityPoolContributions, opts ...grpc.CallOption) (*MsgResetCommunityPoolContributionsResponse, error)
+	// SetResolver defines a method for selecting the denom resolver by the name
+	// it was registered under.
+	SetResolver(ctx context.Context, in *MsgSetResolver, opts ...grpc.CallOption) (*MsgSetResolverResponse, error)
+	// SetMsgTypeMultiplier defines a method for setting the gas price multiplier
+	// applied to a message type.
+	SetMsgTypeMultiplier(ctx context.Context, in *MsgSetMsgTypeMultiplier, opts ...grpc.CallOption) (*MsgSetMsgTypeMultiplierResponse, error)
+	// SetDenomMinGasPrice defines a method for setting the minimum gas price
+	// enforced for a denom.
+	SetDenomMinGasPrice(ctx context.Context, in *MsgSetDenomMinGasPrice, opts ...grpc.CallOption) (*MsgSetDenomMinGasPriceResponse, error)
 }
 
 type msgClient struct {
@@ -51,6 +67,46 @@ func (c *msgClient) Params(ctx context.Context, in *MsgParams, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) ResetCommunityPoolContributions(ctx context.Context, in *MsgResetCommunityPoolContributions, opts ...grpc.CallOption) (*MsgResetCommunityPoolContributionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgResetCommunityPoolContributionsResponse)
+	err := c.cc.Invoke(ctx, Msg_ResetCommunityPoolContributions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetResolver(ctx context.Context, in *MsgSetResolver, opts ...grpc.CallOption) (*MsgSetResolverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetResolverResponse)
+	err := c.cc.Invoke(ctx, Msg_SetResolver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMsgTypeMultiplier(ctx context.Context, in *MsgSetMsgTypeMultiplier, opts ...grpc.CallOption) (*MsgSetMsgTypeMultiplierResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetMsgTypeMultiplierResponse)
+	err := c.cc.Invoke(ctx, Msg_SetMsgTypeMultiplier_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMinGasPrice(ctx context.Context, in *MsgSetDenomMinGasPrice, opts ...grpc.CallOption) (*MsgSetDenomMinGasPriceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MsgSetDenomMinGasPriceResponse)
+	err := c.cc.Invoke(ctx, Msg_SetDenomMinGasPrice_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -60,6 +116,18 @@ func (c *msgClient) Params(ctx context.Context, in *MsgParams, opts ...grpc.Call
 type MsgServer interface {
 	// Params defines a method for updating the feemarket module parameters.
 	Params(context.Context, *MsgParams) (*MsgParamsResponse, error)
+	// ResetCommunityPoolContributions defines a method for resetting the
+	// cumulative community pool contributions.
+	ResetCommunityPoolContributions(context.Context, *MsgResetCommunityPoolContributions) (*MsgResetCommunityPoolContributionsResponse, error)
+	// SetResolver defines a method for selecting the denom resolver by the name
+	// it was registered under.
+	SetResolver(context.Context, *MsgSetResolver) (*MsgSetResolverResponse, error)
+	// SetMsgTypeMultiplier defines a method for setting the gas price multiplier
+	// applied to a message type.
+	SetMsgTypeMultiplier(context.Context, *MsgSetMsgTypeMultiplier) (*MsgSetMsgTypeMultiplierResponse, error)
+	// SetDenomMinGasPrice defines a method for setting the minimum gas price
+	// enforced for a denom.
+	SetDenomMinGasPrice(context.Context, *MsgSetDenomMinGasPrice) (*MsgSetDenomMinGasPriceResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -70,6 +138,18 @@ type UnimplementedMsgServer struct {
 func (UnimplementedMsgServer) Params(context.Context, *MsgParams) (*MsgParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedMsgServer) ResetCommunityPoolContributions(context.Context, *MsgResetCommunityPoolContributions) (*MsgResetCommunityPoolContributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCommunityPoolContributions not implemented")
+}
+func (UnimplementedMsgServer) SetResolver(context.Context, *MsgSetResolver) (*MsgSetResolverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetResolver not implemented")
+}
+func (UnimplementedMsgServer) SetMsgTypeMultiplier(context.Context, *MsgSetMsgTypeMultiplier) (*MsgSetMsgTypeMultiplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMsgTypeMultiplier not implemented")
+}
+func (UnimplementedMsgServer) SetDenomMinGasPrice(context.Context, *MsgSetDenomMinGasPrice) (*MsgSetDenomMinGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMinGasPrice not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -101,6 +181,78 @@ func _Msg_Params_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_ResetCommunityPoolContributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetCommunityPoolContributions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetCommunityPoolContributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ResetCommunityPoolContributions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetCommunityPoolContributions(ctx, req.(*MsgResetCommunityPoolContributions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetResolver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetResolver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetResolver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetResolver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetResolver(ctx, req.(*MsgSetResolver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMsgTypeMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMsgTypeMultiplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMsgTypeMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetMsgTypeMultiplier_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMsgTypeMultiplier(ctx, req.(*MsgSetMsgTypeMultiplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMinGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMinGasPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMinGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetDenomMinGasPrice_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMinGasPrice(ctx, req.(*MsgSetDenomMinGasPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -112,6 +264,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Msg_Params_Handler,
 		},
+		{
+			MethodName: "ResetCommunityPoolContributions",
+			Handler:    _Msg_ResetCommunityPoolContributions_Handler,
+		},
+		{
+			MethodName: "SetResolver",
+			Handler:    _Msg_SetResolver_Handler,
+		},
+		{
+			MethodName: "SetMsgTypeMultiplier",
+			Handler:    _Msg_SetMsgTypeMultiplier_Handler,
+		},
+		{
+			MethodName: "SetDenomMinGasPrice",
+			Handler:    _Msg_SetDenomMinGasPrice_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "feemarket/feemarket/v1/tx.proto",
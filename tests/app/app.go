@@ -372,6 +372,10 @@ func NewSimApp(
 	)
 
 	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(appCodec, keys[feemarkettypes.StoreKey], app.AccountKeeper, &feemarkettypes.TestDenomResolver{}, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+	app.FeeMarketKeeper.SetDenomMetadataKeeper(app.BankKeeper)
+	app.FeeMarketKeeper.SetDistributionKeeper(distrkeeper.NewQuerier(app.DistrKeeper))
+	app.FeeMarketKeeper.SetStakingKeeper(app.StakingKeeper)
+	app.FeeMarketKeeper.SetBankKeeper(app.BankKeeper)
 
 	/****  Module Options ****/
 
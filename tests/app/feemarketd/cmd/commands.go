@@ -28,6 +28,7 @@ import (
 	"github.com/spf13/viper"
 
 	"github.com/skip-mev/feemarket/tests/app"
+	feemarketcli "github.com/skip-mev/feemarket/x/feemarket/client/cli"
 )
 
 // initCometBFTConfig helps to override default CometBFT Config values.
@@ -124,7 +125,7 @@ func initRootCmd(
 	// add keybase, auxiliary RPC, query, genesis, and tx child commands
 	rootCmd.AddCommand(
 		server.StatusCommand(),
-		genesisCommand(txConfig, basicManager),
+		genesisCommand(txConfig, basicManager, feemarketcli.GetGenesisTemplateCmd()),
 		queryCommand(),
 		txCommand(),
 		keys.Commands(),
@@ -91,6 +91,11 @@ func (s *IntegrationTestSuite) TestParams() {
 			MaxBlockUtilization: 10,
 			Window:              1,
 			Enabled:             true,
+
+			EffectiveMinLearningRate: math.LegacyZeroDec(),
+			TargetDeadBand:           math.LegacyZeroDec(),
+			MaxRateChangePerBlock:    math.LegacyZeroDec(),
+			BurnFraction:             math.LegacyZeroDec(),
 		}
 
 		err := s.FeeMarketKeeper.SetParams(s.ctx, params)
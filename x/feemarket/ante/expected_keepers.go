@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
@@ -45,8 +46,18 @@ type BankKeeper interface {
 type FeeMarketKeeper interface {
 	GetState(ctx sdk.Context) (feemarkettypes.State, error)
 	GetMinGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, error)
+	GetFloorGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, error)
+	IsFirstBlock(ctx sdk.Context) (bool, error)
+	GetEnabledHeight(ctx sdk.Context) (int64, error)
+	GetFrozenHeight(ctx sdk.Context) (int64, error)
 	GetParams(ctx sdk.Context) (feemarkettypes.Params, error)
 	SetState(ctx sdk.Context, state feemarkettypes.State) error
 	SetParams(ctx sdk.Context, params feemarkettypes.Params) error
 	ResolveToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error)
+	ResolveToDenomCached(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error)
+	MaxMsgTypeMultiplier(ctx sdk.Context, msgs []sdk.Msg) (math.LegacyDec, error)
+	IsFeeExempt(ctx sdk.Context, addr sdk.AccAddress) bool
+	AccountFeeDiscount(ctx sdk.Context, params feemarkettypes.Params, addr sdk.AccAddress) (math.LegacyDec, error)
+	RecordAccountFeeSpend(ctx sdk.Context, addr sdk.AccAddress, fees sdk.Coins) error
+	CheckFee(ctx sdk.Context, tx sdk.Tx, simulate bool) (*feemarkettypes.FeeCheck, error)
 }
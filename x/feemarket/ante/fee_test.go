@@ -1,17 +1,24 @@
 package ante_test
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	_ "github.com/cosmos/cosmos-sdk/x/auth"
 
+	feemarketante "github.com/skip-mev/feemarket/x/feemarket/ante"
+	"github.com/skip-mev/feemarket/x/feemarket/ante/mocks"
 	antesuite "github.com/skip-mev/feemarket/x/feemarket/ante/suite"
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
@@ -414,6 +421,64 @@ func TestAnteHandle(t *testing.T) {
 			ExpErr:   sdkerrors.ErrOutOfGas,
 			Mock:     false,
 		},
+		{
+			Name: "gas above block limit - reject enabled - fail",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				params, err := s.FeeMarketKeeper.GetParams(s.Ctx)
+				require.NoError(t, err)
+				params.MaxBlockUtilization = gasLimit - 1
+				params.RejectGasAboveBlockLimit = true
+				require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+				accs := s.CreateTestAccounts(1)
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validFee,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: validFee,
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  false,
+			ExpErr:   types.ErrGasExceedsBlockLimit,
+			Mock:     false,
+		},
+		{
+			Name: "gas above block limit - reject disabled - pass",
+			Malleate: func(s *antesuite.TestSuite) antesuite.TestCaseArgs {
+				params, err := s.FeeMarketKeeper.GetParams(s.Ctx)
+				require.NoError(t, err)
+				params.MaxBlockUtilization = gasLimit - 1
+				params.RejectGasAboveBlockLimit = false
+				require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+				accs := s.CreateTestAccounts(1)
+				balance := antesuite.TestAccountBalance{
+					TestAccount: accs[0],
+					Coins:       validFee,
+				}
+				s.SetAccountBalances([]antesuite.TestAccountBalance{balance})
+
+				return antesuite.TestCaseArgs{
+					Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+					GasLimit:  gasLimit,
+					FeeAmount: validFee,
+				}
+			},
+			RunAnte:  true,
+			RunPost:  false,
+			Simulate: false,
+			ExpPass:  true,
+			ExpErr:   nil,
+			Mock:     false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -426,3 +491,455 @@ func TestAnteHandle(t *testing.T) {
 		})
 	}
 }
+
+func TestAnteHandleTracing(t *testing.T) {
+	gasLimit := antesuite.NewTestGasLimit()
+	validFeeAmount := types.DefaultMinBaseGasPrice.MulInt64(int64(gasLimit))
+	validFee := sdk.NewCoins(sdk.NewCoin("stake", validFeeAmount.TruncateInt()))
+
+	s := antesuite.SetupTestSuite(t, false)
+	s.TxBuilder = s.ClientCtx.TxConfig.NewTxBuilder()
+
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	decorator := feemarketante.NewFeeMarketCheckDecorator(
+		s.AccountKeeper,
+		s.BankKeeper,
+		s.FeeGrantKeeper,
+		s.FeeMarketKeeper,
+		nil,
+	).WithTracer(provider.Tracer(types.ModuleName))
+	s.AnteHandler = sdk.ChainAnteDecorators(decorator)
+
+	accs := s.CreateTestAccounts(1)
+	s.SetAccountBalances([]antesuite.TestAccountBalance{{TestAccount: accs[0], Coins: validFee}})
+
+	tc := antesuite.TestCase{RunAnte: true, ExpPass: true}
+	s.RunTestCase(t, tc, antesuite.TestCaseArgs{
+		Msgs:      []sdk.Msg{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+		GasLimit:  gasLimit,
+		FeeAmount: validFee,
+	})
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	require.Equal(t, types.SpanFeeCheck, spans[0].Name())
+
+	attrs := make(map[string]string)
+	for _, attr := range spans[0].Attributes() {
+		attrs[string(attr.Key)] = attr.Value.Emit()
+	}
+
+	require.Equal(t, validFee[0].String(), attrs[types.SpanAttributeKeyFee])
+	require.Equal(t, fmt.Sprintf("%d", gasLimit), attrs[types.SpanAttributeKeyGasLimit])
+	require.Contains(t, attrs, types.SpanAttributeKeyMinGasPrice)
+	require.Contains(t, attrs, types.SpanAttributeKeyTxPriority)
+}
+
+func TestAnteHandleFeeExemptModuleAccount(t *testing.T) {
+	gasLimit := antesuite.NewTestGasLimit()
+	validFeeAmount := types.DefaultMinBaseGasPrice.MulInt64(int64(gasLimit))
+	validFee := sdk.NewCoins(sdk.NewCoin("stake", validFeeAmount.TruncateInt()))
+
+	s := antesuite.SetupTestSuite(t, false)
+	require.NoError(t, s.FeeMarketKeeper.SetFeeExemptModuleAccounts(types.ModuleName))
+
+	decorator := feemarketante.NewFeeMarketCheckDecorator(
+		s.AccountKeeper,
+		s.BankKeeper,
+		s.FeeGrantKeeper,
+		s.FeeMarketKeeper,
+		nil,
+	)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+	accs := s.CreateTestAccounts(2)
+
+	buildTx := func(payer sdk.AccAddress) sdk.Tx {
+		txBuilder := s.ClientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(payer)))
+		txBuilder.SetFeeAmount(validFee)
+		txBuilder.SetGasLimit(gasLimit)
+		txBuilder.SetFeePayer(payer)
+		return txBuilder.GetTx()
+	}
+
+	t.Run("allowlisted module account skips deduction", func(t *testing.T) {
+		addr := s.AccountKeeper.GetModuleAccount(s.Ctx, types.ModuleName).GetAddress()
+
+		// the module account holds no funds, so it would fail if it were charged
+		_, err := decorator.AnteHandle(s.Ctx, buildTx(addr), false, next)
+		require.NoError(t, err)
+		require.True(t, s.BankKeeper.GetAllBalances(s.Ctx, addr).IsZero())
+	})
+
+	t.Run("module account not on the allowlist is charged", func(t *testing.T) {
+		addr := s.AccountKeeper.GetModuleAccount(s.Ctx, types.FeeCollectorName).GetAddress()
+
+		_, err := decorator.AnteHandle(s.Ctx, buildTx(addr), false, next)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	})
+
+	t.Run("regular account is charged", func(t *testing.T) {
+		s.SetAccountBalances([]antesuite.TestAccountBalance{{TestAccount: accs[0], Coins: validFee}})
+		addr := accs[0].Account.GetAddress()
+
+		_, err := decorator.AnteHandle(s.Ctx, buildTx(addr), false, next)
+		require.NoError(t, err)
+		require.True(t, s.BankKeeper.GetAllBalances(s.Ctx, addr).IsZero())
+	})
+
+	t.Run("regular account naming an allowlisted module account as granter is rejected", func(t *testing.T) {
+		moduleAcc := s.AccountKeeper.GetModuleAccount(s.Ctx, types.ModuleName)
+		s.SetAccountBalances([]antesuite.TestAccountBalance{{TestAccount: antesuite.TestAccount{Account: moduleAcc}, Coins: validFee}})
+		addr := accs[1].Account.GetAddress()
+
+		txBuilder := s.ClientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		txBuilder.SetFeeAmount(validFee)
+		txBuilder.SetGasLimit(gasLimit)
+		txBuilder.SetFeePayer(addr)
+		txBuilder.SetFeeGranter(moduleAcc.GetAddress())
+
+		// the module account granted no allowance, so it cannot be made to pay
+		_, err := decorator.AnteHandle(s.Ctx, txBuilder.GetTx(), false, next)
+		require.ErrorContains(t, err, "does not allow to pay fees")
+		require.Equal(t, validFee, s.BankKeeper.GetAllBalances(s.Ctx, moduleAcc.GetAddress()))
+	})
+}
+
+func TestAnteHandleFirstBlock(t *testing.T) {
+	gasLimit := antesuite.NewTestGasLimit()
+	floorFeeAmount := types.DefaultMinBaseGasPrice.MulInt64(int64(gasLimit))
+	floorFee := sdk.NewCoins(sdk.NewCoin("stake", floorFeeAmount.TruncateInt()))
+
+	s := antesuite.SetupTestSuite(t, false)
+	s.FeeMarketKeeper.SetFirstBlockAtFloor(true)
+
+	// the stored price is well above the floor
+	state, err := s.FeeMarketKeeper.GetState(s.Ctx)
+	require.NoError(t, err)
+	state.BaseGasPrice = types.DefaultMinBaseGasPrice.MulInt64(10)
+	require.NoError(t, s.FeeMarketKeeper.SetState(s.Ctx, state))
+
+	decorator := feemarketante.NewFeeMarketCheckDecorator(
+		s.AccountKeeper,
+		s.BankKeeper,
+		s.FeeGrantKeeper,
+		s.FeeMarketKeeper,
+		nil,
+	)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	accs := s.CreateTestAccounts(2)
+	buildTx := func(acc antesuite.TestAccount) sdk.Tx {
+		s.SetAccountBalances([]antesuite.TestAccountBalance{{TestAccount: acc, Coins: floorFee}})
+
+		txBuilder := s.ClientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(acc.Account.GetAddress())))
+		txBuilder.SetFeeAmount(floorFee)
+		txBuilder.SetGasLimit(gasLimit)
+		return txBuilder.GetTx()
+	}
+
+	t.Run("first block is priced at the floor", func(t *testing.T) {
+		s.FeeMarketKeeper.SetEnabledHeight(s.Ctx, s.Ctx.BlockHeight())
+
+		_, err := decorator.AnteHandle(s.Ctx, buildTx(accs[0]), false, next)
+		require.NoError(t, err)
+	})
+
+	t.Run("later blocks are priced at the stored price", func(t *testing.T) {
+		s.FeeMarketKeeper.SetEnabledHeight(s.Ctx, s.Ctx.BlockHeight()-1)
+
+		_, err := decorator.AnteHandle(s.Ctx, buildTx(accs[1]), false, next)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	})
+}
+
+func TestAnteHandleMsgTypeMultiplier(t *testing.T) {
+	gasLimit := antesuite.NewTestGasLimit()
+	baseFeeAmount := types.DefaultMinBaseGasPrice.MulInt64(int64(gasLimit))
+	baseFee := sdk.NewCoins(sdk.NewCoin("stake", baseFeeAmount.TruncateInt()))
+	doubleFee := sdk.NewCoins(sdk.NewCoin("stake", baseFeeAmount.MulInt64(2).TruncateInt()))
+
+	s := antesuite.SetupTestSuite(t, false)
+	require.NoError(t, s.FeeMarketKeeper.SetMsgTypeMultiplier(s.Ctx, sdk.MsgTypeURL(&testdata.TestMsg{}), math.LegacyNewDec(2)))
+
+	decorator := feemarketante.NewFeeMarketCheckDecorator(
+		s.AccountKeeper,
+		s.BankKeeper,
+		s.FeeGrantKeeper,
+		s.FeeMarketKeeper,
+		nil,
+	)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	accs := s.CreateTestAccounts(2)
+	s.SetAccountBalances([]antesuite.TestAccountBalance{
+		{TestAccount: accs[0], Coins: doubleFee},
+		{TestAccount: accs[1], Coins: doubleFee},
+	})
+
+	buildTx := func(acc antesuite.TestAccount, fee sdk.Coins) sdk.Tx {
+		txBuilder := s.ClientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(acc.Account.GetAddress())))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(gasLimit)
+		return txBuilder.GetTx()
+	}
+
+	t.Run("the base fee is not enough", func(t *testing.T) {
+		_, err := decorator.AnteHandle(s.Ctx, buildTx(accs[0], baseFee), false, next)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	})
+
+	t.Run("the multiplied fee is accepted", func(t *testing.T) {
+		_, err := decorator.AnteHandle(s.Ctx, buildTx(accs[1], doubleFee), false, next)
+		require.NoError(t, err)
+	})
+}
+
+func TestAnteHandleBeforeEnabledHeight(t *testing.T) {
+	gasLimit := antesuite.NewTestGasLimit()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("stake", 1))
+
+	s := antesuite.SetupTestSuite(t, false)
+
+	decorator := feemarketante.NewFeeMarketCheckDecorator(
+		s.AccountKeeper,
+		s.BankKeeper,
+		s.FeeGrantKeeper,
+		s.FeeMarketKeeper,
+		nil,
+	)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	accs := s.CreateTestAccounts(1)
+	s.SetAccountBalances([]antesuite.TestAccountBalance{{TestAccount: accs[0], Coins: fee}})
+	addr := accs[0].Account.GetAddress()
+
+	txBuilder := s.ClientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(gasLimit)
+	tx := txBuilder.GetTx()
+
+	t.Run("blocks before the enabled height are not checked", func(t *testing.T) {
+		s.FeeMarketKeeper.SetEnabledHeight(s.Ctx, s.Ctx.BlockHeight()+1)
+
+		_, err := decorator.AnteHandle(s.Ctx, tx, false, next)
+		require.NoError(t, err)
+		require.Equal(t, fee, s.BankKeeper.GetAllBalances(s.Ctx, addr))
+	})
+
+	t.Run("the enabled height is checked", func(t *testing.T) {
+		s.FeeMarketKeeper.SetEnabledHeight(s.Ctx, s.Ctx.BlockHeight())
+
+		_, err := decorator.AnteHandle(s.Ctx, tx, false, next)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	})
+
+	t.Run("an enabled height that cannot be read is an error", func(t *testing.T) {
+		fmk := mocks.NewFeeMarketKeeper(t)
+		fmk.On("GetParams", mock.Anything).Return(types.DefaultParams(), nil)
+		fmk.On("CheckFee", mock.Anything, tx, false).Return(nil, errors.New("corrupt enabled height"))
+
+		decorator := feemarketante.NewFeeMarketCheckDecorator(
+			s.AccountKeeper,
+			s.BankKeeper,
+			s.FeeGrantKeeper,
+			fmk,
+			nil,
+		)
+
+		_, err := decorator.AnteHandle(s.Ctx, tx, false, next)
+		require.ErrorContains(t, err, "corrupt enabled height")
+		require.Equal(t, fee, s.BankKeeper.GetAllBalances(s.Ctx, addr))
+	})
+}
+
+func TestAnteHandleZeroRequiredFee(t *testing.T) {
+	gasLimit := antesuite.NewTestGasLimit()
+	fee := sdk.NewCoins(sdk.NewInt64Coin("foo", 1))
+
+	s := antesuite.SetupTestSuite(t, false)
+
+	// a buggy resolver converts the positive price to zero while the market is enabled
+	s.FeeMarketKeeper.SetDenomResolver(&zeroDenomResolver{})
+
+	decorator := feemarketante.NewFeeMarketCheckDecorator(
+		s.AccountKeeper,
+		s.BankKeeper,
+		s.FeeGrantKeeper,
+		s.FeeMarketKeeper,
+		nil,
+	)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	accs := s.CreateTestAccounts(1)
+	s.SetAccountBalances([]antesuite.TestAccountBalance{{TestAccount: accs[0], Coins: fee}})
+
+	txBuilder := s.ClientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].Account.GetAddress())))
+	txBuilder.SetFeeAmount(fee)
+	txBuilder.SetGasLimit(gasLimit)
+	tx := txBuilder.GetTx()
+
+	t.Run("zero required fee while enabled is rejected", func(t *testing.T) {
+		_, err := decorator.AnteHandle(s.Ctx, tx, false, next)
+		require.ErrorIs(t, err, types.ErrZeroRequiredFee)
+	})
+
+	t.Run("simulation is not guarded", func(t *testing.T) {
+		_, err := decorator.AnteHandle(s.Ctx, tx, true, next)
+		require.NoError(t, err)
+	})
+
+	t.Run("a zero min base gas price is not guarded", func(t *testing.T) {
+		params, err := s.FeeMarketKeeper.GetParams(s.Ctx)
+		require.NoError(t, err)
+		params.MinBaseGasPrice = math.LegacyZeroDec()
+		require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+		_, err = decorator.AnteHandle(s.Ctx, tx, false, next)
+		require.NoError(t, err)
+	})
+
+	t.Run("a disabled fee market is not guarded", func(t *testing.T) {
+		params, err := s.FeeMarketKeeper.GetParams(s.Ctx)
+		require.NoError(t, err)
+		params.MinBaseGasPrice = types.DefaultMinBaseGasPrice
+		params.Enabled = false
+		require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+		_, err = decorator.AnteHandle(s.Ctx, tx, false, next)
+		require.NoError(t, err)
+	})
+}
+
+// zeroDenomResolver converts every coin to a zero amount of the requested denom.
+type zeroDenomResolver struct{}
+
+func (r *zeroDenomResolver) ConvertToDenom(_ sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	return sdk.NewDecCoinFromDec(denom, math.LegacyZeroDec()), nil
+}
+
+func (r *zeroDenomResolver) ExtraDenoms(_ sdk.Context) ([]string, error) {
+	return []string{}, nil
+}
+
+func TestAnteHandleSimulate(t *testing.T) {
+	gasLimit := antesuite.NewTestGasLimit()
+
+	s := antesuite.SetupTestSuite(t, false)
+
+	decorator := feemarketante.NewFeeMarketCheckDecorator(
+		s.AccountKeeper,
+		s.BankKeeper,
+		s.FeeGrantKeeper,
+		s.FeeMarketKeeper,
+		nil,
+	)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	accs := s.CreateTestAccounts(1)
+	txBuilder := s.ClientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(accs[0].Account.GetAddress())))
+	txBuilder.SetGasLimit(gasLimit)
+	tx := txBuilder.GetTx()
+
+	minGasPrice, err := s.FeeMarketKeeper.GetMinGasPrice(s.Ctx, "stake")
+	require.NoError(t, err)
+
+	t.Run("a zero fee tx is not rejected and the required fee is returned", func(t *testing.T) {
+		ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+
+		_, err := decorator.AnteHandle(ctx, tx, true, next)
+		require.NoError(t, err)
+
+		var event sdk.Event
+		for _, e := range ctx.EventManager().Events() {
+			if e.Type == types.EventTypeRequiredFee {
+				event = e
+			}
+		}
+		require.Equal(t, types.EventTypeRequiredFee, event.Type)
+
+		feeAttr, ok := event.GetAttribute(types.AttributeKeyRequiredFee)
+		require.True(t, ok)
+		expected := types.ComputeFee(minGasPrice, gasLimit, types.FeeRoundingMode)
+		require.False(t, expected.IsZero())
+		require.Equal(t, expected.String(), feeAttr.Value)
+
+		priceAttr, ok := event.GetAttribute(types.AttributeKeyGasPrice)
+		require.True(t, ok)
+		require.Equal(t, minGasPrice.String(), priceAttr.Value)
+	})
+
+	t.Run("the same tx is rejected outside of simulation", func(t *testing.T) {
+		ctx := s.Ctx.WithEventManager(sdk.NewEventManager())
+
+		_, err := decorator.AnteHandle(ctx, tx, false, next)
+		require.ErrorIs(t, err, types.ErrNoFeeCoins)
+		require.Empty(t, ctx.EventManager().Events())
+	})
+}
+
+func TestAnteHandleAccountFeeSpendCharged(t *testing.T) {
+	gasLimit := antesuite.NewTestGasLimit()
+	feeAmount := types.DefaultMinBaseGasPrice.MulInt64(int64(gasLimit))
+	fee := sdk.NewCoins(sdk.NewCoin("stake", feeAmount.TruncateInt()))
+
+	s := antesuite.SetupTestSuite(t, false)
+	decorator := feemarketante.NewFeeMarketCheckDecorator(
+		s.AccountKeeper,
+		s.BankKeeper,
+		s.FeeGrantKeeper,
+		s.FeeMarketKeeper,
+		nil,
+	)
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) { return ctx, nil }
+
+	accs := s.CreateTestAccounts(3)
+	s.SetAccountBalances([]antesuite.TestAccountBalance{
+		{TestAccount: accs[0], Coins: fee},
+		{TestAccount: accs[1], Coins: fee},
+		{TestAccount: accs[2], Coins: fee},
+	})
+
+	// gasUsed runs a tx paid by the given account with the given account fee spend window
+	// and returns the gas it consumed.
+	gasUsed := func(acc antesuite.TestAccount, window uint64) storetypes.Gas {
+		params, err := s.FeeMarketKeeper.GetParams(s.Ctx)
+		require.NoError(t, err)
+		params.AccountFeeSpendWindow = window
+		require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+		txBuilder := s.ClientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(testdata.NewTestMsg(acc.Account.GetAddress())))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(gasLimit)
+
+		ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(gasLimit))
+		_, err = decorator.AnteHandle(ctx, txBuilder.GetTx(), false, next)
+		require.NoError(t, err)
+		return ctx.GasMeter().GasConsumed()
+	}
+
+	// the first tx also pays for state that later txs find already written
+	gasUsed(accs[0], 0)
+
+	untracked := gasUsed(accs[1], 0)
+	tracked := gasUsed(accs[2], 10)
+	// the spend and its height index are written, besides the few bytes the window adds to
+	// each params read
+	require.Greater(t, tracked-untracked, 2*storetypes.KVGasConfig().WriteCostFlat)
+
+	spend, err := s.FeeMarketKeeper.GetAccountFeeSpend(s.Ctx, accs[2].Account.GetAddress())
+	require.NoError(t, err)
+	require.Equal(t, fee, spend)
+}
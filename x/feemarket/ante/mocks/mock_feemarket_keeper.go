@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	math "cosmossdk.io/math"
 	mock "github.com/stretchr/testify/mock"
 
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
@@ -15,6 +16,148 @@ type FeeMarketKeeper struct {
 	mock.Mock
 }
 
+// AccountFeeDiscount provides a mock function with given fields: ctx, params, addr
+func (_m *FeeMarketKeeper) AccountFeeDiscount(ctx types.Context, params feemarkettypes.Params, addr types.AccAddress) (math.LegacyDec, error) {
+	ret := _m.Called(ctx, params, addr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AccountFeeDiscount")
+	}
+
+	var r0 math.LegacyDec
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, feemarkettypes.Params, types.AccAddress) (math.LegacyDec, error)); ok {
+		return rf(ctx, params, addr)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, feemarkettypes.Params, types.AccAddress) math.LegacyDec); ok {
+		r0 = rf(ctx, params, addr)
+	} else {
+		r0 = ret.Get(0).(math.LegacyDec)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, feemarkettypes.Params, types.AccAddress) error); ok {
+		r1 = rf(ctx, params, addr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CheckFee provides a mock function with given fields: ctx, tx, simulate
+func (_m *FeeMarketKeeper) CheckFee(ctx types.Context, tx types.Tx, simulate bool) (*feemarkettypes.FeeCheck, error) {
+	ret := _m.Called(ctx, tx, simulate)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CheckFee")
+	}
+
+	var r0 *feemarkettypes.FeeCheck
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, types.Tx, bool) (*feemarkettypes.FeeCheck, error)); ok {
+		return rf(ctx, tx, simulate)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, types.Tx, bool) *feemarkettypes.FeeCheck); ok {
+		r0 = rf(ctx, tx, simulate)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*feemarkettypes.FeeCheck)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, types.Tx, bool) error); ok {
+		r1 = rf(ctx, tx, simulate)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEnabledHeight provides a mock function with given fields: ctx
+func (_m *FeeMarketKeeper) GetEnabledHeight(ctx types.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetEnabledHeight")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFloorGasPrice provides a mock function with given fields: ctx, denom
+func (_m *FeeMarketKeeper) GetFloorGasPrice(ctx types.Context, denom string) (types.DecCoin, error) {
+	ret := _m.Called(ctx, denom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFloorGasPrice")
+	}
+
+	var r0 types.DecCoin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, string) (types.DecCoin, error)); ok {
+		return rf(ctx, denom)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, string) types.DecCoin); ok {
+		r0 = rf(ctx, denom)
+	} else {
+		r0 = ret.Get(0).(types.DecCoin)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, string) error); ok {
+		r1 = rf(ctx, denom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFrozenHeight provides a mock function with given fields: ctx
+func (_m *FeeMarketKeeper) GetFrozenHeight(ctx types.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFrozenHeight")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMinGasPrice provides a mock function with given fields: ctx, denom
 func (_m *FeeMarketKeeper) GetMinGasPrice(ctx types.Context, denom string) (types.DecCoin, error) {
 	ret := _m.Called(ctx, denom)
@@ -99,6 +242,98 @@ func (_m *FeeMarketKeeper) GetState(ctx types.Context) (feemarkettypes.State, er
 	return r0, r1
 }
 
+// IsFeeExempt provides a mock function with given fields: ctx, addr
+func (_m *FeeMarketKeeper) IsFeeExempt(ctx types.Context, addr types.AccAddress) bool {
+	ret := _m.Called(ctx, addr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFeeExempt")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, types.AccAddress) bool); ok {
+		r0 = rf(ctx, addr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// IsFirstBlock provides a mock function with given fields: ctx
+func (_m *FeeMarketKeeper) IsFirstBlock(ctx types.Context) (bool, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFirstBlock")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context) (bool, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context) bool); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MaxMsgTypeMultiplier provides a mock function with given fields: ctx, msgs
+func (_m *FeeMarketKeeper) MaxMsgTypeMultiplier(ctx types.Context, msgs []types.Msg) (math.LegacyDec, error) {
+	ret := _m.Called(ctx, msgs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxMsgTypeMultiplier")
+	}
+
+	var r0 math.LegacyDec
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, []types.Msg) (math.LegacyDec, error)); ok {
+		return rf(ctx, msgs)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, []types.Msg) math.LegacyDec); ok {
+		r0 = rf(ctx, msgs)
+	} else {
+		r0 = ret.Get(0).(math.LegacyDec)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, []types.Msg) error); ok {
+		r1 = rf(ctx, msgs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordAccountFeeSpend provides a mock function with given fields: ctx, addr, fees
+func (_m *FeeMarketKeeper) RecordAccountFeeSpend(ctx types.Context, addr types.AccAddress, fees types.Coins) error {
+	ret := _m.Called(ctx, addr, fees)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordAccountFeeSpend")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, types.AccAddress, types.Coins) error); ok {
+		r0 = rf(ctx, addr, fees)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResolveToDenom provides a mock function with given fields: ctx, coin, denom
 func (_m *FeeMarketKeeper) ResolveToDenom(ctx types.Context, coin types.DecCoin, denom string) (types.DecCoin, error) {
 	ret := _m.Called(ctx, coin, denom)
@@ -127,6 +362,34 @@ func (_m *FeeMarketKeeper) ResolveToDenom(ctx types.Context, coin types.DecCoin,
 	return r0, r1
 }
 
+// ResolveToDenomCached provides a mock function with given fields: ctx, coin, denom
+func (_m *FeeMarketKeeper) ResolveToDenomCached(ctx types.Context, coin types.DecCoin, denom string) (types.DecCoin, error) {
+	ret := _m.Called(ctx, coin, denom)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ResolveToDenomCached")
+	}
+
+	var r0 types.DecCoin
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, types.DecCoin, string) (types.DecCoin, error)); ok {
+		return rf(ctx, coin, denom)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, types.DecCoin, string) types.DecCoin); ok {
+		r0 = rf(ctx, coin, denom)
+	} else {
+		r0 = ret.Get(0).(types.DecCoin)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, types.DecCoin, string) error); ok {
+		r1 = rf(ctx, coin, denom)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetParams provides a mock function with given fields: ctx, params
 func (_m *FeeMarketKeeper) SetParams(ctx types.Context, params feemarkettypes.Params) error {
 	ret := _m.Called(ctx, params)
@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// GetGenesisTemplateCmd returns the cli-command that prints a recommended x/feemarket genesis
+// state for a common chain profile.
+func GetGenesisTemplateCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feemarket-template [profile]",
+		Short: "Print a recommended x/feemarket genesis state for a chain profile",
+		Long: fmt.Sprintf(
+			"Print a recommended x/feemarket genesis state for a chain profile. Supported profiles: %s.",
+			strings.Join(types.ChainProfiles(), ", "),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			gs, err := types.GenerateGenesisTemplate(args[0])
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(gs)
+		},
+	}
+
+	return cmd
+}
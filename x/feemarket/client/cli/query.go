@@ -2,10 +2,12 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
+	"google.golang.org/grpc/status"
 
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
@@ -27,6 +29,15 @@ func GetQueryCmd() *cobra.Command {
 		GetStateCmd(),
 		GetGasPriceCmd(),
 		GetGasPricesCmd(),
+		GetMinGasPriceConfigCmd(),
+		GetRevenueByMsgTypeCmd(),
+		GetAccountFeeSpendCmd(),
+		GetEIP1559EquivalentCmd(),
+		GetBaseGasPriceHistoryCmd(),
+		GetCommunityPoolContributionsCmd(),
+		GetUtilizationCmd(),
+		GetProjectGasPriceCmd(),
+		GetMarketInfoCmd(),
 	)
 
 	return cmd
@@ -86,11 +97,141 @@ func GetStateCmd() *cobra.Command {
 	return cmd
 }
 
-// GetGasPriceCmd returns the cli-command that queries the current feemarket gas price.
+// GetGasPriceCmd returns the cli-command that queries the current feemarket gas price in the
+// given denom, or in the fee denom if none is given.
 func GetGasPriceCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "gas-price",
-		Short: "Query for the current feemarket gas price",
+		Use:   "gas-price [denom]",
+		Short: "Query for the current feemarket gas price in the given denom, or in the fee denom",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.GasPriceRequest{}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.GasPrice(cmd.Context(), req)
+			if err != nil {
+				// Strip the gRPC status wrapping so that e.g. a denom the resolver cannot
+				// convert into is reported as the resolver's error.
+				if req.Denom == "" {
+					return fmt.Errorf("unable to query the gas price in the fee denom: %s", status.Convert(err).Message())
+				}
+				return fmt.Errorf("unable to query the gas price in %s: %s", req.Denom, status.Convert(err).Message())
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetGasPricesCmd returns the cli-command that queries the current feemarket gas prices in the
+// given denoms, or in all available denoms if none are given.
+func GetGasPricesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "gas-prices [denom...]",
+		Short: "Query for the current feemarket gas prices in the given denoms, or in all available denoms",
+		Args:  cobra.ArbitraryArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.GasPrices(cmd.Context(), &types.GasPricesRequest{
+				Denoms: args,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetMinGasPriceConfigCmd returns the cli-command that queries the current minimum gas price
+// formatted for a node's minimum-gas-prices configuration.
+func GetMinGasPriceConfigCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "min-gas-price-config [denom]",
+		Short: "Query for the current minimum gas price formatted for a node's minimum-gas-prices config",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.MinGasPriceConfigRequest{}
+			if len(args) > 0 {
+				req.Denom = args[0]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.MinGasPriceConfig(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetRevenueByMsgTypeCmd returns the cli-command that queries the fee revenue collected over
+// the window by primary message type.
+func GetRevenueByMsgTypeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revenue-by-msg-type",
+		Short: "Query for the fee revenue collected over the window by primary message type",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.RevenueByMsgType(cmd.Context(), &types.RevenueByMsgTypeRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetAccountFeeSpendCmd returns the cli-command that queries the fees paid by an account over
+// the account fee spend window.
+func GetAccountFeeSpendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "account-fee-spend [address]",
+		Short: "Query for the fees paid by an account over the account fee spend window",
 		Args:  cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -99,8 +240,8 @@ func GetGasPriceCmd() *cobra.Command {
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
-			resp, err := queryClient.GasPrice(cmd.Context(), &types.GasPriceRequest{
-				Denom: args[0],
+			resp, err := queryClient.AccountFeeSpend(cmd.Context(), &types.AccountFeeSpendRequest{
+				Address: args[0],
 			})
 			if err != nil {
 				return err
@@ -115,11 +256,169 @@ func GetGasPriceCmd() *cobra.Command {
 	return cmd
 }
 
-// GetGasPricesCmd returns the cli-command that queries all current feemarket gas prices.
-func GetGasPricesCmd() *cobra.Command {
+// GetEIP1559EquivalentCmd returns the cli-command that queries the current feemarket parameters
+// translated into their EIP-1559 equivalents.
+func GetEIP1559EquivalentCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "eip1559-equivalent",
+		Short: "Query for the current feemarket parameters as EIP-1559 equivalents",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.EIP1559Equivalent(cmd.Context(), &types.EIP1559EquivalentRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetBaseGasPriceHistoryCmd returns the cli-command that queries the base gas prices of the
+// most recent blocks.
+func GetBaseGasPriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-gas-price-history [limit]",
+		Short: "Query for the base gas prices of the most recent blocks, up to an optional limit",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var limit uint64
+			if len(args) > 0 {
+				limit, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return fmt.Errorf("invalid limit %q: %w", args[0], err)
+				}
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.BaseGasPriceHistory(cmd.Context(), &types.BaseGasPriceHistoryRequest{
+				Limit: limit,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCommunityPoolContributionsCmd returns the cli-command that queries the share of the
+// distributed fees allocated to the community pool.
+func GetCommunityPoolContributionsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "community-pool-contributions",
+		Short: "Query for the fees contributed to the community pool, cumulatively and over the window",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.CommunityPoolContributions(cmd.Context(), &types.CommunityPoolContributionsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetUtilizationCmd returns the cli-command that queries how full the blocks of the window have been.
+func GetUtilizationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "utilization",
+		Short: "Query for the utilization of the blocks of the window",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.Utilization(cmd.Context(), &types.UtilizationRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetProjectGasPriceCmd returns the cli-command that queries the base gas price and learning
+// rate projected a number of blocks into the future at a constant utilization.
+func GetProjectGasPriceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "project-gas-price [blocks] [assumed-utilization]",
+		Short: "Query for the base gas price projected a number of blocks into the future",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			blocks, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid blocks %q: %w", args[0], err)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			resp, err := queryClient.ProjectGasPrice(cmd.Context(), &types.ProjectGasPriceRequest{
+				Blocks:             blocks,
+				AssumedUtilization: args[1],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(resp)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetMarketInfoCmd returns the cli-command that queries the current feemarket parameters,
+// state and min gas price in the fee denom in a single request.
+func GetMarketInfoCmd() *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "gas-prices",
-		Short: "Query for all current feemarket gas prices",
+		Use:   "market-info",
+		Short: "Query for the current feemarket parameters, state and min gas price",
 		Args:  cobra.NoArgs,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientQueryContext(cmd)
@@ -128,7 +427,7 @@ func GetGasPricesCmd() *cobra.Command {
 			}
 
 			queryClient := types.NewQueryClient(clientCtx)
-			resp, err := queryClient.GasPrices(cmd.Context(), &types.GasPricesRequest{})
+			resp, err := queryClient.MarketInfo(cmd.Context(), &types.MarketInfoRequest{})
 			if err != nil {
 				return err
 			}
@@ -9,7 +9,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"pgregory.net/rapid"
 
-	"github.com/skip-mev/feemarket/x/feemarket/ante"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
 type input struct {
@@ -23,7 +23,7 @@ func TestGetTxPriority(t *testing.T) {
 	rapid.Check(t, func(t *rapid.T) {
 		inputs := createRandomInput(t)
 
-		priority := ante.GetTxPriority(inputs.payFee, inputs.gasLimit, inputs.currentGasPrice)
+		priority := types.GetTxPriority(inputs.payFee, inputs.gasLimit, inputs.currentGasPrice)
 		require.GreaterOrEqual(t, priority, int64(0))
 		require.LessOrEqual(t, priority, int64(math.MaxInt64))
 	})
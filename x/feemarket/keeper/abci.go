@@ -6,7 +6,22 @@ import (
 
 // EndBlock returns an endblocker for the x/feemarket module. The endblocker
 // is responsible for updating the state of the fee market based on the
-// AIMD learning rate adjustment algorithm.
+// AIMD learning rate adjustment algorithm, for recording the resolver rates
+// guarded in the next block, for distributing the fees accumulated over
+// a distribution epoch once it ends, and for recording the community pool's
+// share of the fees distributed in the block.
 func (k *Keeper) EndBlock(ctx sdk.Context) error {
-	return k.UpdateFeeMarket(ctx)
+	if err := k.UpdateFeeMarket(ctx); err != nil {
+		return err
+	}
+
+	if err := k.RecordResolverRates(ctx); err != nil {
+		return err
+	}
+
+	if err := k.DistributeAtEpochEnd(ctx); err != nil {
+		return err
+	}
+
+	return k.RecordCommunityPoolContributions(ctx)
 }
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// CommunityPoolFee returns the fee, at the current minimum gas price, for a tx with the
+// given gas limit that is paid in a denom held by the community pool. This is intended for
+// tooling of on-chain organizations paying fees from community funds. If the community
+// pool holds exactly one denom, denom may be left empty to use it; otherwise the caller
+// must specify which of the pool's denoms to price the fee in.
+func (k *Keeper) CommunityPoolFee(ctx sdk.Context, gas uint64, denom string) (sdk.Coin, error) {
+	if k.distribution == nil {
+		return sdk.Coin{}, types.ErrDistributionNotSet
+	}
+
+	resp, err := k.distribution.CommunityPool(ctx, &distrtypes.QueryCommunityPoolRequest{})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	pool := resp.Pool
+	if denom == "" {
+		switch len(pool) {
+		case 0:
+			return sdk.Coin{}, fmt.Errorf("community pool is empty")
+		case 1:
+			denom = pool[0].Denom
+		default:
+			return sdk.Coin{}, fmt.Errorf("community pool holds %d denoms; a denom must be specified", len(pool))
+		}
+	}
+
+	if !pool.AmountOf(denom).IsPositive() {
+		return sdk.Coin{}, fmt.Errorf("community pool does not hold denom %s", denom)
+	}
+
+	gasPrice, err := k.GetMinGasPrice(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return computeFee(gasPrice, gas), nil
+}
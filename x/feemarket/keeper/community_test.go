@@ -0,0 +1,67 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/skip-mev/feemarket/x/feemarket/types/mocks"
+)
+
+func (s *KeeperTestSuite) TestCommunityPoolFee() {
+	const gas = 1000
+
+	setPool := func(pool sdk.DecCoins) {
+		distribution := mocks.NewDistributionKeeper(s.T())
+		distribution.On("CommunityPool", mock.Anything, mock.Anything).
+			Return(&distrtypes.QueryCommunityPoolResponse{Pool: pool}, nil).Once()
+		s.feeMarketKeeper.SetDistributionKeeper(distribution)
+	}
+	defer s.feeMarketKeeper.SetDistributionKeeper(nil)
+
+	params := types.DefaultParams()
+	params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.5")
+	s.setGenesisState(params, types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate))
+
+	s.Run("errors without a distribution keeper", func() {
+		s.feeMarketKeeper.SetDistributionKeeper(nil)
+
+		_, err := s.feeMarketKeeper.CommunityPoolFee(s.ctx, gas, "")
+		s.Require().ErrorIs(err, types.ErrDistributionNotSet)
+	})
+
+	s.Run("uses the only denom in the pool", func() {
+		setPool(sdk.NewDecCoins(sdk.NewInt64DecCoin(params.FeeDenom, 100)))
+
+		fee, err := s.feeMarketKeeper.CommunityPoolFee(s.ctx, gas, "")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 500), fee)
+	})
+
+	s.Run("requires a denom for a pool with multiple denoms", func() {
+		setPool(sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 100), sdk.NewInt64DecCoin(params.FeeDenom, 100)))
+
+		_, err := s.feeMarketKeeper.CommunityPoolFee(s.ctx, gas, "")
+		s.Require().Error(err)
+	})
+
+	s.Run("prices the fee in the selected denom", func() {
+		s.feeMarketKeeper.SetDenomResolver(&skewedDenomResolver{})
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+		setPool(sdk.NewDecCoins(sdk.NewInt64DecCoin("atom", 100), sdk.NewInt64DecCoin(params.FeeDenom, 100)))
+
+		// atom is worth half as much as the fee denom, so the fee is doubled.
+		fee, err := s.feeMarketKeeper.CommunityPoolFee(s.ctx, gas, "atom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin("atom", 1000), fee)
+	})
+
+	s.Run("rejects a denom the pool does not hold", func() {
+		setPool(sdk.NewDecCoins(sdk.NewInt64DecCoin(params.FeeDenom, 100)))
+
+		_, err := s.feeMarketKeeper.CommunityPoolFee(s.ctx, gas, "atom")
+		s.Require().Error(err)
+	})
+}
@@ -0,0 +1,174 @@
+package keeper
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// RecordDistributedFees adds the given fees, which the fee market just sent to the default
+// fee collector, to the fees distributed in the current block. The share of them that the
+// distribution module allocates to the community pool is recorded once per block by
+// RecordCommunityPoolContributions, so that a tx is only charged for a single write.
+// Nothing is recorded if no distribution keeper is configured, as the share cannot be
+// looked up.
+func (k *Keeper) RecordDistributedFees(ctx sdk.Context, fees sdk.Coins) error {
+	if k.distribution == nil || fees.IsZero() {
+		return nil
+	}
+
+	pending, err := k.getPendingDistributedFees(ctx)
+	if err != nil {
+		return err
+	}
+
+	bz, err := (&types.AccumulatedFees{Fees: pending.Add(fees...)}).Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.KeyPendingDistributedFees, bz)
+
+	return nil
+}
+
+// RecordCommunityPoolContributions records the share of the fees distributed in the current
+// block that the distribution module allocates to the community pool. The share is the fees
+// multiplied by the community tax. It is added both to the cumulative contributions and to
+// the contribution of the current height. Nothing is recorded if the community tax is zero,
+// as the community pool receives no share of the fees. This is called in EndBlock.
+func (k *Keeper) RecordCommunityPoolContributions(ctx sdk.Context) error {
+	fees, err := k.getPendingDistributedFees(ctx)
+	if err != nil {
+		return err
+	}
+
+	if fees.IsZero() {
+		return nil
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.KeyPendingDistributedFees)
+
+	if k.distribution == nil {
+		return nil
+	}
+
+	distrParams, err := k.distribution.Params(ctx, &distrtypes.QueryParamsRequest{})
+	if err != nil {
+		return err
+	}
+
+	tax := distrParams.Params.CommunityTax
+	if !tax.IsPositive() {
+		return nil
+	}
+
+	contribution := sdk.NewDecCoinsFromCoins(fees...).MulDec(tax)
+
+	cumulative, err := k.GetCommunityPoolContributions(ctx)
+	if err != nil {
+		return err
+	}
+	cumulative.Amount = cumulative.Amount.Add(contribution...)
+	if err := k.setCommunityPoolContributions(ctx, cumulative); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.BlockCommunityPoolContributionKey(ctx.BlockHeight())
+
+	var block types.BlockCommunityPoolContribution
+	if bz := store.Get(key); bz != nil {
+		if err := block.Unmarshal(bz); err != nil {
+			return err
+		}
+	}
+	block.Amount = block.Amount.Add(contribution...)
+
+	bz, err := block.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+
+	return nil
+}
+
+// GetCommunityPoolContributions returns the cumulative contributions to the community pool
+// from the fees distributed since the last reset.
+func (k *Keeper) GetCommunityPoolContributions(ctx sdk.Context) (types.CommunityPoolContributions, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyCommunityPoolContributions)
+	if bz == nil {
+		return types.CommunityPoolContributions{Amount: sdk.NewDecCoins()}, nil
+	}
+
+	var contributions types.CommunityPoolContributions
+	if err := contributions.Unmarshal(bz); err != nil {
+		return types.CommunityPoolContributions{}, err
+	}
+
+	return contributions, nil
+}
+
+// GetWindowCommunityPoolContributions returns the contributions to the community pool from
+// the fees distributed over the window.
+func (k *Keeper) GetWindowCommunityPoolContributions(ctx sdk.Context) (sdk.DecCoins, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixBlockCommunityPoolContribution)
+	defer iterator.Close()
+
+	total := sdk.NewDecCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var block types.BlockCommunityPoolContribution
+		if err := block.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		total = total.Add(block.Amount...)
+	}
+
+	return total, nil
+}
+
+// ResetCommunityPoolContributions resets the cumulative contributions to the community pool
+// to zero as of the current height. The contributions recorded over the window are kept.
+func (k *Keeper) ResetCommunityPoolContributions(ctx sdk.Context) error {
+	return k.setCommunityPoolContributions(ctx, types.CommunityPoolContributions{
+		Amount:      sdk.NewDecCoins(),
+		SinceHeight: ctx.BlockHeight(),
+	})
+}
+
+// PruneCommunityPoolContributions removes the contributions recorded at heights that have
+// fallen out of the window ending at the current height.
+func (k *Keeper) PruneCommunityPoolContributions(ctx sdk.Context, window uint64) {
+	k.pruneByHeight(ctx, types.KeyPrefixBlockCommunityPoolContribution, types.BlockCommunityPoolContributionKey, window)
+}
+
+func (k *Keeper) getPendingDistributedFees(ctx sdk.Context) (sdk.Coins, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyPendingDistributedFees)
+	if bz == nil {
+		return sdk.NewCoins(), nil
+	}
+
+	var pending types.AccumulatedFees
+	if err := pending.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return pending.Fees, nil
+}
+
+func (k *Keeper) setCommunityPoolContributions(ctx sdk.Context, contributions types.CommunityPoolContributions) error {
+	bz, err := contributions.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.KeyCommunityPoolContributions, bz)
+
+	return nil
+}
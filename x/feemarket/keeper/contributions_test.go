@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/skip-mev/feemarket/x/feemarket/types/mocks"
+)
+
+func (s *KeeperTestSuite) TestCommunityPoolContributions() {
+	params := types.DefaultAIMDParams()
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	fee := sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 1_000))
+	ctx := s.ctx.WithBlockHeight(100)
+
+	setCommunityTax := func(tax string) *mocks.DistributionKeeper {
+		distribution := mocks.NewDistributionKeeper(s.T())
+		distrParams := distrtypes.DefaultParams()
+		distrParams.CommunityTax = math.LegacyMustNewDecFromStr(tax)
+		distribution.On("Params", mock.Anything, mock.Anything).
+			Return(&distrtypes.QueryParamsResponse{Params: distrParams}, nil).Maybe()
+
+		s.feeMarketKeeper.SetDistributionKeeper(distribution)
+		return distribution
+	}
+	defer s.feeMarketKeeper.SetDistributionKeeper(nil)
+
+	// distribute records the given number of distributed fees in the block at ctx and ends
+	// the block.
+	distribute := func(ctx sdk.Context, fees int) {
+		for i := 0; i < fees; i++ {
+			s.Require().NoError(s.feeMarketKeeper.RecordDistributedFees(ctx, fee))
+		}
+		s.Require().NoError(s.feeMarketKeeper.RecordCommunityPoolContributions(ctx))
+	}
+
+	s.Run("records nothing without a distribution keeper", func() {
+		distribute(ctx, 1)
+
+		contributions, err := s.feeMarketKeeper.GetCommunityPoolContributions(ctx)
+		s.Require().NoError(err)
+		s.Require().True(contributions.Amount.IsZero())
+		s.Require().Equal(int64(0), contributions.SinceHeight)
+	})
+
+	s.Run("records nothing without a community tax", func() {
+		setCommunityTax("0")
+		distribute(ctx, 1)
+
+		contributions, err := s.feeMarketKeeper.GetCommunityPoolContributions(ctx)
+		s.Require().NoError(err)
+		s.Require().True(contributions.Amount.IsZero())
+	})
+
+	s.Run("accumulates the community tax share of the distributed fees", func() {
+		distribution := setCommunityTax("0.02")
+
+		// Each 1,000 fee contributes 1,000 * 0.02 = 20: 20 at height 100 and 40 at
+		// height 101.
+		distribute(ctx, 1)
+		s.Require().NoError(s.feeMarketKeeper.RecordDistributedFees(ctx.WithBlockHeight(101), fee))
+		s.Require().NoError(s.feeMarketKeeper.RecordDistributedFees(ctx.WithBlockHeight(101), fee))
+
+		// the contributions of a block are only recorded at its end, with the community
+		// tax looked up once per block
+		contributions, err := s.feeMarketKeeper.GetCommunityPoolContributions(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(params.FeeDenom, 20)), contributions.Amount)
+
+		s.Require().NoError(s.feeMarketKeeper.RecordCommunityPoolContributions(ctx.WithBlockHeight(101)))
+		distribution.AssertNumberOfCalls(s.T(), "Params", 2)
+
+		contributions, err = s.feeMarketKeeper.GetCommunityPoolContributions(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(params.FeeDenom, 60)), contributions.Amount)
+
+		window, err := s.feeMarketKeeper.GetWindowCommunityPoolContributions(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(contributions.Amount, window)
+	})
+
+	s.Run("the window drops blocks that fell out of it", func() {
+		// Height 100 is out of the 8 block window ending at height 108.
+		s.feeMarketKeeper.PruneCommunityPoolContributions(ctx.WithBlockHeight(108), params.Window)
+
+		window, err := s.feeMarketKeeper.GetWindowCommunityPoolContributions(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(params.FeeDenom, 40)), window)
+
+		contributions, err := s.feeMarketKeeper.GetCommunityPoolContributions(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(params.FeeDenom, 60)), contributions.Amount)
+	})
+
+	s.Run("only the authority can reset the cumulative contributions", func() {
+		msgServer := keeper.NewMsgServer(s.feeMarketKeeper)
+		resetCtx := ctx.WithBlockHeight(110)
+
+		msg := types.NewMsgResetCommunityPoolContributions(sdk.AccAddress("invalid").String())
+		_, err := msgServer.ResetCommunityPoolContributions(resetCtx, &msg)
+		s.Require().Error(err)
+
+		contributions, err := s.feeMarketKeeper.GetCommunityPoolContributions(resetCtx)
+		s.Require().NoError(err)
+		s.Require().False(contributions.Amount.IsZero())
+
+		msg = types.NewMsgResetCommunityPoolContributions(s.authorityAccount.String())
+		_, err = msgServer.ResetCommunityPoolContributions(resetCtx, &msg)
+		s.Require().NoError(err)
+
+		contributions, err = s.feeMarketKeeper.GetCommunityPoolContributions(resetCtx)
+		s.Require().NoError(err)
+		s.Require().True(contributions.Amount.IsZero())
+		s.Require().Equal(int64(110), contributions.SinceHeight)
+
+		// The reset leaves the window untouched.
+		window, err := s.feeMarketKeeper.GetWindowCommunityPoolContributions(resetCtx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(params.FeeDenom, 40)), window)
+	})
+
+	s.Run("accumulates again after a reset", func() {
+		distribute(ctx.WithBlockHeight(111), 1)
+
+		contributions, err := s.feeMarketKeeper.GetCommunityPoolContributions(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(sdk.NewInt64DecCoin(params.FeeDenom, 20)), contributions.Amount)
+		s.Require().Equal(int64(110), contributions.SinceHeight)
+	})
+}
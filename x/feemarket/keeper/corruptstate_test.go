@@ -0,0 +1,42 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestCorruptStatePolicy() {
+	corrupt := []byte{0xff, 0xff, 0xff}
+
+	s.Run("strict policy returns an error", func() {
+		s.feeMarketKeeper.SetRawState(s.ctx, corrupt)
+
+		_, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().Error(err)
+	})
+
+	s.Run("reset policy falls back to a fresh state and emits an event", func() {
+		s.feeMarketKeeper.SetCorruptStatePolicy(types.CorruptStatePolicyReset)
+		defer s.feeMarketKeeper.SetCorruptStatePolicy(types.CorruptStatePolicyStrict)
+
+		params := types.DefaultAIMDParams()
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+		s.feeMarketKeeper.SetRawState(s.ctx, corrupt)
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		state, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().NoError(state.ValidateBasic())
+		s.Require().Equal(types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate), state)
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeStateReset, events[0].Type)
+		_, ok := events[0].GetAttribute(types.AttributeKeyError)
+		s.Require().True(ok)
+
+		// The fee market keeps running on the fresh state.
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+	})
+}
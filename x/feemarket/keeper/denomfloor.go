@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// SetDenomMinGasPrice sets the minimum gas price enforced for the given denom on top of the
+// market-derived price, like a validator's minimum-gas-prices, e.g. for an illiquid denom
+// whose resolved price does not reflect what it can be sold for. A zero price removes the
+// minimum of the denom.
+func (k *Keeper) SetDenomMinGasPrice(ctx sdk.Context, denom string, price math.LegacyDec) error {
+	if err := types.ValidateDenomMinGasPrice(denom, price); err != nil {
+		return err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	if price.IsZero() {
+		store.Delete(types.DenomMinGasPriceKey(denom))
+		return nil
+	}
+
+	bz, err := price.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.DenomMinGasPriceKey(denom), bz)
+	return nil
+}
+
+// GetDenomMinGasPrices returns the minimum gas prices set with SetDenomMinGasPrice, sorted by
+// denom.
+func (k *Keeper) GetDenomMinGasPrices(ctx sdk.Context) (sdk.DecCoins, error) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixDenomMinGasPrice)
+	defer iterator.Close()
+
+	var prices sdk.DecCoins
+	for ; iterator.Valid(); iterator.Next() {
+		var price math.LegacyDec
+		if err := price.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		denom := string(iterator.Key()[len(types.KeyPrefixDenomMinGasPrice):])
+		prices = prices.Add(sdk.NewDecCoinFromDec(denom, price))
+	}
+
+	return prices, nil
+}
+
+// applyDenomMinGasPrice raises the given market-derived gas price to the minimum gas price of
+// its denom, if one is set. The minimum is read without charging gas, so that txs cost the
+// same whether a minimum is set or not.
+func (k *Keeper) applyDenomMinGasPrice(ctx sdk.Context, gasPrice sdk.DecCoin) (sdk.DecCoin, error) {
+	bz := k.getUnmetered(ctx, types.DenomMinGasPriceKey(gasPrice.Denom))
+	if bz == nil {
+		return gasPrice, nil
+	}
+
+	var price math.LegacyDec
+	if err := price.Unmarshal(bz); err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	if price.GT(gasPrice.Amount) {
+		gasPrice.Amount = price
+	}
+
+	return gasPrice, nil
+}
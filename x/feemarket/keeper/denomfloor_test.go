@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestDenomMinGasPrice() {
+	gs := types.DefaultGenesisState()
+	gs.Params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.001")
+	gs.State.BaseGasPrice = math.LegacyMustNewDecFromStr("0.025")
+	s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+	defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+	s.feeMarketKeeper.SetDenomResolver(&countingDenomResolver{rate: math.LegacyNewDec(4)})
+
+	s.Run("unknown denoms fall back to the market price", func() {
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("0.1")), gasPrice)
+
+		prices, err := s.feeMarketKeeper.GetDenomMinGasPrices(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(prices)
+	})
+
+	s.Run("a minimum above the market price is enforced", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, "uatom", math.LegacyMustNewDecFromStr("0.5")))
+
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("0.5")), gasPrice)
+
+		// 0.5 * 1,000 = 500
+		fee, err := s.feeMarketKeeper.GetRequiredFee(s.ctx, 1_000, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin("uatom", 500), fee)
+	})
+
+	s.Run("a minimum below the market price is ignored", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, types.DefaultFeeDenom, math.LegacyMustNewDecFromStr("0.01")))
+
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec(types.DefaultFeeDenom, gs.State.BaseGasPrice), gasPrice)
+	})
+
+	s.Run("returns the minimums sorted by denom", func() {
+		prices, err := s.feeMarketKeeper.GetDenomMinGasPrices(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(types.DefaultFeeDenom, math.LegacyMustNewDecFromStr("0.01")),
+			sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("0.5")),
+		), prices)
+	})
+
+	s.Run("a zero minimum removes it", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, "uatom", math.LegacyZeroDec()))
+
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("0.1")), gasPrice)
+
+		prices, err := s.feeMarketKeeper.GetDenomMinGasPrices(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(types.DefaultFeeDenom, math.LegacyMustNewDecFromStr("0.01")),
+		), prices)
+	})
+
+	s.Run("the floor and robust fee enforce the minimum", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, "uatom", math.LegacyMustNewDecFromStr("0.5")))
+		defer func() {
+			s.Require().NoError(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, "uatom", math.LegacyZeroDec()))
+		}()
+
+		// The resolved floor is 0.001 * 4 = 0.004.
+		floor, err := s.feeMarketKeeper.GetFloorGasPrice(s.ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("0.5")), floor)
+
+		// 0.5 * 1,000 = 500
+		fee, err := s.feeMarketKeeper.RobustFee(s.ctx, 1_000, 0, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin("uatom", 500), fee)
+	})
+
+	s.Run("the authority can set a minimum", func() {
+		msg := types.NewMsgSetDenomMinGasPrice(s.authorityAccount.String(), "ufoo", math.LegacyMustNewDecFromStr("0.2"))
+		_, err := s.msgServer.SetDenomMinGasPrice(s.ctx, &msg)
+		s.Require().NoError(err)
+
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, "ufoo")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec("ufoo", math.LegacyMustNewDecFromStr("0.2")), gasPrice)
+
+		msg.MinGasPrice = math.LegacyZeroDec()
+		_, err = s.msgServer.SetDenomMinGasPrice(s.ctx, &msg)
+		s.Require().NoError(err)
+	})
+
+	s.Run("other signers cannot set a minimum", func() {
+		msg := types.NewMsgSetDenomMinGasPrice(sdk.AccAddress("other").String(), "ufoo", math.LegacyMustNewDecFromStr("0.2"))
+		_, err := s.msgServer.SetDenomMinGasPrice(s.ctx, &msg)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects invalid minimums", func() {
+		s.Require().Error(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, "", math.LegacyOneDec()))
+		s.Require().Error(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, "uatom", math.LegacyNewDec(-1)))
+		s.Require().Error(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, "uatom", math.LegacyDec{}))
+	})
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// DiscountedFee returns the fee required for the given amount of gas in the given denom after
+// applying the loyalty discount the account qualifies for, see AccountFeeDiscount. This is the
+// fee the ante and post handlers require from a tx paid by the account, before any message
+// type multiplier.
+func (k *Keeper) DiscountedFee(ctx sdk.Context, addr sdk.AccAddress, gas uint64, denom string) (sdk.Coin, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	gasPrice, err := k.GetMinGasPrice(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	discount, err := k.AccountFeeDiscount(ctx, params, addr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	gasPrice.Amount = gasPrice.Amount.Mul(math.LegacyOneDec().Sub(discount))
+
+	return computeFee(gasPrice, gas), nil
+}
+
+// AccountFeeDiscount returns the loyalty discount the given account qualifies for: that of the
+// highest fee discount tier whose min spend is met by the fees the account paid, in the fee
+// denom, over the account fee spend window ending at the previous block. Fees paid in the
+// current block are not counted, so the discount does not change as the account pays fees
+// within a block and the ante and post handlers charge the same discount. Nothing is read
+// from the store if no tiers are set or account fee tracking is disabled.
+func (k *Keeper) AccountFeeDiscount(ctx sdk.Context, params types.Params, addr sdk.AccAddress) (math.LegacyDec, error) {
+	if len(params.FeeDiscountTiers) == 0 || params.AccountFeeSpendWindow == 0 {
+		return math.LegacyZeroDec(), nil
+	}
+
+	spend, err := k.accountFeeSpend(ctx, addr, params.AccountFeeSpendWindow, ctx.BlockHeight()-1)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return params.FeeDiscount(spend.AmountOf(params.FeeDenom)), nil
+}
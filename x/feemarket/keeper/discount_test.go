@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestDiscountedFee() {
+	frequent := sdk.AccAddress("frequent____________")
+	occasional := sdk.AccAddress("occasional__________")
+	newcomer := sdk.AccAddress("newcomer____________")
+
+	params, err := s.feeMarketKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.AccountFeeSpendWindow = 100
+	params.FeeDiscountTiers = []types.FeeDiscountTier{
+		{MinSpend: math.NewInt(1_000), Discount: math.LegacyMustNewDecFromStr("0.1")},
+		{MinSpend: math.NewInt(10_000), Discount: math.LegacyMustNewDecFromStr("0.25")},
+	}
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	state := types.DefaultState()
+	state.BaseGasPrice = math.LegacyOneDec()
+	s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+	// The discount counts the fees paid before the current block.
+	recordCtx := s.ctx.WithBlockHeight(10)
+	for i := 0; i < 20; i++ {
+		s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(recordCtx, frequent, sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 600))))
+	}
+	s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(recordCtx, occasional, sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 1_500))))
+	ctx := s.ctx.WithBlockHeight(11)
+
+	s.Run("a high-activity account receives the highest tier it has reached", func() {
+		fee, err := s.feeMarketKeeper.DiscountedFee(ctx, frequent, 1_000, params.FeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 750), fee)
+	})
+
+	s.Run("an account below the highest tier receives the lower discount", func() {
+		fee, err := s.feeMarketKeeper.DiscountedFee(ctx, occasional, 1_000, params.FeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 900), fee)
+	})
+
+	s.Run("an account without history receives no discount", func() {
+		fee, err := s.feeMarketKeeper.DiscountedFee(ctx, newcomer, 1_000, params.FeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 1_000), fee)
+	})
+
+	s.Run("the discount applies to fees in other denoms", func() {
+		fee, err := s.feeMarketKeeper.DiscountedFee(ctx, frequent, 1_000, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin("uatom", 750), fee)
+	})
+	s.Run("fees paid in the current block do not change the discount", func() {
+		fee, err := s.feeMarketKeeper.DiscountedFee(recordCtx, frequent, 1_000, params.FeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 1_000), fee)
+	})
+
+	s.Run("the ante handler requires exactly the discounted fee", func() {
+		const gasLimit = 100_000
+
+		buildTx := func(payer sdk.AccAddress, fee sdk.Coin) sdk.Tx {
+			txBuilder := s.encCfg.TxConfig.NewTxBuilder()
+			s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(payer)))
+			txBuilder.SetFeeAmount(sdk.NewCoins(fee))
+			txBuilder.SetGasLimit(gasLimit)
+			txBuilder.SetFeePayer(payer)
+			return txBuilder.GetTx()
+		}
+
+		for _, addr := range []sdk.AccAddress{frequent, occasional, newcomer} {
+			fee, err := s.feeMarketKeeper.DiscountedFee(ctx, addr, gasLimit, params.FeeDenom)
+			s.Require().NoError(err)
+
+			_, err = s.feeMarketKeeper.PreCheckFee(ctx, buildTx(addr, fee))
+			s.Require().NoError(err)
+
+			_, err = s.feeMarketKeeper.PreCheckFee(ctx, buildTx(addr, fee.SubAmount(math.OneInt())))
+			s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+		}
+
+		// The discount follows the account that pays the fee.
+		fee, err := s.feeMarketKeeper.DiscountedFee(ctx, frequent, gasLimit, params.FeeDenom)
+		s.Require().NoError(err)
+		_, err = s.feeMarketKeeper.PreCheckFee(ctx, buildTx(newcomer, fee))
+		s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	})
+}
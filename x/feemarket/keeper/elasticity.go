@@ -0,0 +1,115 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// RecordObservation records the base gas price charged during the current block along with
+// the block's utilization.
+func (k *Keeper) RecordObservation(ctx sdk.Context, baseGasPrice math.LegacyDec, utilization uint64) error {
+	observation := types.BlockObservation{
+		BaseGasPrice: baseGasPrice,
+		Utilization:  utilization,
+	}
+
+	bz, err := observation.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.ObservationKey(ctx.BlockHeight()), bz)
+	k.invalidateMetricsCache()
+
+	return nil
+}
+
+// PruneObservations removes the observations recorded at heights that have fallen out of
+// the window ending at the current height.
+func (k *Keeper) PruneObservations(ctx sdk.Context, window uint64) {
+	k.pruneByHeight(ctx, types.KeyPrefixObservation, types.ObservationKey, window)
+	k.invalidateMetricsCache()
+}
+
+// GetObservations returns the observations recorded over the window, ordered by height.
+func (k *Keeper) GetObservations(ctx sdk.Context) ([]types.BlockObservation, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixObservation)
+	defer iterator.Close()
+
+	var observations []types.BlockObservation
+	for ; iterator.Valid(); iterator.Next() {
+		var observation types.BlockObservation
+		if err := observation.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		observations = append(observations, observation)
+	}
+
+	return observations, nil
+}
+
+// EmpiricalElasticity estimates the price elasticity of demand from the (base gas price,
+// utilization) pairs observed over the window. A least-squares line of utilization against
+// price is fitted, and its slope is converted to an elasticity at the mean price and
+// utilization: slope * meanPrice / meanUtilization. A negative value means utilization
+// drops as the price rises; e.g. -1 means a 1% price increase reduces utilization by 1%.
+// ErrInsufficientData is returned if fewer than two observations were recorded, the price
+// did not vary, or no gas was consumed over the window.
+func (k *Keeper) EmpiricalElasticity(ctx sdk.Context) (math.LegacyDec, error) {
+	slope, meanPrice, meanUtilization, err := k.fitDemand(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return slope.Mul(meanPrice).Quo(meanUtilization), nil
+}
+
+// fitDemand fits a least-squares line of utilization against base gas price over the
+// observations recorded in the window, returning its slope along with the mean price and
+// utilization the line passes through.
+func (k *Keeper) fitDemand(ctx sdk.Context) (slope, meanPrice, meanUtilization math.LegacyDec, err error) {
+	observations, err := k.GetObservations(ctx)
+	if err != nil {
+		return slope, meanPrice, meanUtilization, err
+	}
+
+	if len(observations) < 2 {
+		err = types.ErrInsufficientData.Wrapf("got %d observations, need at least 2", len(observations))
+		return slope, meanPrice, meanUtilization, err
+	}
+
+	n := int64(len(observations))
+	sumPrice, sumUtilization := math.LegacyZeroDec(), math.LegacyZeroDec()
+	for _, observation := range observations {
+		sumPrice = sumPrice.Add(observation.BaseGasPrice)
+		sumUtilization = sumUtilization.Add(math.LegacyNewDecFromInt(math.NewIntFromUint64(observation.Utilization)))
+	}
+
+	meanPrice = sumPrice.QuoInt64(n)
+	meanUtilization = sumUtilization.QuoInt64(n)
+	if !meanUtilization.IsPositive() {
+		err = types.ErrInsufficientData.Wrap("no gas was consumed over the window")
+		return slope, meanPrice, meanUtilization, err
+	}
+
+	covariance, variance := math.LegacyZeroDec(), math.LegacyZeroDec()
+	for _, observation := range observations {
+		dp := observation.BaseGasPrice.Sub(meanPrice)
+		du := math.LegacyNewDecFromInt(math.NewIntFromUint64(observation.Utilization)).Sub(meanUtilization)
+
+		covariance = covariance.Add(dp.Mul(du))
+		variance = variance.Add(dp.Mul(dp))
+	}
+
+	if variance.IsZero() {
+		err = types.ErrInsufficientData.Wrap("the base gas price did not vary over the window")
+		return slope, meanPrice, meanUtilization, err
+	}
+
+	return covariance.Quo(variance), meanPrice, meanUtilization, nil
+}
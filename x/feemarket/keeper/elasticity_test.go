@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestEmpiricalElasticity() {
+	params := types.DefaultAIMDParams()
+	params.RecordAnalytics = true
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	record := func(startHeight int64, prices []string, utilizations []uint64) {
+		for i := range prices {
+			ctx := s.ctx.WithBlockHeight(startHeight + int64(i))
+			s.Require().NoError(s.feeMarketKeeper.RecordObservation(ctx, math.LegacyMustNewDecFromStr(prices[i]), utilizations[i]))
+		}
+	}
+	resetObservations := func() {
+		s.feeMarketKeeper.PruneObservations(s.ctx.WithBlockHeight(1_000_000), 0)
+	}
+
+	s.Run("recovers a known elasticity from a synthetic window", func() {
+		defer resetObservations()
+
+		// utilization = 500 - 100 * price, so the slope is -100 and at the means
+		// (price 2.5, utilization 250) the elasticity is -100 * 2.5 / 250 = -1.
+		record(10, []string{"1", "2", "3", "4"}, []uint64{400, 300, 200, 100})
+
+		elasticity, err := s.feeMarketKeeper.EmpiricalElasticity(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(-1), elasticity)
+	})
+
+	s.Run("observations are ordered by height", func() {
+		defer resetObservations()
+
+		record(10, []string{"1", "2"}, []uint64{100, 200})
+
+		observations, err := s.feeMarketKeeper.GetObservations(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(observations, 2)
+		s.Require().Equal(uint64(100), observations[0].Utilization)
+		s.Require().Equal(uint64(200), observations[1].Utilization)
+	})
+
+	s.Run("insufficient data returns an error", func() {
+		defer resetObservations()
+
+		_, err := s.feeMarketKeeper.EmpiricalElasticity(s.ctx)
+		s.Require().ErrorIs(err, types.ErrInsufficientData)
+
+		record(10, []string{"1"}, []uint64{100})
+		_, err = s.feeMarketKeeper.EmpiricalElasticity(s.ctx)
+		s.Require().ErrorIs(err, types.ErrInsufficientData)
+
+		// The price never varied.
+		record(11, []string{"1", "1"}, []uint64{200, 300})
+		_, err = s.feeMarketKeeper.EmpiricalElasticity(s.ctx)
+		s.Require().ErrorIs(err, types.ErrInsufficientData)
+	})
+
+	s.Run("no gas consumed returns an error", func() {
+		defer resetObservations()
+
+		record(10, []string{"1", "2"}, []uint64{0, 0})
+		_, err := s.feeMarketKeeper.EmpiricalElasticity(s.ctx)
+		s.Require().ErrorIs(err, types.ErrInsufficientData)
+	})
+
+	s.Run("the fee market update records and prunes observations", func() {
+		defer resetObservations()
+
+		state := types.DefaultAIMDState()
+		for i := int64(0); i < int64(params.Window)+2; i++ {
+			ctx := s.ctx.WithBlockHeight(100 + i)
+			state.SetCurrentUtilization(uint64(i) * 1_000)
+			s.Require().NoError(s.feeMarketKeeper.SetState(ctx, state))
+			s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+
+			var err error
+			state, err = s.feeMarketKeeper.GetState(ctx)
+			s.Require().NoError(err)
+		}
+
+		observations, err := s.feeMarketKeeper.GetObservations(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Len(observations, int(params.Window))
+		s.Require().Equal(uint64(params.Window+1)*1_000, observations[len(observations)-1].Utilization)
+	})
+
+	s.Run("the fee market update records nothing while analytics are off", func() {
+		defer resetObservations()
+
+		disabled := params
+		disabled.RecordAnalytics = false
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, disabled))
+		defer func() { s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params)) }()
+
+		state := types.DefaultAIMDState()
+		state.SetCurrentUtilization(1_000)
+		ctx := s.ctx.WithBlockHeight(100)
+		s.Require().NoError(s.feeMarketKeeper.SetState(ctx, state))
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+
+		observations, err := s.feeMarketKeeper.GetObservations(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(observations)
+	})
+}
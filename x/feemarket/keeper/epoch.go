@@ -0,0 +1,111 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// AccumulateFees adds the given fees, which must already be held in the fee market's fee
+// collector, to the fees distributed at the end of the current distribution epoch. An error
+// is returned if no bank keeper is configured, as the fees could never be distributed; see
+// CanAccumulateFees.
+func (k *Keeper) AccumulateFees(ctx sdk.Context, fees sdk.Coins) error {
+	if k.bank == nil {
+		return types.ErrBankNotSet
+	}
+
+	accumulated, err := k.GetAccumulatedFees(ctx)
+	if err != nil {
+		return err
+	}
+
+	return k.setAccumulatedFees(ctx, accumulated.Add(fees...))
+}
+
+// CanAccumulateFees returns true if a bank keeper is configured to distribute the fees
+// accumulated over a distribution epoch. Without one, the post handler distributes fees in
+// every transaction even if DistributionEpochBlocks is set.
+func (k *Keeper) CanAccumulateFees() bool {
+	return k.bank != nil
+}
+
+// GetAccumulatedFees returns the fees accumulated for distribution at the end of the current
+// distribution epoch.
+func (k *Keeper) GetAccumulatedFees(ctx sdk.Context) (sdk.Coins, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyAccumulatedFees)
+	if bz == nil {
+		return sdk.NewCoins(), nil
+	}
+
+	var accumulated types.AccumulatedFees
+	if err := accumulated.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	return accumulated.Fees, nil
+}
+
+// DistributeAccumulatedFees sends the accumulated fees from the fee market's fee collector to
+// the default fee collector, where they are distributed to stakers, and returns them.
+func (k *Keeper) DistributeAccumulatedFees(ctx sdk.Context) (sdk.Coins, error) {
+	accumulated, err := k.GetAccumulatedFees(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if accumulated.IsZero() {
+		return accumulated, nil
+	}
+
+	if k.bank == nil {
+		return nil, types.ErrBankNotSet
+	}
+
+	err = k.bank.SendCoinsFromModuleToModule(ctx, types.FeeCollectorName, authtypes.FeeCollectorName, accumulated)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.KVStore(k.storeKey).Delete(types.KeyAccumulatedFees)
+
+	if err := k.RecordDistributedFees(ctx, accumulated); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeeDistribution,
+		sdk.NewAttribute(sdk.AttributeKeyFee, accumulated.String()),
+	))
+
+	return accumulated, nil
+}
+
+// DistributeAtEpochEnd distributes the accumulated fees if the current block ends a
+// distribution epoch. Fees accumulated before epochs were disabled are distributed in the
+// next block.
+func (k *Keeper) DistributeAtEpochEnd(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !params.IsDistributionEpochEnd(ctx.BlockHeight()) {
+		return nil
+	}
+
+	_, err = k.DistributeAccumulatedFees(ctx)
+	return err
+}
+
+func (k *Keeper) setAccumulatedFees(ctx sdk.Context, fees sdk.Coins) error {
+	bz, err := (&types.AccumulatedFees{Fees: fees}).Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.KeyAccumulatedFees, bz)
+
+	return nil
+}
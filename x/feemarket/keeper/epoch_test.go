@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/skip-mev/feemarket/x/feemarket/types/mocks"
+)
+
+func (s *KeeperTestSuite) TestDistributionEpochs() {
+	params := types.DefaultAIMDParams()
+	params.DistributeFees = true
+	params.DistributionEpochBlocks = 3
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	fees := func(amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, amount))
+	}
+
+	s.Run("accumulates fees across an epoch and distributes them at its end", func() {
+		bankKeeper := mocks.NewBankKeeper(s.T())
+		s.feeMarketKeeper.SetBankKeeper(bankKeeper)
+		defer s.feeMarketKeeper.SetBankKeeper(nil)
+
+		// Blocks 4 and 5 are inside the epoch ending at block 6.
+		for _, height := range []int64{4, 5} {
+			ctx := s.ctx.WithBlockHeight(height)
+			s.Require().NoError(s.feeMarketKeeper.AccumulateFees(ctx, fees(10)))
+			s.Require().NoError(s.feeMarketKeeper.DistributeAtEpochEnd(ctx))
+		}
+
+		accumulated, err := s.feeMarketKeeper.GetAccumulatedFees(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(fees(20), accumulated)
+
+		bankKeeper.On("SendCoinsFromModuleToModule", mock.Anything, types.FeeCollectorName, authtypes.FeeCollectorName, fees(25)).
+			Return(nil).Once()
+
+		ctx := s.ctx.WithBlockHeight(6).WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.AccumulateFees(ctx, fees(5)))
+		s.Require().NoError(s.feeMarketKeeper.DistributeAtEpochEnd(ctx))
+
+		accumulated, err = s.feeMarketKeeper.GetAccumulatedFees(ctx)
+		s.Require().NoError(err)
+		s.Require().True(accumulated.IsZero())
+
+		events := ctx.EventManager().Events()
+		s.Require().Len(events, 1)
+		s.Require().Equal(types.EventTypeFeeDistribution, events[0].Type)
+	})
+
+	s.Run("an epoch end without accumulated fees distributes nothing", func() {
+		bankKeeper := mocks.NewBankKeeper(s.T())
+		s.feeMarketKeeper.SetBankKeeper(bankKeeper)
+		defer s.feeMarketKeeper.SetBankKeeper(nil)
+
+		s.Require().NoError(s.feeMarketKeeper.DistributeAtEpochEnd(s.ctx.WithBlockHeight(9)))
+	})
+
+	s.Run("fees accumulated before epochs are disabled are distributed in the next block", func() {
+		bankKeeper := mocks.NewBankKeeper(s.T())
+		s.feeMarketKeeper.SetBankKeeper(bankKeeper)
+		defer s.feeMarketKeeper.SetBankKeeper(nil)
+		defer func() {
+			s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+		}()
+
+		s.Require().NoError(s.feeMarketKeeper.AccumulateFees(s.ctx.WithBlockHeight(10), fees(7)))
+
+		noEpochs := params
+		noEpochs.DistributionEpochBlocks = 0
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, noEpochs))
+
+		bankKeeper.On("SendCoinsFromModuleToModule", mock.Anything, types.FeeCollectorName, authtypes.FeeCollectorName, fees(7)).
+			Return(nil).Once()
+		s.Require().NoError(s.feeMarketKeeper.DistributeAtEpochEnd(s.ctx.WithBlockHeight(11)))
+	})
+
+	s.Run("accumulating without a bank keeper returns an error", func() {
+		err := s.feeMarketKeeper.AccumulateFees(s.ctx, fees(1))
+		s.Require().ErrorIs(err, types.ErrBankNotSet)
+	})
+}
@@ -0,0 +1,587 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// FeeRange returns the fee required at the current gas price in the given denom for
+// each point of a (min, likely, max) gas estimate distribution. The gas estimates must
+// be ordered such that minGas <= likelyGas <= maxGas.
+func (k *Keeper) FeeRange(ctx sdk.Context, minGas, likelyGas, maxGas uint64, denom string) (types.FeeRangeResult, error) {
+	if minGas > likelyGas || likelyGas > maxGas {
+		return types.FeeRangeResult{}, fmt.Errorf(
+			"gas estimates must be ordered min <= likely <= max; got %d, %d, %d", minGas, likelyGas, maxGas,
+		)
+	}
+
+	gasPrice, err := k.GetMinGasPrice(ctx, denom)
+	if err != nil {
+		return types.FeeRangeResult{}, err
+	}
+
+	return types.FeeRangeResult{
+		Min:    computeFee(gasPrice, minGas),
+		Likely: computeFee(gasPrice, likelyGas),
+		Max:    computeFee(gasPrice, maxGas),
+	}, nil
+}
+
+// FeeWithGasAdjustment applies the given gas adjustment factor to the simulated gas, as
+// wallets do to leave headroom for state changes between simulation and execution, and
+// returns the fee required for the adjusted gas limit at the current gas price in the given
+// denom along with the adjusted gas limit. The adjusted gas is rounded up so that it never
+// falls short of the adjustment. The adjustment must be at least 1.
+func (k *Keeper) FeeWithGasAdjustment(ctx sdk.Context, simulatedGas uint64, adjustment math.LegacyDec, denom string) (sdk.Coin, uint64, error) {
+	if adjustment.IsNil() || adjustment.LT(math.LegacyOneDec()) {
+		return sdk.Coin{}, 0, fmt.Errorf("gas adjustment must be at least 1; got %s", adjustment)
+	}
+
+	adjusted := math.LegacyNewDecFromInt(math.NewIntFromUint64(simulatedGas)).Mul(adjustment).Ceil().TruncateInt()
+	if !adjusted.IsUint64() {
+		return sdk.Coin{}, 0, fmt.Errorf("adjusted gas %s overflows a gas limit", adjusted)
+	}
+	gasLimit := adjusted.Uint64()
+
+	gasPrice, err := k.GetMinGasPrice(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, 0, err
+	}
+
+	return computeFee(gasPrice, gasLimit), gasLimit, nil
+}
+
+// computeFee returns the fee required to pay for the given amount of gas at the given
+// gas price. It rounds with types.FeeRoundingMode, the mode used by the ante handler, so
+// that estimated fees always match the fee that is checked and deducted.
+func computeFee(gasPrice sdk.DecCoin, gas uint64) sdk.Coin {
+	return types.ComputeFee(gasPrice, gas, types.FeeRoundingMode)
+}
+
+// RequiredBump returns the additional fee that must be added to a tx with the given fee and gas
+// limit for it to meet the fee currently required by the fee market. This is intended for
+// wallets that offer to speed up a stuck tx. The bump is computed separately for each denom
+// of the current fee, since the tx may be re-signed to pay in any of them; denoms that
+// already meet the required fee are omitted, so an empty result means no bump is needed. If
+// the current fee is empty, the full required fee in the fee denom is returned.
+func (k *Keeper) RequiredBump(ctx sdk.Context, currentFee sdk.Coins, gas uint64) (sdk.Coins, error) {
+	if currentFee.Empty() {
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		currentFee = sdk.Coins{sdk.NewCoin(params.FeeDenom, math.ZeroInt())}
+	}
+
+	bump := sdk.NewCoins()
+	for _, fee := range currentFee {
+		gasPrice, err := k.GetMinGasPrice(ctx, fee.Denom)
+		if err != nil {
+			return nil, err
+		}
+
+		required := computeFee(gasPrice, gas)
+		if fee.IsGTE(required) {
+			continue
+		}
+
+		bump = bump.Add(required.Sub(fee))
+	}
+
+	return bump, nil
+}
+
+// MaxProjectionBlocks is the maximum number of blocks the fee market can be projected
+// forward by.
+const MaxProjectionBlocks = 10_000
+
+// OptimalTransactBlock projects the base gas price forward from the current block to the
+// given deadline, assuming every block has the given utilization (as a fraction of the
+// max block utilization), and returns the block at which the projected price is lowest
+// along with that price. If several blocks share the lowest price, the earliest is
+// returned. The projection applies the AIMD learning rate and base gas price updates
+// performed in EndBlock.
+func (k *Keeper) OptimalTransactBlock(ctx sdk.Context, deadline int64, assumedUtilization math.LegacyDec) (int64, math.LegacyDec, error) {
+	var (
+		bestBlock int64
+		bestPrice math.LegacyDec
+	)
+
+	err := k.projectBaseGasPrice(ctx, deadline, assumedUtilization, func(block int64, price math.LegacyDec) {
+		if bestPrice.IsNil() || price.LT(bestPrice) {
+			bestBlock, bestPrice = block, price
+		}
+	})
+	if err != nil {
+		return 0, math.LegacyDec{}, err
+	}
+
+	return bestBlock, bestPrice, nil
+}
+
+// ProjectGasPrice projects the fee market forward by the given number of blocks in the same
+// manner as OptimalTransactBlock, assuming every block has the given utilization (as a
+// fraction of the max block utilization), and returns the projected base gas price and
+// learning rate. As in EndBlock, the price is floored at MinBaseGasPrice and the learning
+// rate is kept within its bounds. The projection is not dampened by the warmup. At most
+// MaxProjectionBlocks blocks can be projected, and the stored state is left untouched.
+func (k *Keeper) ProjectGasPrice(ctx sdk.Context, blocks uint64, assumedUtilization math.LegacyDec) (math.LegacyDec, math.LegacyDec, error) {
+	if blocks > MaxProjectionBlocks {
+		return math.LegacyDec{}, math.LegacyDec{}, fmt.Errorf("cannot project more than %d blocks ahead; got %d", MaxProjectionBlocks, blocks)
+	}
+
+	var projected types.State
+	err := k.projectState(ctx, ctx.BlockHeight()+int64(blocks), assumedUtilization, func(_ int64, state types.State) {
+		projected = state
+	})
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+
+	return projected.BaseGasPrice, projected.LearningRate, nil
+}
+
+// FeeAtFutureBlock projects the base gas price forward to the target block in the same
+// manner as OptimalTransactBlock and returns the fee required for the given amount of gas
+// at the projected price in the given denom. The projected price is converted as in
+// GetMinGasPrice, so the floor, the resolver rate guard and the min gas price of the denom
+// all apply.
+func (k *Keeper) FeeAtFutureBlock(ctx sdk.Context, targetBlock int64, gas uint64, assumedUtilization math.LegacyDec, denom string) (sdk.Coin, error) {
+	var projected math.LegacyDec
+
+	err := k.projectBaseGasPrice(ctx, targetBlock, assumedUtilization, func(_ int64, price math.LegacyDec) {
+		projected = price
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	gasPrice, err := k.minGasPrice(ctx, params, projected, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return computeFee(gasPrice, gas), nil
+}
+
+// RobustFee returns a fee for the given amount of gas in the given denom that remains
+// sufficient over the next withinBlocks blocks, even if the gas price rises as fast as it
+// can. This is a safe over-estimate for users who want near-certain inclusion. The base gas
+// price is projected forward as in FeeAtFutureBlock, assuming every block is full. That
+// raises the price, and the learning rate, by the most the EndBlock update allows per block.
+// The fee is priced at the highest price on that path.
+//
+// A denom other than the fee denom is converted as in GetMinGasPrice. If the resolver rate
+// guard is enabled and a rate is recorded for the denom, the rate is also assumed to rise
+// by MaxRateChangePerBlock per block, the most the guard lets a rate move. Without the guard
+// the current rate is used, as the rate is not bounded. As in GetMinGasPrice, the fee is
+// never priced below the min gas price of the denom. A withinBlocks of zero returns the fee
+// currently required.
+func (k *Keeper) RobustFee(ctx sdk.Context, gas uint64, withinBlocks int64, denom string) (sdk.Coin, error) {
+	if withinBlocks < 0 {
+		return sdk.Coin{}, fmt.Errorf("blocks must be non-negative; got %d", withinBlocks)
+	}
+
+	var worst math.LegacyDec
+	err := k.projectBaseGasPrice(ctx, ctx.BlockHeight()+withinBlocks, math.LegacyOneDec(), func(_ int64, price math.LegacyDec) {
+		if worst.IsNil() || price.GT(worst) {
+			worst = price
+		}
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if params.MinBaseGasPrice.GT(worst) {
+		worst = params.MinBaseGasPrice
+	}
+
+	gasPrice := sdk.NewDecCoinFromDec(params.FeeDenom, worst)
+	if denom == params.FeeDenom {
+		gasPrice, err = k.applyDenomMinGasPrice(ctx, gasPrice)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		return computeFee(gasPrice, gas), nil
+	}
+
+	gasPrice, err = k.ResolveToDenomCached(ctx, gasPrice, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	gasPrice, err = k.guardGasPrice(ctx, params, worst, gasPrice)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if withinBlocks > 0 && rateGuardEnabled(params) {
+		last, ok, err := k.GetLastResolverRate(ctx, denom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		// The guard accepts rates within MaxRateChangePerBlock of the last recorded rate,
+		// which itself moves by at most MaxRateChangePerBlock at the end of each block. The
+		// rate in withinBlocks blocks is therefore at most last * (1 + max)^(withinBlocks+1).
+		if ok {
+			bound, err := maxGuardedRate(params, last, withinBlocks+1)
+			if err != nil {
+				return sdk.Coin{}, err
+			}
+
+			if price := worst.Mul(bound); price.GT(gasPrice.Amount) {
+				gasPrice.Amount = price
+			}
+		}
+	}
+
+	gasPrice, err = k.applyDenomMinGasPrice(ctx, gasPrice)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return computeFee(gasPrice, gas), nil
+}
+
+// maxGuardedRate returns the highest rate the resolver rate guard can accept the given
+// number of blocks after the given last rate was recorded.
+func maxGuardedRate(params types.Params, last math.LegacyDec, blocks int64) (rate math.LegacyDec, err error) {
+	// Catch an overflow of the compounded rate.
+	defer func() {
+		if rec := recover(); rec != nil {
+			rate, err = math.LegacyDec{}, fmt.Errorf("max rate change compounded over %d blocks overflows", blocks)
+		}
+	}()
+
+	growth := math.LegacyOneDec().Add(params.MaxRateChangePerBlock).Power(uint64(blocks))
+	return last.Mul(growth), nil
+}
+
+// projectBaseGasPrice projects the base gas price forward from the current block to
+// toBlock, assuming every block has the given utilization, and calls fn with the price of
+// each block in order, starting with the current block.
+func (k *Keeper) projectBaseGasPrice(
+	ctx sdk.Context,
+	toBlock int64,
+	assumedUtilization math.LegacyDec,
+	fn func(block int64, price math.LegacyDec),
+) error {
+	return k.projectState(ctx, toBlock, assumedUtilization, func(block int64, state types.State) {
+		fn(block, state.BaseGasPrice)
+	})
+}
+
+// projectState projects the fee market state forward in the same manner as
+// projectBaseGasPrice, and calls fn with the state of each block in order, starting with the
+// current block. The stored state is left untouched.
+func (k *Keeper) projectState(
+	ctx sdk.Context,
+	toBlock int64,
+	assumedUtilization math.LegacyDec,
+	fn func(block int64, state types.State),
+) error {
+	height := ctx.BlockHeight()
+	if toBlock < height {
+		return fmt.Errorf("block %d is before the current height %d", toBlock, height)
+	}
+	if toBlock-height > MaxProjectionBlocks {
+		return fmt.Errorf("block %d is more than %d blocks ahead", toBlock, MaxProjectionBlocks)
+	}
+	if assumedUtilization.IsNil() || assumedUtilization.IsNegative() || assumedUtilization.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("assumed utilization must be between [0, 1]")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return err
+	}
+
+	state.ReconcileWindowSum()
+	blockGas := assumedUtilization.MulInt(math.NewIntFromUint64(params.MaxBlockUtilization)).TruncateInt().Uint64()
+
+	fn(height, state)
+	for block := height + 1; block <= toBlock; block++ {
+		// The price for the next block is determined by the utilization of the
+		// previous one.
+		state.SetCurrentUtilization(blockGas)
+		state.UpdateLearningRate(params)
+		state.UpdateBaseGasPrice(params)
+		state.IncrementHeight()
+
+		fn(block, state)
+	}
+
+	return nil
+}
+
+// CommittedGasPrice returns the gas price in the fee denom for a user committing to consume
+// the given amount of gas evenly over the next commitmentBlocks blocks. The price is based
+// on the projected average rather than the spot price:
+//
+//  1. Every block of the commitment is assumed to have the current average window
+//     utilization, plus its share of the committed gas, capped at a full block.
+//  2. The base gas price is projected forward over the commitment under that assumption.
+//  3. The committed price is the average projected price, capped at the spot price.
+//
+// The commitment is therefore priced at a discount when prices are expected to fall, and
+// at the spot price otherwise.
+func (k *Keeper) CommittedGasPrice(ctx sdk.Context, gas uint64, commitmentBlocks int64) (sdk.DecCoin, error) {
+	if commitmentBlocks <= 0 {
+		return sdk.DecCoin{}, fmt.Errorf("commitment must span at least one block; got %d", commitmentBlocks)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	assumedUtilization := state.GetAverageUtilization(params)
+	if params.MaxBlockUtilization > 0 {
+		perBlock := math.LegacyNewDecFromInt(math.NewIntFromUint64(gas)).QuoInt64(commitmentBlocks)
+		assumedUtilization = assumedUtilization.Add(perBlock.QuoInt(math.NewIntFromUint64(params.MaxBlockUtilization)))
+	}
+	if assumedUtilization.GT(math.LegacyOneDec()) {
+		assumedUtilization = math.LegacyOneDec()
+	}
+
+	// The current block is excluded, as the commitment starts with the next one.
+	height := ctx.BlockHeight()
+	total := math.LegacyZeroDec()
+	err = k.projectBaseGasPrice(ctx, height+commitmentBlocks, assumedUtilization, func(block int64, price math.LegacyDec) {
+		if block > height {
+			total = total.Add(price)
+		}
+	})
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	committed := total.QuoInt64(commitmentBlocks)
+	if committed.GT(state.BaseGasPrice) {
+		committed = state.BaseGasPrice
+	}
+
+	return sdk.NewDecCoinFromDec(params.FeeDenom, committed), nil
+}
+
+// EquilibriumUtilization returns the constant block utilization, as a fraction of the max
+// block utilization, at which the base gas price neither rises nor falls over time. With a
+// constant utilization u and target T, every block applies the multiplier
+// 1 + learningRate * (u - T) / T and, once the window is filled with u, the additive
+// adjustment delta * window * (u - T). Both vanish only at u = T, so the learning rate
+// dynamics change how quickly the price converges but not where: the equilibrium is the
+// target utilization for any state. The target is MaxBlockUtilization / 2 rounded down,
+// so for an odd max block utilization the equilibrium sits slightly below one half. Note
+// that a positive TargetDeadBand also holds the price steady for any utilization within
+// the band, and that a price at MinBaseGasPrice cannot fall for any utilization below
+// the target.
+func (k *Keeper) EquilibriumUtilization(ctx sdk.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if params.MaxBlockUtilization == 0 {
+		return math.LegacyDec{}, fmt.Errorf("max block utilization is zero")
+	}
+
+	target := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.TargetBlockUtilization()))
+	return target.Quo(math.LegacyNewDecFromInt(math.NewIntFromUint64(params.MaxBlockUtilization))), nil
+}
+
+// responseThreshold is the fraction of a price gap left once the market has responded
+// 63% of the way to its new equilibrium, i.e. 1/e.
+var responseThreshold = math.LegacyMustNewDecFromStr("0.367879441171442322")
+
+// ResponseTime returns the number of blocks the base gas price takes to close 63% (1 - 1/e)
+// of the gap to its new equilibrium after a step change in demand, i.e. the time constant
+// of the price controller. This gives an intuitive "the market reacts in ~N blocks" figure
+// for tuning.
+//
+// Each block multiplies the price by 1 + lr * (u - T) / T for the block utilization u and
+// target T. Assuming demand with unit elasticity around the equilibrium price p*, a
+// relative price gap x = (p - p*) / p* moves utilization to (u - T) / T = -x, so every
+// block shrinks the gap by a factor of (1 - lr). The step is assumed to push the average
+// utilization outside of the Gamma band, so the learning rate starts at its current value
+// and grows by Alpha each block up to MaxLearningRate. The response time is the smallest n
+// such that (1 - lr_1) * ... * (1 - lr_n) <= 1/e. For a constant learning rate this is
+// about 1 / lr blocks. Delta and TargetDeadBand are not accounted for.
+//
+// An error is returned if the learning rate is not positive or the price would not respond
+// within 2^61 blocks.
+func (k *Keeper) ResponseTime(ctx sdk.Context) (int64, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	lr, err := k.GetLearningRate(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	one := math.LegacyOneDec()
+	gapFactor := func(lr math.LegacyDec) math.LegacyDec {
+		return math.LegacyMaxDec(one.Sub(lr), math.LegacyZeroDec())
+	}
+
+	// Walk block by block while the learning rate is still changing.
+	remaining := one
+	blocks := int64(0)
+	for {
+		next := math.LegacyMinDec(lr.Add(params.Alpha), params.MaxLearningRate)
+		if next.Equal(lr) {
+			break
+		}
+		lr = next
+
+		blocks++
+		remaining = remaining.Mul(gapFactor(lr))
+		if remaining.LTE(responseThreshold) {
+			return blocks, nil
+		}
+	}
+
+	if !lr.IsPositive() {
+		return 0, fmt.Errorf("the learning rate is never positive, so the price never responds")
+	}
+
+	// The learning rate is now constant, so search for the remaining number of blocks.
+	factor := gapFactor(lr)
+	responded := func(n uint64) bool {
+		return remaining.Mul(factor.Power(n)).LTE(responseThreshold)
+	}
+
+	hi := uint64(1)
+	for !responded(hi) {
+		if hi >= 1<<61 {
+			return 0, fmt.Errorf("the price does not respond within %d blocks", hi)
+		}
+		hi *= 2
+	}
+
+	lo := hi / 2
+	for lo+1 < hi {
+		mid := lo + (hi-lo)/2
+		if responded(mid) {
+			hi = mid
+		} else {
+			lo = mid
+		}
+	}
+
+	return blocks + int64(hi), nil
+}
+
+// ExpectedPricePaid estimates the mean and standard deviation of the base gas price a user
+// pays when aiming for inclusion within the next inclusionTargetBlocks blocks. The per-block
+// relative price changes observed over the window are treated as independent draws with the
+// observed mean m and variance v. The next block charges the current base gas price p, and
+// the price j blocks after it has mean p * (1+m)^j and second moment p^2 * (v + (1+m)^2)^j.
+// The tx is assumed equally likely to be included in any of the target blocks, so the price
+// paid is the equal-weight mixture of these distributions. The model ignores the MinBaseGasPrice
+// floor, so it overstates the spread when the price is close to the floor.
+// ErrInsufficientData is returned if fewer than two positive prices were observed.
+func (k *Keeper) ExpectedPricePaid(ctx sdk.Context, inclusionTargetBlocks int64) (mean, stddev math.LegacyDec, err error) {
+	if inclusionTargetBlocks < 1 {
+		return mean, stddev, fmt.Errorf("inclusion target must be at least one block; got %d", inclusionTargetBlocks)
+	}
+
+	observations, err := k.GetObservations(ctx)
+	if err != nil {
+		return mean, stddev, err
+	}
+
+	changes := priceChanges(observations)
+	if len(changes) == 0 {
+		return mean, stddev, types.ErrInsufficientData.Wrapf("got %d observations, need at least 2 positive prices", len(observations))
+	}
+
+	n := int64(len(changes))
+	drift := meanDec(changes)
+
+	variance := math.LegacyZeroDec()
+	for _, change := range changes {
+		d := change.Sub(drift)
+		variance = variance.Add(d.Mul(d))
+	}
+	variance = variance.QuoInt64(n)
+
+	price, err := k.GetBaseGasPrice(ctx)
+	if err != nil {
+		return mean, stddev, err
+	}
+
+	growth := math.LegacyOneDec().Add(drift)
+	secondGrowth := variance.Add(growth.Mul(growth))
+
+	sumMean, sumSecond := math.LegacyZeroDec(), math.LegacyZeroDec()
+	for j := uint64(0); j < uint64(inclusionTargetBlocks); j++ {
+		sumMean = sumMean.Add(growth.Power(j))
+		sumSecond = sumSecond.Add(secondGrowth.Power(j))
+	}
+
+	mean = price.Mul(sumMean).QuoInt64(inclusionTargetBlocks)
+	second := price.Mul(price).Mul(sumSecond).QuoInt64(inclusionTargetBlocks)
+
+	spread := math.LegacyMaxDec(second.Sub(mean.Mul(mean)), math.LegacyZeroDec())
+	stddev, err = spread.ApproxSqrt()
+	if err != nil {
+		return math.LegacyDec{}, math.LegacyDec{}, err
+	}
+
+	return mean, stddev, nil
+}
+
+// priceChanges returns the relative change of the base gas price between each pair of
+// consecutive observations, skipping pairs whose earlier price is not positive.
+func priceChanges(observations []types.BlockObservation) []math.LegacyDec {
+	var changes []math.LegacyDec
+	for i := 1; i < len(observations); i++ {
+		prev := observations[i-1].BaseGasPrice
+		if !prev.IsPositive() {
+			continue
+		}
+
+		changes = append(changes, observations[i].BaseGasPrice.Quo(prev).Sub(math.LegacyOneDec()))
+	}
+
+	return changes
+}
+
+// meanDec returns the mean of a non-empty list of decs.
+func meanDec(decs []math.LegacyDec) math.LegacyDec {
+	sum := math.LegacyZeroDec()
+	for _, dec := range decs {
+		sum = sum.Add(dec)
+	}
+
+	return sum.QuoInt64(int64(len(decs)))
+}
@@ -0,0 +1,627 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestFeeRange() {
+	s.Run("computes the fee at each point of the distribution", func() {
+		gs := types.DefaultGenesisState()
+		gs.State.BaseGasPrice = math.LegacyMustNewDecFromStr("1.5")
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		result, err := s.feeMarketKeeper.FeeRange(s.ctx, 100, 151, 300, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+
+		s.Require().Equal(sdk.NewInt64Coin(types.DefaultFeeDenom, 150), result.Min)
+		// 151 * 1.5 = 226.5 which is rounded up.
+		s.Require().Equal(sdk.NewInt64Coin(types.DefaultFeeDenom, 227), result.Likely)
+		s.Require().Equal(sdk.NewInt64Coin(types.DefaultFeeDenom, 450), result.Max)
+
+		s.Require().True(result.Min.IsLTE(result.Likely))
+		s.Require().True(result.Likely.IsLTE(result.Max))
+	})
+
+	s.Run("equal gas estimates return equal fees", func() {
+		gs := types.DefaultGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		result, err := s.feeMarketKeeper.FeeRange(s.ctx, 100, 100, 100, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(result.Min, result.Likely)
+		s.Require().Equal(result.Likely, result.Max)
+	})
+
+	s.Run("unordered gas estimates return an error", func() {
+		gs := types.DefaultGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		_, err := s.feeMarketKeeper.FeeRange(s.ctx, 200, 100, 300, types.DefaultFeeDenom)
+		s.Require().Error(err)
+
+		_, err = s.feeMarketKeeper.FeeRange(s.ctx, 100, 300, 200, types.DefaultFeeDenom)
+		s.Require().Error(err)
+	})
+
+	s.Run("resolves the fee into another denom", func() {
+		gs := types.DefaultGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		result, err := s.feeMarketKeeper.FeeRange(s.ctx, 1, 2, 3, "atom")
+		s.Require().NoError(err)
+		s.Require().Equal("atom", result.Max.Denom)
+		s.Require().Equal(sdk.NewInt64Coin("atom", 3), result.Max)
+	})
+}
+
+func (s *KeeperTestSuite) TestFeeWithGasAdjustment() {
+	s.Run("adjusts the gas and prices the adjusted gas", func() {
+		gs := types.DefaultGenesisState()
+		gs.State.BaseGasPrice = math.LegacyMustNewDecFromStr("1.5")
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		// 100,000 * 1.3 = 130,000 gas, and 130,000 * 1.5 = 195,000.
+		fee, gasLimit, err := s.feeMarketKeeper.FeeWithGasAdjustment(s.ctx, 100_000, math.LegacyMustNewDecFromStr("1.3"), types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(130_000), gasLimit)
+		s.Require().Equal(sdk.NewInt64Coin(types.DefaultFeeDenom, 195_000), fee)
+
+		required, err := s.feeMarketKeeper.GetRequiredFee(s.ctx, gasLimit, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(required, fee)
+	})
+
+	s.Run("rounds the adjusted gas up", func() {
+		gs := types.DefaultGenesisState()
+		gs.State.BaseGasPrice = math.LegacyMustNewDecFromStr("1.5")
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		// 101 * 1.5 = 151.5 which is rounded up to 152 gas, and 152 * 1.5 = 228.
+		fee, gasLimit, err := s.feeMarketKeeper.FeeWithGasAdjustment(s.ctx, 101, math.LegacyMustNewDecFromStr("1.5"), types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(152), gasLimit)
+		s.Require().Equal(sdk.NewInt64Coin(types.DefaultFeeDenom, 228), fee)
+	})
+
+	s.Run("an adjustment of one leaves the gas unchanged", func() {
+		gs := types.DefaultGenesisState()
+		gs.State.BaseGasPrice = math.LegacyMustNewDecFromStr("1.5")
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		fee, gasLimit, err := s.feeMarketKeeper.FeeWithGasAdjustment(s.ctx, 151, math.LegacyOneDec(), types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(151), gasLimit)
+		s.Require().Equal(sdk.NewInt64Coin(types.DefaultFeeDenom, 227), fee)
+	})
+
+	s.Run("rejects an adjustment below one", func() {
+		gs := types.DefaultGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		_, _, err := s.feeMarketKeeper.FeeWithGasAdjustment(s.ctx, 100, math.LegacyMustNewDecFromStr("0.9"), types.DefaultFeeDenom)
+		s.Require().Error(err)
+
+		_, _, err = s.feeMarketKeeper.FeeWithGasAdjustment(s.ctx, 100, math.LegacyDec{}, types.DefaultFeeDenom)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects an adjusted gas that overflows", func() {
+		gs := types.DefaultGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		_, _, err := s.feeMarketKeeper.FeeWithGasAdjustment(s.ctx, ^uint64(0), math.LegacyMustNewDecFromStr("1.01"), types.DefaultFeeDenom)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestEstimationMatchesDeduction() {
+	prices := []string{"1", "1.5", "0.025", "0.333333333333333333", "1.000000000000000001", "0.999999999999999999"}
+	gases := []uint64{1, 3, 151, 100_000, 1_234_567}
+
+	// checkDeduction asserts that the ante handler accepts exactly the estimated fee and
+	// rejects anything less.
+	checkDeduction := func(ctx sdk.Context, gasPrice sdk.DecCoin, fee sdk.Coin, gas uint64) {
+		payCoin, tip, err := types.CheckTxFee(ctx, gasPrice, fee, int64(gas), true)
+		s.Require().NoError(err)
+		s.Require().Equal(fee, payCoin)
+		s.Require().True(tip.IsZero())
+
+		if fee.IsPositive() {
+			_, _, err = types.CheckTxFee(ctx, gasPrice, fee.SubAmount(math.OneInt()), int64(gas), true)
+			s.Require().Error(err)
+		}
+	}
+
+	for _, price := range prices {
+		gs := types.DefaultGenesisState()
+		gs.State.BaseGasPrice = math.LegacyMustNewDecFromStr(price)
+		gs.Params.MinBaseGasPrice = gs.State.BaseGasPrice
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+
+		for _, gas := range gases {
+			result, err := s.feeMarketKeeper.FeeRange(s.ctx, gas, gas, gas, types.DefaultFeeDenom)
+			s.Require().NoError(err)
+			checkDeduction(s.ctx, gasPrice, result.Likely, gas)
+
+			// Projecting to the current block prices at the current gas price.
+			fee, err := s.feeMarketKeeper.FeeAtFutureBlock(s.ctx, s.ctx.BlockHeight(), gas, math.LegacyZeroDec(), types.DefaultFeeDenom)
+			s.Require().NoError(err)
+			s.Require().Equal(result.Likely, fee)
+
+			// Bumping an empty fee by the required bump yields the estimated fee.
+			bump, err := s.feeMarketKeeper.RequiredBump(s.ctx, sdk.NewCoins(), gas)
+			s.Require().NoError(err)
+			s.Require().Equal(result.Likely.Amount, bump.AmountOf(types.DefaultFeeDenom))
+		}
+	}
+}
+
+func (s *KeeperTestSuite) TestRequiredBump() {
+	s.Run("a stuck tx requires a positive bump", func() {
+		gs := types.DefaultGenesisState()
+		gs.State.BaseGasPrice = math.LegacyMustNewDecFromStr("1.5")
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		// 151 * 1.5 = 226.5 which is rounded up to 227.
+		bump, err := s.feeMarketKeeper.RequiredBump(s.ctx, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 200)), 151)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 27)), bump)
+	})
+
+	s.Run("an already sufficient tx requires no bump", func() {
+		gs := types.DefaultGenesisState()
+		gs.State.BaseGasPrice = math.LegacyMustNewDecFromStr("1.5")
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		bump, err := s.feeMarketKeeper.RequiredBump(s.ctx, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 227)), 151)
+		s.Require().NoError(err)
+		s.Require().True(bump.IsZero())
+
+		bump, err = s.feeMarketKeeper.RequiredBump(s.ctx, sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 1_000)), 151)
+		s.Require().NoError(err)
+		s.Require().True(bump.IsZero())
+	})
+
+	s.Run("computes the bump separately for each denom", func() {
+		gs := types.DefaultGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		fee := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 100), sdk.NewInt64Coin("atom", 40))
+		bump, err := s.feeMarketKeeper.RequiredBump(s.ctx, fee, 100)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 60)), bump)
+	})
+
+	s.Run("an empty fee requires the full fee in the fee denom", func() {
+		gs := types.DefaultGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		bump, err := s.feeMarketKeeper.RequiredBump(s.ctx, sdk.NewCoins(), 100)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 100)), bump)
+	})
+}
+
+func (s *KeeperTestSuite) TestOptimalTransactBlock() {
+	s.Run("decaying projection is lowest at the deadline", func() {
+		gs := types.DefaultAIMDGenesisState()
+		gs.State.BaseGasPrice = gs.Params.MinBaseGasPrice.MulInt64(100)
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		ctx := s.ctx.WithBlockHeight(10)
+		block, price, err := s.feeMarketKeeper.OptimalTransactBlock(ctx, 15, math.LegacyZeroDec())
+		s.Require().NoError(err)
+		s.Require().Equal(int64(15), block)
+		s.Require().True(price.LT(gs.State.BaseGasPrice))
+		s.Require().True(price.GTE(gs.Params.MinBaseGasPrice))
+
+		// A later deadline can only yield an equal or lower price.
+		_, laterPrice, err := s.feeMarketKeeper.OptimalTransactBlock(ctx, 20, math.LegacyZeroDec())
+		s.Require().NoError(err)
+		s.Require().True(laterPrice.LT(price))
+	})
+
+	s.Run("rising projection is lowest at the current block", func() {
+		gs := types.DefaultAIMDGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		ctx := s.ctx.WithBlockHeight(10)
+		block, price, err := s.feeMarketKeeper.OptimalTransactBlock(ctx, 15, math.LegacyOneDec())
+		s.Require().NoError(err)
+		s.Require().Equal(int64(10), block)
+		s.Require().Equal(gs.State.BaseGasPrice, price)
+	})
+
+	s.Run("deadline at the current block returns the current price", func() {
+		gs := types.DefaultAIMDGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		ctx := s.ctx.WithBlockHeight(10)
+		block, price, err := s.feeMarketKeeper.OptimalTransactBlock(ctx, 10, math.LegacyZeroDec())
+		s.Require().NoError(err)
+		s.Require().Equal(int64(10), block)
+		s.Require().Equal(gs.State.BaseGasPrice, price)
+	})
+
+	s.Run("invalid inputs return an error", func() {
+		ctx := s.ctx.WithBlockHeight(10)
+
+		_, _, err := s.feeMarketKeeper.OptimalTransactBlock(ctx, 9, math.LegacyZeroDec())
+		s.Require().Error(err)
+
+		_, _, err = s.feeMarketKeeper.OptimalTransactBlock(ctx, 10+keeper.MaxProjectionBlocks+1, math.LegacyZeroDec())
+		s.Require().Error(err)
+
+		_, _, err = s.feeMarketKeeper.OptimalTransactBlock(ctx, 15, math.LegacyMustNewDecFromStr("1.1"))
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestFeeAtFutureBlock() {
+	s.Run("prices the gas at the projected price", func() {
+		gs := types.DefaultAIMDGenesisState()
+		gs.State.BaseGasPrice = gs.Params.MinBaseGasPrice.MulInt64(100)
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		ctx := s.ctx.WithBlockHeight(10)
+		_, projected, err := s.feeMarketKeeper.OptimalTransactBlock(ctx, 15, math.LegacyZeroDec())
+		s.Require().NoError(err)
+
+		fee, err := s.feeMarketKeeper.FeeAtFutureBlock(ctx, 15, 1000, math.LegacyZeroDec(), types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoin(types.DefaultFeeDenom, projected.MulInt64(1000).Ceil().TruncateInt()), fee)
+
+		// The projected fee is lower than the fee at the current price.
+		current, err := s.feeMarketKeeper.FeeAtFutureBlock(ctx, 10, 1000, math.LegacyZeroDec(), types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoin(types.DefaultFeeDenom, gs.State.BaseGasPrice.MulInt64(1000).TruncateInt()), current)
+		s.Require().True(fee.IsLT(current))
+	})
+
+	s.Run("rising projection increases the fee", func() {
+		gs := types.DefaultAIMDGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		ctx := s.ctx.WithBlockHeight(10)
+		current, err := s.feeMarketKeeper.FeeAtFutureBlock(ctx, 10, 1000, math.LegacyOneDec(), types.DefaultFeeDenom)
+		s.Require().NoError(err)
+
+		fee, err := s.feeMarketKeeper.FeeAtFutureBlock(ctx, 12, 1000, math.LegacyOneDec(), types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().True(current.IsLT(fee))
+	})
+
+	s.Run("resolves the fee into another denom", func() {
+		gs := types.DefaultAIMDGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		ctx := s.ctx.WithBlockHeight(10)
+		fee, err := s.feeMarketKeeper.FeeAtFutureBlock(ctx, 12, 1000, math.LegacyZeroDec(), "atom")
+		s.Require().NoError(err)
+		s.Require().Equal("atom", fee.Denom)
+	})
+
+	s.Run("never prices below the min gas price of the denom", func() {
+		gs := types.DefaultAIMDGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		floor := gs.Params.MinBaseGasPrice.MulInt64(50)
+		s.Require().NoError(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, types.DefaultFeeDenom, floor))
+		defer func() {
+			s.Require().NoError(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, types.DefaultFeeDenom, math.LegacyZeroDec()))
+		}()
+
+		ctx := s.ctx.WithBlockHeight(10)
+		fee, err := s.feeMarketKeeper.FeeAtFutureBlock(ctx, 12, 1000, math.LegacyZeroDec(), types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoin(types.DefaultFeeDenom, floor.MulInt64(1000).Ceil().TruncateInt()), fee)
+	})
+
+	s.Run("target block in the past returns an error", func() {
+		ctx := s.ctx.WithBlockHeight(10)
+
+		_, err := s.feeMarketKeeper.FeeAtFutureBlock(ctx, 9, 1000, math.LegacyZeroDec(), types.DefaultFeeDenom)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestRobustFee() {
+	s.Run("zero blocks returns the required fee", func() {
+		gs := types.DefaultAIMDGenesisState()
+		gs.State.BaseGasPrice = gs.Params.MinBaseGasPrice.MulInt64(3)
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		fee, err := s.feeMarketKeeper.RobustFee(s.ctx, 1000, 0, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+
+		required, err := s.feeMarketKeeper.GetRequiredFee(s.ctx, 1000, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(required, fee)
+	})
+
+	s.Run("covers the worst-case price path", func() {
+		gs := types.DefaultAIMDGenesisState()
+		gs.State.BaseGasPrice = gs.Params.MinBaseGasPrice.MulInt64(3)
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		const within = 5
+		ctx := s.ctx.WithBlockHeight(10)
+		robust, err := s.feeMarketKeeper.RobustFee(ctx, 1000, within, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+
+		// The robust fee is the fee at the end of a run of full blocks.
+		projected, err := s.feeMarketKeeper.FeeAtFutureBlock(ctx, 10+within, 1000, math.LegacyOneDec(), types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(projected, robust)
+
+		current, err := s.feeMarketKeeper.GetRequiredFee(ctx, 1000, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().True(current.IsLT(robust))
+
+		// Fill every block and run the EndBlock update; the required fee never exceeds the
+		// robust fee within the deadline.
+		for height := int64(10); height < 10+within; height++ {
+			blockCtx := ctx.WithBlockHeight(height)
+
+			state, err := s.feeMarketKeeper.GetState(blockCtx)
+			s.Require().NoError(err)
+			state.SetCurrentUtilization(gs.Params.MaxBlockUtilization)
+			s.Require().NoError(s.feeMarketKeeper.SetState(blockCtx, state))
+			s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(blockCtx))
+
+			required, err := s.feeMarketKeeper.GetRequiredFee(blockCtx.WithBlockHeight(height+1), 1000, types.DefaultFeeDenom)
+			s.Require().NoError(err)
+			s.Require().True(required.IsLTE(robust), "required fee %s exceeds the robust fee %s at height %d", required, robust, height+1)
+		}
+	})
+
+	s.Run("assumes the guarded rate rises as fast as allowed", func() {
+		params := types.DefaultParams()
+		params.MaxRateChangePerBlock = math.LegacyMustNewDecFromStr("0.1")
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, types.DefaultState()))
+
+		resolver := &prospectiveDenomResolver{denom: "uatom", rate: math.LegacyNewDec(10)}
+		s.feeMarketKeeper.SetDenomResolver(resolver)
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+		s.Require().NoError(s.feeMarketKeeper.RecordResolverRates(s.ctx.WithBlockHeight(1)))
+
+		const within = 3
+		ctx := s.ctx.WithBlockHeight(2)
+		robust, err := s.feeMarketKeeper.RobustFee(ctx, 1_000_000, within, "uatom")
+		s.Require().NoError(err)
+
+		// Fill every block and raise the rate by as much as the guard accepts in every
+		// block; the required fee never exceeds the robust fee within the deadline.
+		for height := int64(2); height <= 2+within; height++ {
+			blockCtx := ctx.WithBlockHeight(height)
+			resolver.rate = resolver.rate.Add(resolver.rate.Mul(params.MaxRateChangePerBlock))
+
+			required, err := s.feeMarketKeeper.GetRequiredFee(blockCtx, 1_000_000, "uatom")
+			s.Require().NoError(err)
+			s.Require().True(required.IsLTE(robust), "required fee %s exceeds the robust fee %s at height %d", required, robust, height)
+
+			state, err := s.feeMarketKeeper.GetState(blockCtx)
+			s.Require().NoError(err)
+			state.SetCurrentUtilization(params.MaxBlockUtilization)
+			s.Require().NoError(s.feeMarketKeeper.SetState(blockCtx, state))
+			s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(blockCtx))
+			s.Require().NoError(s.feeMarketKeeper.RecordResolverRates(blockCtx))
+		}
+
+		// The rate was accepted in every block, so the bound was reached.
+		rate, _, err := s.feeMarketKeeper.GetLastResolverRate(ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(resolver.rate, rate)
+	})
+
+	s.Run("rejects a negative number of blocks", func() {
+		gs := types.DefaultGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		_, err := s.feeMarketKeeper.RobustFee(s.ctx, 1000, -1, types.DefaultFeeDenom)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestCommittedGasPrice() {
+	s.Run("committed price is below spot when prices are expected to fall", func() {
+		gs := types.DefaultAIMDGenesisState()
+		gs.State.BaseGasPrice = gs.Params.MinBaseGasPrice.MulInt64(100)
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		ctx := s.ctx.WithBlockHeight(10)
+		committed, err := s.feeMarketKeeper.CommittedGasPrice(ctx, 1_000_000, 20)
+		s.Require().NoError(err)
+		s.Require().Equal(gs.Params.FeeDenom, committed.Denom)
+		s.Require().True(committed.Amount.LT(gs.State.BaseGasPrice))
+		s.Require().True(committed.Amount.GTE(gs.Params.MinBaseGasPrice))
+
+		// A longer commitment averages over lower projected prices.
+		longer, err := s.feeMarketKeeper.CommittedGasPrice(ctx, 1_000_000, 40)
+		s.Require().NoError(err)
+		s.Require().True(longer.Amount.LT(committed.Amount))
+	})
+
+	s.Run("committed price is capped at spot when prices are expected to rise", func() {
+		gs := types.DefaultAIMDGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		// Committing to fill every block pushes the projected price up.
+		ctx := s.ctx.WithBlockHeight(10)
+		committed, err := s.feeMarketKeeper.CommittedGasPrice(ctx, gs.Params.MaxBlockUtilization*5, 5)
+		s.Require().NoError(err)
+		s.Require().True(committed.Amount.Equal(gs.State.BaseGasPrice))
+	})
+
+	s.Run("rejects an empty commitment", func() {
+		_, err := s.feeMarketKeeper.CommittedGasPrice(s.ctx, 1000, 0)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestEquilibriumUtilization() {
+	// run applies the fee market update for the given number of blocks at a constant
+	// utilization and returns the resulting base gas price.
+	run := func(params types.Params, utilization uint64, blocks int) math.LegacyDec {
+		state := types.NewState(params.Window, params.MinBaseGasPrice.MulInt64(10), params.MinLearningRate)
+		for i := range state.Window {
+			state.Window[i] = utilization
+		}
+		state.ReconcileWindowSum()
+		s.setGenesisState(params, state)
+
+		for i := 0; i < blocks; i++ {
+			ctx := s.ctx.WithBlockHeight(int64(i + 1))
+			state, err := s.feeMarketKeeper.GetState(ctx)
+			s.Require().NoError(err)
+			state.SetCurrentUtilization(utilization)
+			s.Require().NoError(s.feeMarketKeeper.SetState(ctx, state))
+			s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+		}
+
+		price, err := s.feeMarketKeeper.GetBaseGasPrice(s.ctx)
+		s.Require().NoError(err)
+		return price
+	}
+
+	s.Run("equilibrium is the target for default aimd params", func() {
+		params := types.DefaultAIMDParams()
+		params.Delta = math.LegacyMustNewDecFromStr("0.0001")
+		s.setGenesisState(params, types.DefaultAIMDState())
+
+		equilibrium, err := s.feeMarketKeeper.EquilibriumUtilization(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.5"), equilibrium)
+
+		// The price holds steady at the equilibrium despite the learning rate changing,
+		// and moves away from it on either side.
+		start := params.MinBaseGasPrice.MulInt64(10)
+		target := params.TargetBlockUtilization()
+		s.Require().Equal(start, run(params, target, 20))
+		s.Require().True(run(params, target+target/10, 20).GT(start))
+		s.Require().True(run(params, target-target/10, 20).LT(start))
+	})
+
+	s.Run("odd max block utilization diverges slightly from one half", func() {
+		params := types.DefaultAIMDParams()
+		params.MaxBlockUtilization = 3
+		s.setGenesisState(params, types.DefaultAIMDState())
+
+		equilibrium, err := s.feeMarketKeeper.EquilibriumUtilization(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyOneDec().QuoInt64(3), equilibrium)
+		s.Require().Equal(params.MinBaseGasPrice.MulInt64(10), run(params, 1, 20))
+	})
+}
+
+func (s *KeeperTestSuite) TestResponseTime() {
+	setup := func(params types.Params, learningRate math.LegacyDec) {
+		state := types.NewState(params.Window, params.MinBaseGasPrice, learningRate)
+		s.feeMarketKeeper.InitGenesis(s.ctx, *types.NewGenesisState(params, state))
+	}
+
+	s.Run("the default eip-1559 params respond in 8 blocks", func() {
+		// A constant learning rate of 0.125: 0.875^7 > 1/e >= 0.875^8.
+		params := types.DefaultParams()
+		setup(params, params.MinLearningRate)
+
+		blocks, err := s.feeMarketKeeper.ResponseTime(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(8), blocks)
+	})
+
+	s.Run("the default aimd params respond in 8 blocks", func() {
+		// The learning rate grows from 0.01 by 0.025 per block: after 8 blocks the gap is
+		// 0.965 * 0.94 * 0.915 * 0.89 * 0.865 * 0.84 * 0.815 * 0.79 ~= 0.346 < 1/e, while
+		// after 7 it is ~0.437.
+		params := types.DefaultAIMDParams()
+		setup(params, params.MinLearningRate)
+
+		blocks, err := s.feeMarketKeeper.ResponseTime(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(8), blocks)
+	})
+
+	s.Run("a constant learning rate responds in about 1 / lr blocks", func() {
+		// 0.99^99 > 1/e >= 0.99^100.
+		params := types.DefaultParams()
+		params.MinLearningRate = math.LegacyMustNewDecFromStr("0.01")
+		params.MaxLearningRate = params.MinLearningRate
+		setup(params, params.MinLearningRate)
+
+		blocks, err := s.feeMarketKeeper.ResponseTime(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(100), blocks)
+	})
+
+	s.Run("a learning rate of one responds in a single block", func() {
+		params := types.DefaultAIMDParams()
+		params.MaxLearningRate = math.LegacyOneDec()
+		setup(params, params.MaxLearningRate)
+
+		blocks, err := s.feeMarketKeeper.ResponseTime(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(1), blocks)
+	})
+}
+
+func (s *KeeperTestSuite) TestExpectedPricePaid() {
+	s.Run("errors without a positive inclusion target", func() {
+		_, _, err := s.feeMarketKeeper.ExpectedPricePaid(s.ctx, 0)
+		s.Require().Error(err)
+	})
+
+	s.Run("errors without enough observations", func() {
+		s.Require().NoError(s.feeMarketKeeper.RecordObservation(s.ctx.WithBlockHeight(1), math.LegacyOneDec(), 0))
+
+		_, _, err := s.feeMarketKeeper.ExpectedPricePaid(s.ctx, 1)
+		s.Require().ErrorIs(err, types.ErrInsufficientData)
+	})
+
+	// The price alternately rises and falls by 20%, so the per-block change has a mean of
+	// zero and a variance of 0.04.
+	prices := []string{"1", "1.2", "0.96", "1.152", "0.9216"}
+	for i, price := range prices {
+		ctx := s.ctx.WithBlockHeight(int64(i + 1))
+		s.Require().NoError(s.feeMarketKeeper.RecordObservation(ctx, math.LegacyMustNewDecFromStr(price), 0))
+	}
+
+	state := types.DefaultState()
+	state.BaseGasPrice = math.LegacyNewDec(2)
+	s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+	requireApprox := func(expected string, actual math.LegacyDec) {
+		diff := math.LegacyMustNewDecFromStr(expected).Sub(actual).Abs()
+		s.Require().True(diff.LT(math.LegacyNewDecWithPrec(1, 12)), "expected %s, got %s", expected, actual)
+	}
+
+	s.Run("the next block charges the current price", func() {
+		mean, stddev, err := s.feeMarketKeeper.ExpectedPricePaid(s.ctx, 1)
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice, mean)
+		s.Require().True(stddev.IsZero())
+	})
+
+	s.Run("the spread grows with the inclusion target", func() {
+		// Second moment 4 * (1 + 1.04) / 2, so the variance is 0.08.
+		mean, stddev, err := s.feeMarketKeeper.ExpectedPricePaid(s.ctx, 2)
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice, mean)
+		requireApprox("0.282842712474619010", stddev)
+
+		// Second moment 4 * (1 + 1.04 + 1.0816) / 3, so the variance is 0.162133...
+		mean, stddev, err = s.feeMarketKeeper.ExpectedPricePaid(s.ctx, 3)
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice, mean)
+		requireApprox("0.402657836547773317", stddev)
+	})
+}
@@ -0,0 +1,38 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// SetFeeExemptModuleAccounts sets the module accounts whose transactions are exempt from
+// fee deduction by the fee market ante and post handlers. Each entry must be the name of a
+// module account known to the account keeper. Any previously configured exemptions are
+// replaced.
+func (k *Keeper) SetFeeExemptModuleAccounts(moduleNames ...string) error {
+	exempt := make(map[string]struct{}, len(moduleNames))
+	for _, name := range moduleNames {
+		addr := k.ak.GetModuleAddress(name)
+		if addr == nil {
+			return types.ErrNotModuleAccount.Wrapf("module %s has no module account", name)
+		}
+
+		exempt[string(addr)] = struct{}{}
+	}
+
+	k.feeExemptModuleAccounts = exempt
+
+	return nil
+}
+
+// IsFeeExempt returns true if the given address is an allowlisted module account whose
+// transactions are exempt from fee deduction.
+func (k *Keeper) IsFeeExempt(ctx sdk.Context, addr sdk.AccAddress) bool {
+	if _, ok := k.feeExemptModuleAccounts[string(addr)]; !ok {
+		return false
+	}
+
+	_, ok := k.ak.GetAccount(ctx, addr).(sdk.ModuleAccountI)
+	return ok
+}
@@ -0,0 +1,29 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestFeeExemptModuleAccounts() {
+	s.Run("rejects unknown module accounts", func() {
+		err := s.feeMarketKeeper.SetFeeExemptModuleAccounts(types.ModuleName, "unknown")
+		s.Require().ErrorIs(err, types.ErrNotModuleAccount)
+	})
+
+	s.Run("accounts that are not allowlisted are not exempt", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetFeeExemptModuleAccounts(types.ModuleName))
+
+		s.Require().False(s.feeMarketKeeper.IsFeeExempt(s.ctx, sdk.AccAddress("regular_____________")))
+		s.Require().False(s.feeMarketKeeper.IsFeeExempt(s.ctx, authtypes.NewModuleAddress(types.FeeCollectorName)))
+	})
+
+	s.Run("allowlisted address must hold a module account", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetFeeExemptModuleAccounts(types.ModuleName))
+
+		// no account has been created at the module address yet
+		s.Require().False(s.feeMarketKeeper.IsFeeExempt(s.ctx, authtypes.NewModuleAddress(types.ModuleName)))
+	})
+}
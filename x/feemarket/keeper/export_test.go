@@ -0,0 +1,100 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// SetRawState writes the given bytes as the fee market state, bypassing encoding.
+func (k *Keeper) SetRawState(ctx sdk.Context, bz []byte) {
+	ctx.KVStore(k.storeKey).Set(types.KeyState, bz)
+}
+
+// SetRawMsgTypeMultiplier writes the multiplier of the given message type URL to the store,
+// bypassing SetMsgTypeMultiplier and thus the message type multiplier cache.
+func (k *Keeper) SetRawMsgTypeMultiplier(ctx sdk.Context, msgTypeURL string, multiplier math.LegacyDec) {
+	bz, err := multiplier.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.MsgTypeMultiplierKey(msgTypeURL), bz)
+}
+
+// UnregisterDenomResolver removes the denom resolver registered under the given name, as on a
+// node that never registered it.
+func (k *Keeper) UnregisterDenomResolver(name string) {
+	delete(k.denomResolvers, name)
+}
+
+// DeleteEnabledHeight removes the enabled height from the store, as in a store written before
+// the enabled height was set.
+func (k *Keeper) DeleteEnabledHeight(ctx sdk.Context) {
+	ctx.KVStore(k.storeKey).Delete(types.KeyEnabledHeight)
+}
+
+// BaseGasPriceHistorySlots returns the number of slots of the base gas price history that are
+// written to the store.
+func (k *Keeper) BaseGasPriceHistorySlots(ctx sdk.Context) int {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixBaseGasPriceHistory)
+	defer iterator.Close()
+
+	slots := 0
+	for ; iterator.Valid(); iterator.Next() {
+		slots++
+	}
+
+	return slots
+}
+
+// CompactBaseGasPriceHistoryEntries returns the number of entries of the compact base gas
+// price history that are written to the store.
+func (k *Keeper) CompactBaseGasPriceHistoryEntries(ctx sdk.Context) int {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixCompactBaseGasPriceHistory)
+	defer iterator.Close()
+
+	entries := 0
+	for ; iterator.Valid(); iterator.Next() {
+		entries++
+	}
+
+	return entries
+}
+
+// BaseGasPriceHistoryBytes returns the number of key and value bytes that both the naive
+// and the compact base gas price history write to the store.
+func (k *Keeper) BaseGasPriceHistoryBytes(ctx sdk.Context) int {
+	store := ctx.KVStore(k.storeKey)
+
+	size := 0
+	for _, prefix := range [][]byte{types.KeyPrefixBaseGasPriceHistory, types.KeyPrefixCompactBaseGasPriceHistory} {
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			size += len(iterator.Key()) + len(iterator.Value())
+		}
+		iterator.Close()
+	}
+
+	if bz := store.Get(types.KeyCompactBaseGasPriceHistoryTip); bz != nil {
+		size += len(types.KeyCompactBaseGasPriceHistoryTip) + len(bz)
+	}
+
+	return size
+}
+
+// AccountFeeSpendIndexEntries returns the number of entries of the account fee spend height
+// index that are written to the store.
+func (k *Keeper) AccountFeeSpendIndexEntries(ctx sdk.Context) int {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixAccountFeeSpendByHeight)
+	defer iterator.Close()
+
+	entries := 0
+	for ; iterator.Valid(); iterator.Next() {
+		entries++
+	}
+
+	return entries
+}
@@ -1,16 +1,25 @@
 package keeper
 
 import (
+	"fmt"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/attribute"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
 // UpdateFeeMarket updates the base fee and learning rate based on the
-// AIMD learning rate adjustment algorithm. Note that if the fee market
+// AIMD learning rate adjustment algorithm, or the EMA of the window
+// utilization, as selected by the mode param. Note that if the fee market
 // is disabled, this function will return without updating the fee market.
 // This is executed in EndBlock which allows the next block's base fee to
 // be readily available for wallets to estimate gas prices.
 func (k *Keeper) UpdateFeeMarket(ctx sdk.Context) error {
+	span := types.StartSpan(ctx, k.tracer, types.SpanUpdateFeeMarket)
+	defer span.End()
+
 	params, err := k.GetParams(ctx)
 	if err != nil {
 		return err
@@ -25,32 +34,129 @@ func (k *Keeper) UpdateFeeMarket(ctx sdk.Context) error {
 		return nil
 	}
 
+	// Drop the fee revenue, community pool contributions and account fee spend
+	// recorded for blocks that have fallen out of their windows.
+	k.PruneRevenue(ctx, params.Window)
+	k.PruneCommunityPoolContributions(ctx, params.Window)
+	k.PruneAccountFeeSpend(ctx, params.AccountFeeSpendWindow)
+
 	state, err := k.GetState(ctx)
 	if err != nil {
 		return err
 	}
+	prev := state
+
+	// The running window sum is updated incrementally, so recompute it once every
+	// pass over the window to guard against drift.
+	if state.Index == 0 && state.ReconcileWindowSum() {
+		k.Logger(ctx).Error(
+			"fee market running window sum drifted from the window and was recomputed",
+			"height", ctx.BlockHeight(),
+		)
+	}
+
+	// The window holds no data for the first block after the fee market is enabled, so
+	// hold the price at the floor and only record the block's utilization.
+	firstBlock, err := k.IsFirstBlock(ctx)
+	if err != nil {
+		return err
+	}
+	if firstBlock {
+		k.Logger(ctx).Info(
+			"skipped the fee market update for the first block after enablement",
+			"height", ctx.BlockHeight(),
+		)
+
+		state.BaseGasPrice = params.MinBaseGasPrice
+		state.IncrementHeight()
+		return k.UpdateState(ctx, prev, state)
+	}
+
+	// Record the price charged during this block alongside its utilization before
+	// the price is updated for the next block. Observations recorded before analytics
+	// were disabled are still pruned.
+	k.PruneObservations(ctx, params.Window)
+	if params.RecordAnalytics {
+		if err := k.RecordObservation(ctx, state.BaseGasPrice, state.Window[state.Index]); err != nil {
+			return err
+		}
+	}
+
+	previousBaseGasPrice := state.BaseGasPrice
 
 	// Update the learning rate based on the block utilization seen in the
-	// current block. This is the AIMD learning rate adjustment algorithm.
+	// current block. This is the AIMD learning rate adjustment algorithm, and
+	// holds the learning rate steady in EMA mode.
 	newLR := state.UpdateLearningRate(
 		params,
 	)
 
+	// Dampen the learning rate adjustment while the fee market is warming up.
+	warmupFactor, err := k.GetWarmupFactor(ctx, params)
+	if err != nil {
+		return err
+	}
+
 	// Update the base gas price based with the new learning rate and delta adjustment.
-	newBaseGasPrice := state.UpdateBaseGasPrice(params)
+	newBaseGasPrice := state.UpdateBaseGasPriceScaled(params, warmupFactor)
 
 	k.Logger(ctx).Info(
 		"updated the fee market",
 		"height", ctx.BlockHeight(),
 		"new_base_gas_price", newBaseGasPrice,
 		"new_learning_rate", newLR,
+		"warmup_factor", warmupFactor,
 		"average_block_utilization", state.GetAverageUtilization(params),
 		"net_block_utilization", state.GetNetUtilization(params),
 	)
 
+	if k.structuredPriceLog {
+		line, err := types.NewPriceUpdateLog(ctx.BlockHeight(), params, previousBaseGasPrice, state).JSON()
+		if err != nil {
+			return err
+		}
+
+		k.Logger(ctx).Info(line)
+	}
+
+	if span.IsRecording() {
+		span.SetAttributes(
+			attribute.Int64(types.SpanAttributeKeyHeight, ctx.BlockHeight()),
+			attribute.String(types.SpanAttributeKeyBaseGasPrice, newBaseGasPrice.String()),
+			attribute.String(types.SpanAttributeKeyLearningRate, newLR.String()),
+			attribute.String(types.SpanAttributeKeyAverageUtilization, state.GetAverageUtilization(params).String()),
+		)
+	}
+
 	// Increment the height of the state and set the new state.
 	state.IncrementHeight()
-	return k.SetState(ctx, state)
+	return k.UpdateState(ctx, prev, state)
+}
+
+// GetWarmupFactor returns the factor by which the learning rate adjustment is scaled
+// at the current height. The number of blocks since the fee market was enabled is
+// derived from the enabled height. If the enabled height was never set, the fee market
+// is considered to have been enabled since genesis.
+func (k *Keeper) GetWarmupFactor(ctx sdk.Context, params types.Params) (math.LegacyDec, error) {
+	if params.WarmupBlocks == 0 {
+		return math.LegacyOneDec(), nil
+	}
+
+	enabledHeight, err := k.GetEnabledHeight(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if enabledHeight < 0 {
+		enabledHeight = 0
+	}
+
+	var blocksSinceEnabled uint64
+	if ctx.BlockHeight() > enabledHeight {
+		blocksSinceEnabled = uint64(ctx.BlockHeight() - enabledHeight)
+	}
+
+	return params.WarmupFactor(blocksSinceEnabled), nil
 }
 
 // GetBaseGasPrice returns the base fee from the fee market state.
@@ -63,6 +169,27 @@ func (k *Keeper) GetBaseGasPrice(ctx sdk.Context) (math.LegacyDec, error) {
 	return state.BaseGasPrice, nil
 }
 
+// CongestionMultiplier returns the current base gas price as a multiple of the minimum
+// base gas price, i.e. BaseGasPrice / MinBaseGasPrice. A value of 1 means the market is
+// at its floor. An error is returned if the minimum base gas price is zero.
+func (k *Keeper) CongestionMultiplier(ctx sdk.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if params.MinBaseGasPrice.IsNil() || params.MinBaseGasPrice.IsZero() {
+		return math.LegacyDec{}, types.ErrZeroMinBaseGasPrice
+	}
+
+	metrics, err := k.DerivedMetrics(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return metrics.CongestionMultiplier, nil
+}
+
 // GetLearningRate returns the learning rate from the fee market state.
 func (k *Keeper) GetLearningRate(ctx sdk.Context) (math.LegacyDec, error) {
 	state, err := k.GetState(ctx)
@@ -73,7 +200,34 @@ func (k *Keeper) GetLearningRate(ctx sdk.Context) (math.LegacyDec, error) {
 	return state.LearningRate, nil
 }
 
+// GetUtilization returns how full the blocks of the window have been: the gas used over the
+// window divided by the window's capacity, i.e. the max block utilization, twice the target,
+// per block. It also returns the gas used over the window and the window length. A window
+// without any gas used, or without any capacity, has a utilization of exactly zero.
+func (k *Keeper) GetUtilization(ctx sdk.Context) (utilization math.LegacyDec, gas, window uint64, err error) {
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return math.LegacyDec{}, 0, 0, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, 0, 0, err
+	}
+
+	gas, window = state.WindowSum, uint64(len(state.Window))
+	if gas == 0 || window == 0 || params.MaxBlockUtilization == 0 {
+		return math.LegacyZeroDec(), gas, window, nil
+	}
+
+	return state.GetAverageUtilization(params), gas, window, nil
+}
+
 // GetMinGasPrice returns the mininum gas prices for given denom as sdk.DecCoins from the fee market state.
+// The price is max(BaseGasPrice, MinBaseGasPrice), so a state written below the floor never
+// prices gas below it, resolved into the requested denom. If the resolver's rate moved more
+// than MaxRateChangePerBlock since the last block, the last good rate is used instead. The
+// result is raised to the denom's minimum set with SetDenomMinGasPrice, if any.
 func (k *Keeper) GetMinGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, error) {
 	baseGasPrice, err := k.GetBaseGasPrice(ctx)
 	if err != nil {
@@ -85,21 +239,89 @@ func (k *Keeper) GetMinGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, err
 		return sdk.DecCoin{}, err
 	}
 
-	var gasPrice sdk.DecCoin
+	return k.minGasPrice(ctx, params, baseGasPrice, denom)
+}
+
+// GetMarketInfo returns the params, the state and the min gas price in the fee denom (see
+// GetMinGasPrice), reading the params and state from the store only once.
+func (k *Keeper) GetMarketInfo(ctx sdk.Context) (types.Params, types.State, sdk.DecCoin, error) {
+	params, state, err := k.readMarket(ctx, true)
+	if err != nil {
+		return types.Params{}, types.State{}, sdk.DecCoin{}, err
+	}
+
+	minGasPrice, err := k.minGasPrice(ctx, params, state.BaseGasPrice, params.FeeDenom)
+	if err != nil {
+		return types.Params{}, types.State{}, sdk.DecCoin{}, err
+	}
+
+	return params, state, minGasPrice, nil
+}
+
+// readMarket reads the params and, if withState is set, the state from the store. It is the
+// read path shared by the Params, State and MarketInfo queries, so that they always agree.
+func (k *Keeper) readMarket(ctx sdk.Context, withState bool) (types.Params, types.State, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.Params{}, types.State{}, err
+	}
+
+	if !withState {
+		return params, types.State{}, nil
+	}
+
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return types.Params{}, types.State{}, err
+	}
+
+	return params, state, nil
+}
+
+// minGasPrice returns the min gas price in the given denom for the given params and base gas
+// price. See GetMinGasPrice.
+func (k *Keeper) minGasPrice(ctx sdk.Context, params types.Params, baseGasPrice math.LegacyDec, denom string) (sdk.DecCoin, error) {
+	if params.MinBaseGasPrice.GT(baseGasPrice) {
+		baseGasPrice = params.MinBaseGasPrice
+	}
+
+	var (
+		gasPrice sdk.DecCoin
+		err      error
+	)
 
 	if params.FeeDenom == denom {
 		gasPrice = sdk.NewDecCoinFromDec(params.FeeDenom, baseGasPrice)
 	} else {
-		gasPrice, err = k.ResolveToDenom(ctx, sdk.NewDecCoinFromDec(params.FeeDenom, baseGasPrice), denom)
+		gasPrice, err = k.ResolveToDenomCached(ctx, sdk.NewDecCoinFromDec(params.FeeDenom, baseGasPrice), denom)
+		if err != nil {
+			return sdk.DecCoin{}, err
+		}
+
+		gasPrice, err = k.guardGasPrice(ctx, params, baseGasPrice, gasPrice)
 		if err != nil {
 			return sdk.DecCoin{}, err
 		}
 	}
 
-	return gasPrice, nil
+	return k.applyDenomMinGasPrice(ctx, gasPrice)
 }
 
-// GetMinGasPrices returns the mininum gas prices as sdk.DecCoins from the fee market state.
+// GetRequiredFee returns the minimum fee, in the given denom, owed for the given gas limit at
+// the current min gas price (see GetMinGasPrice). The fee is rounded up to a whole coin, as
+// the ante handler does, so the returned amount is always sufficient to pay for the gas.
+func (k *Keeper) GetRequiredFee(ctx sdk.Context, gasLimit uint64, denom string) (sdk.Coin, error) {
+	gasPrice, err := k.GetMinGasPrice(ctx, denom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return computeFee(gasPrice, gasLimit), nil
+}
+
+// GetMinGasPrices returns the mininum gas prices as sdk.DecCoins from the fee market state,
+// priced at max(BaseGasPrice, MinBaseGasPrice) and raised to the per-denom minimums like
+// GetMinGasPrice.
 func (k *Keeper) GetMinGasPrices(ctx sdk.Context) (sdk.DecCoins, error) {
 	baseGasPrice, err := k.GetBaseGasPrice(ctx)
 	if err != nil {
@@ -111,10 +333,28 @@ func (k *Keeper) GetMinGasPrices(ctx sdk.Context) (sdk.DecCoins, error) {
 		return sdk.NewDecCoins(), err
 	}
 
+	if params.MinBaseGasPrice.GT(baseGasPrice) {
+		baseGasPrice = params.MinBaseGasPrice
+	}
+
 	minGasPrice := sdk.NewDecCoinFromDec(params.FeeDenom, baseGasPrice)
-	minGasPrices := sdk.NewDecCoins(minGasPrice)
 
-	extraDenoms, err := k.resolver.ExtraDenoms(ctx)
+	feeDenomGasPrice, err := k.applyDenomMinGasPrice(ctx, minGasPrice)
+	if err != nil {
+		return sdk.NewDecCoins(), err
+	}
+	minGasPrices := sdk.NewDecCoins(feeDenomGasPrice)
+
+	resolver, err := k.denomResolver(ctx)
+	if err != nil {
+		return sdk.NewDecCoins(), err
+	}
+
+	if resolver == nil {
+		return minGasPrices, nil
+	}
+
+	extraDenoms, err := resolver.ExtraDenoms(ctx)
 	if err != nil {
 		return sdk.NewDecCoins(), err
 	}
@@ -129,8 +369,132 @@ func (k *Keeper) GetMinGasPrices(ctx sdk.Context) (sdk.DecCoins, error) {
 			)
 			continue
 		}
+
+		gasPrice, err = k.guardGasPrice(ctx, params, baseGasPrice, gasPrice)
+		if err != nil {
+			return sdk.NewDecCoins(), err
+		}
+
+		gasPrice, err = k.applyDenomMinGasPrice(ctx, gasPrice)
+		if err != nil {
+			return sdk.NewDecCoins(), err
+		}
+		minGasPrices = minGasPrices.Add(gasPrice)
+	}
+
+	return minGasPrices, nil
+}
+
+// GetMinGasPricesInDenoms returns the minimum gas price in each of the given denoms. Unlike
+// GetMinGasPrices, a denom that cannot be converted by the denom resolver is an error rather
+// than being skipped, since the caller asked for it explicitly. Duplicate denoms are ignored.
+func (k *Keeper) GetMinGasPricesInDenoms(ctx sdk.Context, denoms []string) (sdk.DecCoins, error) {
+	minGasPrices := sdk.NewDecCoins()
+	seen := make(map[string]struct{}, len(denoms))
+
+	for _, denom := range denoms {
+		if _, ok := seen[denom]; ok {
+			continue
+		}
+		seen[denom] = struct{}{}
+
+		gasPrice, err := k.GetMinGasPrice(ctx, denom)
+		if err != nil {
+			return sdk.NewDecCoins(), fmt.Errorf("failed to get gas price in %s: %w", denom, err)
+		}
 		minGasPrices = minGasPrices.Add(gasPrice)
 	}
 
 	return minGasPrices, nil
 }
+
+// GetMinGasPriceConfig returns the current minimum gas price in the given denom formatted
+// as a cosmos-sdk gas-price string (e.g. 0.025uatom), suitable for a node's
+// minimum-gas-prices configuration. If denom is empty, the fee denom is used. If a denom
+// metadata keeper is configured, the base denom from the denom's metadata is used.
+func (k *Keeper) GetMinGasPriceConfig(ctx sdk.Context, denom string) (string, error) {
+	if denom == "" {
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return "", err
+		}
+
+		denom = params.FeeDenom
+	}
+
+	gasPrice, err := k.GetMinGasPrice(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+
+	if k.metadata != nil {
+		if metadata, found := k.metadata.GetDenomMetaData(ctx, denom); found && metadata.Base != "" {
+			gasPrice.Denom = metadata.Base
+		}
+	}
+
+	return types.FormatGasPrice(gasPrice), nil
+}
+
+// GetGasPriceQuote returns the minimum gas price for the given denom rounded up to the
+// denom's precision, along with that precision. See DenomPrecision. The rounding is for
+// display only: fees are always computed from the unrounded price. An empty denom quotes
+// the gas price in the fee denom.
+func (k *Keeper) GetGasPriceQuote(ctx sdk.Context, denom string) (sdk.DecCoin, uint32, error) {
+	if denom == "" {
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return sdk.DecCoin{}, 0, err
+		}
+
+		denom = params.FeeDenom
+	}
+
+	gasPrice, err := k.GetMinGasPrice(ctx, denom)
+	if err != nil {
+		return sdk.DecCoin{}, 0, err
+	}
+
+	precision := k.DenomPrecision(ctx, denom)
+	return types.RoundUpToPrecision(gasPrice, precision), precision, nil
+}
+
+// GetGasPriceRelativeTo returns the minimum gas price, in the denom of referenceValue, as a
+// multiple of referenceValue. This normalizes the per-gas fee against a caller-defined
+// index, e.g. a reference of the average daily transaction value expresses the fee per gas
+// in units of that value. An error is returned if the reference is not positive.
+func (k *Keeper) GetGasPriceRelativeTo(ctx sdk.Context, referenceValue sdk.DecCoin) (math.LegacyDec, error) {
+	if referenceValue.Amount.IsNil() || !referenceValue.Amount.IsPositive() {
+		return math.LegacyDec{}, fmt.Errorf("reference value must be positive; got %s", referenceValue)
+	}
+
+	gasPrice, err := k.GetMinGasPrice(ctx, referenceValue.Denom)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return gasPrice.Amount.Quo(referenceValue.Amount), nil
+}
+
+// DenomPrecision returns the number of decimal places of the given denom, i.e. the exponent
+// of its display unit in the denom's metadata (e.g. 6 for uatom and 18 for aevmos). If no
+// metadata keeper is configured, the denom has no registered metadata, or the display unit
+// is not found, types.DefaultDenomPrecision is returned.
+func (k *Keeper) DenomPrecision(ctx sdk.Context, denom string) uint32 {
+	if k.metadata == nil {
+		return types.DefaultDenomPrecision
+	}
+
+	metadata, found := k.metadata.GetDenomMetaData(ctx, denom)
+	if !found {
+		return types.DefaultDenomPrecision
+	}
+
+	for _, unit := range metadata.DenomUnits {
+		if unit.Denom == metadata.Display {
+			return min(unit.Exponent, types.DefaultDenomPrecision)
+		}
+	}
+
+	return types.DefaultDenomPrecision
+}
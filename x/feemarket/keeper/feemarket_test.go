@@ -1,9 +1,13 @@
 package keeper_test
 
 import (
+	"testing"
+
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	testkeeper "github.com/skip-mev/feemarket/testutils/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
@@ -53,7 +57,7 @@ func (s *KeeperTestSuite) TestUpdateFeeMarket() {
 		// change MinBaseGasPrice value < 1
 		params := types.DefaultParams()
 		params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.5")
-		s.setGenesisState(params, state)
+		s.setStateBelowFloor(params, state)
 
 		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
 
@@ -76,7 +80,7 @@ func (s *KeeperTestSuite) TestUpdateFeeMarket() {
 		state.BaseGasPrice = types.DefaultMinBaseGasPrice.Sub(change)
 
 		params := types.DefaultParams()
-		s.setGenesisState(params, state)
+		s.setStateBelowFloor(params, state)
 
 		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
 
@@ -339,7 +343,7 @@ func (s *KeeperTestSuite) TestUpdateFeeMarket() {
 		state.BaseGasPrice = types.DefaultMinBaseGasPrice.Add(math.LegacyNewDecFromInt(increase)).Sub(math.LegacyNewDec(1))
 		state.LearningRate = lr
 
-		s.setGenesisState(params, state)
+		s.setStateBelowFloor(params, state)
 
 		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
 
@@ -409,6 +413,178 @@ func (s *KeeperTestSuite) TestUpdateFeeMarket() {
 	})
 }
 
+func (s *KeeperTestSuite) TestUpdateFeeMarketWarmup() {
+	// fullBlockIncrease returns the base gas price increase after a single full block
+	// at the given number of blocks since the fee market was enabled.
+	fullBlockIncrease := func(blocksSinceEnabled int64) math.LegacyDec {
+		params := types.DefaultAIMDParams()
+		params.WarmupBlocks = 10
+		state := types.DefaultAIMDState()
+		s.Require().NoError(state.Update(params.MaxBlockUtilization, params))
+		s.setGenesisState(params, state)
+
+		s.feeMarketKeeper.SetEnabledHeight(s.ctx, s.ctx.BlockHeight()-blocksSinceEnabled)
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		fee, err := s.feeMarketKeeper.GetBaseGasPrice(s.ctx)
+		s.Require().NoError(err)
+		return fee.Sub(state.BaseGasPrice)
+	}
+
+	s.Run("price moves less during warmup than after", func() {
+		early := fullBlockIncrease(0)
+		late := fullBlockIncrease(20)
+
+		s.Require().True(early.IsPositive())
+		s.Require().True(early.LT(late))
+	})
+
+	s.Run("price movement ramps up during warmup", func() {
+		first := fullBlockIncrease(0)
+		middle := fullBlockIncrease(5)
+		last := fullBlockIncrease(9)
+
+		s.Require().True(first.LT(middle))
+		s.Require().True(middle.LT(last))
+		s.Require().Equal(last, fullBlockIncrease(20))
+	})
+
+	s.Run("no warmup by default", func() {
+		params := types.DefaultAIMDParams()
+		s.setGenesisState(params, types.DefaultAIMDState())
+		s.feeMarketKeeper.SetEnabledHeight(s.ctx, s.ctx.BlockHeight())
+
+		factor, err := s.feeMarketKeeper.GetWarmupFactor(s.ctx, params)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyOneDec(), factor)
+	})
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeMarketEMA() {
+	params := types.DefaultAIMDParams()
+	params.Mode = types.Mode_MODE_EMA
+	params.Alpha = math.LegacyMustNewDecFromStr("0.5")
+	params.Delta = math.LegacyZeroDec()
+
+	state := types.DefaultAIMDState()
+	state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(10)
+	state.LearningRate = math.LegacyMustNewDecFromStr("0.1")
+	for i := range state.Window {
+		state.Window[i] = params.TargetBlockUtilization()
+	}
+	state.Window[state.Index] = params.MaxBlockUtilization
+	state.ReconcileWindowSum()
+	s.setGenesisState(params, state)
+
+	s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+	updated, err := s.feeMarketKeeper.GetState(s.ctx)
+	s.Require().NoError(err)
+
+	// The learning rate is held, and the price moves by the smoothed utilization, which is
+	// halfway between the target and a full block.
+	s.Require().True(state.LearningRate.Equal(updated.LearningRate))
+	expected := state.BaseGasPrice.Mul(math.LegacyOneDec().Add(state.LearningRate.QuoInt64(2)))
+	s.Require().True(expected.Equal(updated.BaseGasPrice), updated.BaseGasPrice.String())
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeMarketDisabled() {
+	params := types.DefaultAIMDParams()
+	state := types.DefaultAIMDState()
+	state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(3)
+	s.setGenesisState(params, state)
+
+	s.Run("disabling freezes the price and records the frozen height", func() {
+		frozen := params
+		frozen.Enabled = false
+		frozen.MinLearningRate = params.MaxLearningRate.Add(math.LegacyOneDec())
+
+		_, err := s.msgServer.Params(s.ctx, &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    frozen,
+		})
+		s.Require().NoError(err)
+
+		got, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice, got.BaseGasPrice)
+		s.Require().Equal(state.LearningRate, got.LearningRate)
+
+		height, err := s.feeMarketKeeper.GetFrozenHeight(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(s.ctx.BlockHeight(), height)
+
+		// the fee market was enabled since genesis, which disabling it does not change
+		enabled, _, err := s.feeMarketKeeper.IsEnabled(s.ctx)
+		s.Require().NoError(err)
+		s.Require().False(enabled)
+
+		exported := s.feeMarketKeeper.ExportGenesis(s.ctx)
+		s.Require().Equal(int64(-1), exported.EnabledHeight)
+		s.Require().Equal(s.ctx.BlockHeight(), exported.FrozenHeight)
+
+		// the learning rate bounds out of order only hold while enabled
+		msg, broken := keeper.BaseGasPriceInvariant(s.feeMarketKeeper)(s.ctx)
+		s.Require().False(broken, msg)
+	})
+
+	s.Run("end block leaves the state of a disabled fee market unchanged", func() {
+		before, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		before.Window[before.Index] = params.MaxBlockUtilization
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, before))
+
+		s.Require().NoError(s.feeMarketKeeper.EndBlock(s.ctx))
+
+		after, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(before, after)
+
+		msg, broken := keeper.BaseGasPriceInvariant(s.feeMarketKeeper)(s.ctx)
+		s.Require().False(broken, msg)
+	})
+
+	s.Run("re-enabling records the height", func() {
+		ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 5)
+		_, err := s.msgServer.Params(ctx, &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		})
+		s.Require().NoError(err)
+
+		height, err := s.feeMarketKeeper.GetEnabledHeight(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(ctx.BlockHeight(), height)
+
+		enabled, enabledHeight, err := s.feeMarketKeeper.IsEnabled(ctx)
+		s.Require().NoError(err)
+		s.Require().True(enabled)
+		s.Require().Equal(ctx.BlockHeight(), enabledHeight)
+	})
+}
+
+func (s *KeeperTestSuite) TestUpdateFeeMarketWindowSumDrift() {
+	s.Run("drifted running sum is recomputed once per window pass", func() {
+		params := types.DefaultAIMDParams()
+		s.setGenesisState(params, types.DefaultAIMDState())
+
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		state.Window[0] = params.MaxBlockUtilization
+		state.WindowSum = 0
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		state, err = s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state.SumWindow(), state.WindowSum)
+
+		// The full block was used to update the price despite the drifted sum.
+		s.Require().True(state.BaseGasPrice.GT(params.MinBaseGasPrice))
+	})
+}
+
 func (s *KeeperTestSuite) TestGetBaseFee() {
 	s.Run("can retrieve base fee with default eip-1559", func() {
 		gs := types.DefaultGenesisState()
@@ -429,6 +605,36 @@ func (s *KeeperTestSuite) TestGetBaseFee() {
 	})
 }
 
+func (s *KeeperTestSuite) TestCongestionMultiplier() {
+	s.Run("multiplier is one at the floor", func() {
+		gs := types.DefaultAIMDGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		multiplier, err := s.feeMarketKeeper.CongestionMultiplier(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyOneDec(), multiplier)
+	})
+
+	s.Run("multiplier reflects a price above the floor", func() {
+		gs := types.DefaultAIMDGenesisState()
+		gs.State.BaseGasPrice = gs.Params.MinBaseGasPrice.Mul(math.LegacyMustNewDecFromStr("3.2"))
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		multiplier, err := s.feeMarketKeeper.CongestionMultiplier(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("3.2"), multiplier)
+	})
+
+	s.Run("zero floor returns an error", func() {
+		gs := types.DefaultAIMDGenesisState()
+		gs.Params.MinBaseGasPrice = math.LegacyZeroDec()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		_, err := s.feeMarketKeeper.CongestionMultiplier(s.ctx)
+		s.Require().ErrorIs(err, types.ErrZeroMinBaseGasPrice)
+	})
+}
+
 func (s *KeeperTestSuite) TestGetLearningRate() {
 	s.Run("can retrieve learning rate with default eip-1559", func() {
 		gs := types.DefaultGenesisState()
@@ -473,9 +679,122 @@ func (s *KeeperTestSuite) TestGetMinGasPrices() {
 	})
 }
 
+func (s *KeeperTestSuite) TestGetRequiredFee() {
+	gs := types.DefaultGenesisState()
+	gs.Params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.01")
+	gs.State.BaseGasPrice = math.LegacyMustNewDecFromStr("0.025")
+	s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+	s.Run("multiplies the min gas price by the gas limit", func() {
+		fee, err := s.feeMarketKeeper.GetRequiredFee(s.ctx, 100_000, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(types.DefaultFeeDenom, 2_500), fee)
+	})
+
+	s.Run("rounds up to a whole coin", func() {
+		// 0.025 * 101 = 2.525
+		fee, err := s.feeMarketKeeper.GetRequiredFee(s.ctx, 101, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(types.DefaultFeeDenom, 3), fee)
+
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().True(math.LegacyNewDecFromInt(fee.Amount).GTE(gasPrice.Amount.MulInt64(101)))
+	})
+
+	s.Run("resolves the fee into the requested denom", func() {
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+		s.feeMarketKeeper.SetDenomResolver(&countingDenomResolver{rate: math.LegacyNewDec(4)})
+
+		fee, err := s.feeMarketKeeper.GetRequiredFee(s.ctx, 1_000, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin("uatom", 100), fee)
+	})
+
+	s.Run("a base gas price below the floor is priced at the floor", func() {
+		state := gs.State
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("0.001")
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec(types.DefaultFeeDenom, gs.Params.MinBaseGasPrice), gasPrice)
+
+		fee, err := s.feeMarketKeeper.GetRequiredFee(s.ctx, 1_000, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(types.DefaultFeeDenom, 10), fee)
+	})
+
+	s.Run("a failed conversion is returned", func() {
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+		s.feeMarketKeeper.SetDenomResolver(&types.ErrorDenomResolver{})
+
+		_, err := s.feeMarketKeeper.GetRequiredFee(s.ctx, 1_000, "uatom")
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestGetGasPriceRelativeTo() {
+	gs := types.DefaultGenesisState()
+	gs.Params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.025")
+	gs.State.BaseGasPrice = math.LegacyMustNewDecFromStr("0.025")
+	s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+	s.Run("divides the gas price by the reference", func() {
+		// An average transaction value of 50,000 denom prices each unit of gas at
+		// 0.025 / 50,000 = 0.0000005 of a transaction.
+		reference := sdk.NewDecCoinFromDec(gs.Params.FeeDenom, math.LegacyNewDec(50_000))
+
+		ratio, err := s.feeMarketKeeper.GetGasPriceRelativeTo(s.ctx, reference)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.0000005"), ratio)
+	})
+
+	s.Run("a zero reference returns an error", func() {
+		reference := sdk.NewDecCoinFromDec(gs.Params.FeeDenom, math.LegacyZeroDec())
+
+		_, err := s.feeMarketKeeper.GetGasPriceRelativeTo(s.ctx, reference)
+		s.Require().Error(err)
+	})
+
+	s.Run("a negative reference returns an error", func() {
+		reference := sdk.DecCoin{Denom: gs.Params.FeeDenom, Amount: math.LegacyNewDec(-1)}
+
+		_, err := s.feeMarketKeeper.GetGasPriceRelativeTo(s.ctx, reference)
+		s.Require().Error(err)
+	})
+}
+
+// setStateBelowFloor writes params and a state whose base gas price is below the min base
+// gas price, which genesis rejects.
+func (s *KeeperTestSuite) setStateBelowFloor(params types.Params, state types.State) {
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+	s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+}
+
 func (s *KeeperTestSuite) setGenesisState(params types.Params, state types.State) {
 	gs := types.NewGenesisState(params, state)
 	s.NotPanics(func() {
 		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
 	})
 }
+
+func BenchmarkUpdateFeeMarket(b *testing.B) {
+	ctx, tk, _ := testkeeper.NewTestSetup(b)
+	k := tk.FeeMarketKeeper
+
+	params := types.DefaultAIMDParams()
+	params.Window = 100_000
+	state := types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
+	for i := range state.Window {
+		state.Window[i] = params.TargetBlockUtilization()
+	}
+	k.InitGenesis(ctx, *types.NewGenesisState(params, state))
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := k.UpdateFeeMarket(ctx); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
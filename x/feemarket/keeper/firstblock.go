@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsFirstBlock returns true if first block pricing is enabled with SetFirstBlockAtFloor and
+// the current block is the block in which the fee market was enabled. The window holds no
+// data for that block until it ends, and only covers the transactions executed after the
+// fee market was enabled, so its utilization is undefined. A fee market that has been
+// enabled since genesis has no first block.
+func (k *Keeper) IsFirstBlock(ctx sdk.Context) (bool, error) {
+	if !k.firstBlockAtFloor {
+		return false, nil
+	}
+
+	enabled, enabledHeight, err := k.IsEnabled(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	return enabled && ctx.BlockHeight() == enabledHeight, nil
+}
+
+// GetFloorGasPrice returns the minimum base gas price for the given denom, raised to the min gas
+// price of the denom if one is set. This is the price charged in the first block after the fee
+// market is enabled.
+func (k *Keeper) GetFloorGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	floor := sdk.NewDecCoinFromDec(params.FeeDenom, params.MinBaseGasPrice)
+	if params.FeeDenom != denom {
+		floor, err = k.ResolveToDenom(ctx, floor, denom)
+		if err != nil {
+			return sdk.DecCoin{}, err
+		}
+	}
+
+	return k.applyDenomMinGasPrice(ctx, floor)
+}
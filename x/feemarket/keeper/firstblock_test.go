@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestFirstBlock() {
+	params := types.DefaultAIMDParams()
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	setup := func(ctx sdk.Context) types.State {
+		s.feeMarketKeeper.SetEnabledHeight(ctx, ctx.BlockHeight())
+
+		state := types.NewState(params.Window, params.MinBaseGasPrice.MulInt64(10), params.MinLearningRate)
+		state.SetCurrentUtilization(params.MaxBlockUtilization)
+		s.Require().NoError(s.feeMarketKeeper.SetState(ctx, state))
+		return state
+	}
+
+	s.Run("disabled by default", func() {
+		ctx := s.ctx.WithBlockHeight(10)
+		setup(ctx)
+		defer s.feeMarketKeeper.SetEnabledHeight(s.ctx, -1)
+
+		firstBlock, err := s.feeMarketKeeper.IsFirstBlock(ctx)
+		s.Require().NoError(err)
+		s.Require().False(firstBlock)
+	})
+
+	s.Run("first block is priced at the floor and skips the update", func() {
+		s.feeMarketKeeper.SetFirstBlockAtFloor(true)
+		defer s.feeMarketKeeper.SetFirstBlockAtFloor(false)
+		defer s.feeMarketKeeper.SetEnabledHeight(s.ctx, -1)
+
+		ctx := s.ctx.WithBlockHeight(10)
+		before := setup(ctx)
+
+		firstBlock, err := s.feeMarketKeeper.IsFirstBlock(ctx)
+		s.Require().NoError(err)
+		s.Require().True(firstBlock)
+
+		gasPrice, err := s.feeMarketKeeper.GetFloorGasPrice(ctx, params.FeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec(params.FeeDenom, params.MinBaseGasPrice), gasPrice)
+
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+
+		state, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params.MinBaseGasPrice, state.BaseGasPrice)
+		s.Require().Equal(before.LearningRate, state.LearningRate)
+		s.Require().Equal(uint64(1), state.Index)
+		s.Require().Equal(params.MaxBlockUtilization, state.Window[0])
+
+		// Once the window holds the first block, the update runs as usual.
+		ctx = ctx.WithBlockHeight(11)
+		firstBlock, err = s.feeMarketKeeper.IsFirstBlock(ctx)
+		s.Require().NoError(err)
+		s.Require().False(firstBlock)
+
+		state.SetCurrentUtilization(params.MaxBlockUtilization)
+		s.Require().NoError(s.feeMarketKeeper.SetState(ctx, state))
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+
+		state, err = s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().True(state.BaseGasPrice.GT(params.MinBaseGasPrice))
+		s.Require().True(state.LearningRate.GT(before.LearningRate))
+	})
+
+	s.Run("enabled since genesis has no first block", func() {
+		s.feeMarketKeeper.SetFirstBlockAtFloor(true)
+		defer s.feeMarketKeeper.SetFirstBlockAtFloor(false)
+
+		s.feeMarketKeeper.SetEnabledHeight(s.ctx, -1)
+		firstBlock, err := s.feeMarketKeeper.IsFirstBlock(s.ctx.WithBlockHeight(0))
+		s.Require().NoError(err)
+		s.Require().False(firstBlock)
+	})
+
+	s.Run("floor gas price is resolved into other denoms", func() {
+		gasPrice, err := s.feeMarketKeeper.GetFloorGasPrice(s.ctx, "atom")
+		s.Require().NoError(err)
+		s.Require().Equal("atom", gasPrice.Denom)
+		s.Require().True(gasPrice.Amount.Equal(params.MinBaseGasPrice))
+	})
+}
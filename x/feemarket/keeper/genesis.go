@@ -6,30 +6,65 @@ import (
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
-// InitGenesis initializes the feemarket module's state from a given genesis state.
+// InitGenesis initializes the feemarket module's state from a given genesis state. It panics
+// if the genesis state is invalid, e.g. if the window does not hold params.Window blocks or
+// the base gas price is below the min base gas price.
 func (k *Keeper) InitGenesis(ctx sdk.Context, gs types.GenesisState) {
 	if err := gs.ValidateBasic(); err != nil {
 		panic(err)
 	}
 
-	if gs.Params.Window != uint64(len(gs.State.Window)) {
-		panic("genesis state and parameters do not match for window")
-	}
-
 	// Initialize the fee market state and parameters.
 	if err := k.SetParams(ctx, gs.Params); err != nil {
 		panic(err)
 	}
 
-	if err := k.SetState(ctx, gs.State); err != nil {
+	// The running window sum is derived from the window, so recompute it in case the
+	// genesis state omits it.
+	gs.State.ReconcileWindowSum()
+	if err := k.UpdateState(ctx, types.State{}, gs.State); err != nil {
 		panic(err)
 	}
 
-	// always init enabled height to -1 until it is explicitly set later in the application
-	k.SetEnabledHeight(ctx, -1)
+	// The enabled height is -1 unless the genesis was exported from a chain on which the
+	// fee market was enabled by a MsgParams.
+	k.SetEnabledHeight(ctx, gs.GetEnabledHeightOrUnset())
+	if gs.FrozenHeight > 0 {
+		k.SetFrozenHeight(ctx, gs.FrozenHeight)
+	}
+
+	for _, price := range gs.DenomMinGasPrices {
+		if err := k.SetDenomMinGasPrice(ctx, price.Denom, price.Amount); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, m := range gs.MsgTypeMultipliers {
+		if err := k.SetMsgTypeMultiplier(ctx, m.MsgTypeUrl, m.Multiplier); err != nil {
+			panic(err)
+		}
+	}
+
+	// The accumulated fees are held in the fee market's fee collector, whose balance is part
+	// of the bank genesis, and are distributed at the end of the current epoch.
+	if !gs.AccumulatedFees.IsZero() {
+		if err := k.setAccumulatedFees(ctx, gs.AccumulatedFees); err != nil {
+			panic(err)
+		}
+	}
+
+	// The contributions over the window are not part of the genesis, only the cumulative
+	// contributions since the last reset.
+	if contributions := gs.CommunityPoolContributions; !contributions.Amount.IsZero() || contributions.SinceHeight != 0 {
+		if err := k.setCommunityPoolContributions(ctx, contributions); err != nil {
+			panic(err)
+		}
+	}
 }
 
-// ExportGenesis returns a GenesisState for a given context.
+// ExportGenesis returns a GenesisState for a given context, including the full window, the
+// enabled and frozen heights, the denom min gas prices, the message type multipliers, the fees
+// accumulated for distribution and the cumulative community pool contributions.
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	// Get the feemarket module's parameters.
 	params, err := k.GetParams(ctx)
@@ -43,5 +78,49 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, state)
+	enabledHeight, err := k.GetEnabledHeight(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	frozenHeight, err := k.GetFrozenHeight(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	denomMinGasPrices, err := k.GetDenomMinGasPrices(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	msgTypeMultipliers, err := k.GetMsgTypeMultipliers(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	accumulatedFees, err := k.GetAccumulatedFees(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	contributions, err := k.GetCommunityPoolContributions(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs := types.NewGenesisState(params, state)
+	gs.EnabledHeight = enabledHeight
+	if frozenHeight > 0 {
+		gs.FrozenHeight = frozenHeight
+	}
+	gs.DenomMinGasPrices = denomMinGasPrices
+	gs.MsgTypeMultipliers = msgTypeMultipliers
+	if !accumulatedFees.IsZero() {
+		gs.AccumulatedFees = accumulatedFees
+	}
+	if !contributions.Amount.IsZero() || contributions.SinceHeight != 0 {
+		gs.CommunityPoolContributions = contributions
+	}
+
+	return gs
 }
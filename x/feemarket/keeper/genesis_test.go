@@ -1,6 +1,10 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/skip-mev/feemarket/testutils/keeper"
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
@@ -25,6 +29,35 @@ func (s *KeeperTestSuite) TestInitGenesis() {
 		})
 	})
 
+	s.Run("base gas price below the min base gas price should panic", func() {
+		gs := types.DefaultGenesisState()
+		gs.State.BaseGasPrice = gs.Params.MinBaseGasPrice.Sub(math.LegacyMustNewDecFromStr("0.1"))
+
+		s.Require().Panics(func() {
+			s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+		})
+	})
+
+	s.Run("imports the enabled height", func() {
+		gs := types.DefaultGenesisState()
+		gs.EnabledHeight = 42
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		enabledHeight, err := s.feeMarketKeeper.GetEnabledHeight(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(42), enabledHeight)
+	})
+
+	s.Run("a genesis without an enabled height imports as not enabled", func() {
+		gs := types.DefaultGenesisState()
+		gs.EnabledHeight = 0
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		enabledHeight, err := s.feeMarketKeeper.GetEnabledHeight(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(-1), enabledHeight)
+	})
+
 	s.Run("mismatch in params and state for window should panic", func() {
 		gs := types.DefaultAIMDGenesisState()
 		gs.Params.Window = 1
@@ -60,3 +93,93 @@ func (s *KeeperTestSuite) TestExportGenesis() {
 		s.Require().Equal(gs, exportedGenesis)
 	})
 }
+
+func (s *KeeperTestSuite) TestExportImportGenesis() {
+	// Build up a state with a partially filled, rotated window and an enabled height.
+	params := types.DefaultAIMDParams()
+	params.Window = 4
+	state := types.NewState(params.Window, params.MinBaseGasPrice.MulInt64(3), params.MinLearningRate)
+	for _, gas := range []uint64{10, 20, 30, 40, 50} {
+		s.Require().NoError(state.Update(gas, params))
+		state.IncrementHeight()
+	}
+	gs := types.NewGenesisState(params, state)
+	accumulated := sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 1_000))
+	gs.AccumulatedFees = accumulated
+	contributions := types.CommunityPoolContributions{
+		Amount:      sdk.NewDecCoins(sdk.NewInt64DecCoin(params.FeeDenom, 20)),
+		SinceHeight: 3,
+	}
+	gs.CommunityPoolContributions = contributions
+	s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+	s.feeMarketKeeper.SetEnabledHeight(s.ctx, 7)
+	s.feeMarketKeeper.SetFrozenHeight(s.ctx, 5)
+	minGasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("0.5")))
+	s.Require().NoError(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, "uatom", minGasPrices[0].Amount))
+	multipliers := []types.MsgTypeMultiplier{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyNewDec(2)}}
+	s.Require().NoError(s.feeMarketKeeper.SetMsgTypeMultiplier(s.ctx, multipliers[0].MsgTypeUrl, multipliers[0].Multiplier))
+
+	exported := s.feeMarketKeeper.ExportGenesis(s.ctx)
+	s.Require().Equal(params, exported.Params)
+	s.Require().Equal(state, exported.State)
+	s.Require().Equal(int64(7), exported.EnabledHeight)
+	s.Require().Equal(int64(5), exported.FrozenHeight)
+	s.Require().Equal(minGasPrices, exported.DenomMinGasPrices)
+	s.Require().Equal(multipliers, exported.MsgTypeMultipliers)
+	s.Require().Equal(accumulated, exported.AccumulatedFees)
+	s.Require().Equal(contributions, exported.CommunityPoolContributions)
+
+	bz, err := s.encCfg.Codec.MarshalJSON(exported)
+	s.Require().NoError(err)
+
+	s.Run("export is byte-identical across nodes with the same state", func() {
+		ctx, tk, _ := testkeeper.NewTestSetup(s.T())
+		other := tk.FeeMarketKeeper
+		other.InitGenesis(ctx, *exported)
+
+		otherBz, err := s.encCfg.Codec.MarshalJSON(other.ExportGenesis(ctx))
+		s.Require().NoError(err)
+		s.Require().Equal(string(bz), string(otherBz))
+	})
+
+	s.Run("the exported json imports into the same state", func() {
+		var imported types.GenesisState
+		s.Require().NoError(s.encCfg.Codec.UnmarshalJSON(bz, &imported))
+
+		ctx, tk, _ := testkeeper.NewTestSetup(s.T())
+		other := tk.FeeMarketKeeper
+		other.InitGenesis(ctx, imported)
+
+		gotState, err := other.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state, gotState)
+
+		gotParams, err := other.GetParams(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params, gotParams)
+
+		enabledHeight, err := other.GetEnabledHeight(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(7), enabledHeight)
+
+		frozenHeight, err := other.GetFrozenHeight(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(5), frozenHeight)
+
+		gotMinGasPrices, err := other.GetDenomMinGasPrices(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(minGasPrices, gotMinGasPrices)
+
+		gotMultipliers, err := other.GetMsgTypeMultipliers(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(multipliers, gotMultipliers)
+
+		gotAccumulated, err := other.GetAccumulatedFees(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(accumulated, gotAccumulated)
+
+		gotContributions, err := other.GetCommunityPoolContributions(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(contributions, gotContributions)
+	})
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+const (
+	// HealthComponentConvergence scores how steadily block utilization tracks the target
+	// rather than oscillating around it.
+	HealthComponentConvergence = "convergence"
+	// HealthComponentClamping scores how rarely blocks in the window are full, i.e. how
+	// rarely demand is clamped by the max block utilization.
+	HealthComponentClamping = "clamping"
+	// HealthComponentFloorProximity scores how far the base gas price is above the
+	// minimum base gas price.
+	HealthComponentFloorProximity = "floor_proximity"
+	// HealthComponentResponsiveness scores whether the learning rate is high enough to
+	// respond to extreme utilization.
+	HealthComponentResponsiveness = "responsiveness"
+)
+
+// healthWeights are the weights, summing to 100, of each component in the overall
+// health score.
+var healthWeights = map[string]int64{
+	HealthComponentConvergence:    30,
+	HealthComponentClamping:       30,
+	HealthComponentFloorProximity: 20,
+	HealthComponentResponsiveness: 20,
+}
+
+// HealthScore returns an overall fee market health score in [0, 100] along with the
+// score of each component, also in [0, 100]. The overall score is the weighted average
+// of the components:
+//
+//   - convergence (30%): one minus the fraction of consecutive blocks in the window
+//     whose utilization crosses from one side of the target to the other.
+//   - clamping (30%): one minus the fraction of blocks in the window that are full.
+//   - floor proximity (20%): the headroom of the base gas price above the minimum base
+//     gas price, relative to that minimum. A price at twice the minimum or more scores
+//     full marks.
+//   - responsiveness (20%): when the average utilization is outside of the target band,
+//     the learning rate relative to the max learning rate. Otherwise full marks.
+func (k *Keeper) HealthScore(ctx sdk.Context) (int, map[string]int, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return 0, nil, err
+	}
+
+	components := map[string]math.LegacyDec{
+		HealthComponentConvergence:    convergenceScore(state, params),
+		HealthComponentClamping:       clampingScore(state, params),
+		HealthComponentFloorProximity: floorProximityScore(state, params),
+		HealthComponentResponsiveness: responsivenessScore(state, params),
+	}
+
+	total := math.LegacyZeroDec()
+	scores := make(map[string]int, len(components))
+	for name, score := range components {
+		scores[name] = int(score.MulInt64(100).TruncateInt64())
+		total = total.Add(score.MulInt64(healthWeights[name]))
+	}
+
+	return int(total.TruncateInt64()), scores, nil
+}
+
+// convergenceScore returns one minus the fraction of consecutive blocks, in the order
+// they were produced, whose utilization lies on opposite sides of the target. Blocks
+// exactly at the target do not count as a crossing.
+func convergenceScore(state types.State, params types.Params) math.LegacyDec {
+	n := len(state.Window)
+	if n < 2 {
+		return math.LegacyOneDec()
+	}
+
+	target := params.TargetBlockUtilization()
+	side := func(utilization uint64) int {
+		switch {
+		case utilization > target:
+			return 1
+		case utilization < target:
+			return -1
+		default:
+			return 0
+		}
+	}
+
+	// The oldest block in the window is the one after the current index.
+	crossings, prev := 0, 0
+	for i := 1; i <= n; i++ {
+		s := side(state.Window[(int(state.Index)+i)%n])
+		if s != 0 && prev != 0 && s != prev {
+			crossings++
+		}
+		if s != 0 {
+			prev = s
+		}
+	}
+
+	return math.LegacyOneDec().Sub(math.LegacyNewDec(int64(crossings)).QuoInt64(int64(n - 1)))
+}
+
+// clampingScore returns one minus the fraction of blocks in the window that are full.
+func clampingScore(state types.State, params types.Params) math.LegacyDec {
+	if len(state.Window) == 0 {
+		return math.LegacyOneDec()
+	}
+
+	full := 0
+	for _, utilization := range state.Window {
+		if utilization >= params.MaxBlockUtilization {
+			full++
+		}
+	}
+
+	return math.LegacyOneDec().Sub(math.LegacyNewDec(int64(full)).QuoInt64(int64(len(state.Window))))
+}
+
+// floorProximityScore returns the headroom of the base gas price above the minimum base
+// gas price relative to that minimum, capped at one.
+func floorProximityScore(state types.State, params types.Params) math.LegacyDec {
+	if !params.MinBaseGasPrice.IsPositive() {
+		return math.LegacyOneDec()
+	}
+
+	headroom := state.BaseGasPrice.Sub(params.MinBaseGasPrice).Quo(params.MinBaseGasPrice)
+	return clampUnit(headroom)
+}
+
+// responsivenessScore returns the learning rate relative to the max learning rate if the
+// average utilization is outside of the target band, as the price must then move quickly.
+func responsivenessScore(state types.State, params types.Params) math.LegacyDec {
+	if !params.MaxLearningRate.IsPositive() || params.MaxBlockUtilization == 0 {
+		return math.LegacyOneDec()
+	}
+
+	avg := state.GetAverageUtilization(params)
+	if avg.GT(params.Gamma) && avg.LT(math.LegacyOneDec().Sub(params.Gamma)) {
+		return math.LegacyOneDec()
+	}
+
+	return clampUnit(state.LearningRate.Quo(params.MaxLearningRate))
+}
+
+// clampUnit clamps the given value to [0, 1].
+func clampUnit(d math.LegacyDec) math.LegacyDec {
+	if d.IsNegative() {
+		return math.LegacyZeroDec()
+	}
+	if d.GT(math.LegacyOneDec()) {
+		return math.LegacyOneDec()
+	}
+
+	return d
+}
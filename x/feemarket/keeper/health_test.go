@@ -0,0 +1,70 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestHealthScore() {
+	params := types.DefaultAIMDParams()
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	target := params.TargetBlockUtilization()
+
+	setState := func(window []uint64) {
+		state := types.DefaultAIMDState()
+		state.Window = window
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(2)
+		state.LearningRate = params.MaxLearningRate
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+	}
+
+	s.Run("steady utilization at the target is fully healthy", func() {
+		setState([]uint64{target, target + 1, target + 2, target + 1, target, target + 1, target + 2, target + 1})
+
+		score, components, err := s.feeMarketKeeper.HealthScore(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(100, score)
+		s.Require().Equal(map[string]int{
+			keeper.HealthComponentConvergence:    100,
+			keeper.HealthComponentClamping:       100,
+			keeper.HealthComponentFloorProximity: 100,
+			keeper.HealthComponentResponsiveness: 100,
+		}, components)
+	})
+
+	s.Run("oscillating utilization lowers convergence", func() {
+		high := target * 3 / 2
+		setState([]uint64{0, high, 0, high, 0, high, 0, high})
+
+		score, components, err := s.feeMarketKeeper.HealthScore(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(0, components[keeper.HealthComponentConvergence])
+		s.Require().Equal(70, score)
+	})
+
+	s.Run("frequently full blocks lower clamping", func() {
+		full := params.MaxBlockUtilization
+		setState([]uint64{full, full, full, full, target, target, target, target})
+
+		score, components, err := s.feeMarketKeeper.HealthScore(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(50, components[keeper.HealthComponentClamping])
+		s.Require().Less(score, 100)
+	})
+
+	s.Run("price at the floor with a slow learning rate under load lowers the score", func() {
+		full := params.MaxBlockUtilization
+		state := types.DefaultAIMDState()
+		state.Window = []uint64{full - 1, full - 1, full - 1, full - 1, full - 1, full - 1, full - 1, full - 1}
+		state.BaseGasPrice = params.MinBaseGasPrice
+		state.LearningRate = params.MinLearningRate
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		score, components, err := s.feeMarketKeeper.HealthScore(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(0, components[keeper.HealthComponentFloorProximity])
+		s.Require().Equal(2, components[keeper.HealthComponentResponsiveness])
+		s.Require().Equal(60, score)
+	})
+}
@@ -0,0 +1,360 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"sort"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// GetBaseGasPriceHistory returns up to limit of the most recent records of the base gas
+// price history, ordered by height. A limit of zero returns all records retained for the
+// last BaseGasPriceHistorySize blocks. Blocks for which no record was written, e.g. because
+// the history was disabled at the time, are omitted. A compact history is reconstructed
+// into the same absolute series.
+func (k *Keeper) GetBaseGasPriceHistory(ctx sdk.Context, limit uint64) ([]types.BaseGasPriceRecord, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	size := params.BaseGasPriceHistorySize
+	if size == 0 {
+		return nil, nil
+	}
+
+	if params.CompactsBaseGasPriceHistory() {
+		return k.getCompactBaseGasPriceHistory(ctx, size, limit)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixBaseGasPriceHistory)
+	defer iterator.Close()
+
+	// Slots may still hold records from before the size was last changed, so only the
+	// records within the last size blocks are returned.
+	height := ctx.BlockHeight()
+	var history []types.BaseGasPriceRecord
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.BaseGasPriceRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		if record.Height > height || uint64(height-record.Height) >= size {
+			continue
+		}
+
+		history = append(history, record)
+	}
+
+	sort.Slice(history, func(i, j int) bool {
+		return history[i].Height < history[j].Height
+	})
+
+	if limit > 0 && uint64(len(history)) > limit {
+		history = history[uint64(len(history))-limit:]
+	}
+
+	return history, nil
+}
+
+// recordBaseGasPrice writes the given base gas price to the slot of the current height in
+// the base gas price history, if the history is enabled. The history is a ring buffer of
+// BaseGasPriceHistorySize slots, so the record overwrites the one written size blocks ago.
+// It is written without charging gas, as the state is set by every tx while the base gas
+// price only changes at the end of a block, and is skipped if the slot already holds the
+// given price for the current height.
+func (k *Keeper) recordBaseGasPrice(ctx sdk.Context, price math.LegacyDec) error {
+	var params types.Params
+	if err := params.Unmarshal(k.getUnmetered(ctx, types.KeyParams)); err != nil {
+		return err
+	}
+
+	size := params.BaseGasPriceHistorySize
+	if size == 0 {
+		return nil
+	}
+
+	if params.CompactsBaseGasPriceHistory() {
+		return k.recordCompactBaseGasPrice(ctx, price, size)
+	}
+
+	record := types.BaseGasPriceRecord{
+		Height:       ctx.BlockHeight(),
+		BaseGasPrice: price,
+	}
+
+	key := types.BaseGasPriceHistoryKey(uint64(record.Height) % size)
+	if bz := k.getUnmetered(ctx, key); bz != nil {
+		var prev types.BaseGasPriceRecord
+		if err := prev.Unmarshal(bz); err == nil && prev.Height == record.Height && decsEqual(prev.BaseGasPrice, price) {
+			return nil
+		}
+	}
+
+	bz, err := record.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(k.storeKey).Set(key, bz)
+	return nil
+}
+
+// resizeBaseGasPriceHistory lays out the base gas price history for the given params after
+// they changed from the given previous params. The slot of a height depends on the size, so
+// the records of the last size blocks are moved to their new slots and all other records
+// are dropped. Switching between the naive and the compact history drops the history, and
+// a compact history is trimmed to a smaller size as it is written. Like the writes, the
+// resize is not charged gas.
+func (k *Keeper) resizeBaseGasPriceHistory(ctx sdk.Context, prevParams []byte, params types.Params) error {
+	if prevParams == nil {
+		return nil
+	}
+
+	var prev types.Params
+	if err := prev.Unmarshal(prevParams); err != nil {
+		return err
+	}
+
+	if prev.CompactsBaseGasPriceHistory() != params.CompactsBaseGasPriceHistory() {
+		k.clearBaseGasPriceHistory(ctx)
+		return nil
+	}
+
+	size := params.BaseGasPriceHistorySize
+	if params.CompactsBaseGasPriceHistory() || prev.BaseGasPriceHistorySize == size {
+		return nil
+	}
+
+	store := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixBaseGasPriceHistory)
+
+	height := ctx.BlockHeight()
+	var keys [][]byte
+	var retained []types.BaseGasPriceRecord
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+
+		var record types.BaseGasPriceRecord
+		if err := record.Unmarshal(iterator.Value()); err != nil {
+			iterator.Close()
+			return err
+		}
+
+		if record.Height <= height && uint64(height-record.Height) < size {
+			retained = append(retained, record)
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+
+	for _, record := range retained {
+		bz, err := record.Marshal()
+		if err != nil {
+			return err
+		}
+
+		store.Set(types.BaseGasPriceHistoryKey(uint64(record.Height)%size), bz)
+	}
+
+	return nil
+}
+
+// recordCompactBaseGasPrice records the given base gas price in the compact base gas price
+// history, which stores an entry only for the heights at which the price changed. The
+// entry holds the change from the previously recorded price, which the tip keeps along
+// with the height it was last recorded at. The first entry holds the absolute price. The
+// entries that fell out of the last size blocks are folded into one, so that the oldest
+// entry still holds the absolute price.
+func (k *Keeper) recordCompactBaseGasPrice(ctx sdk.Context, price math.LegacyDec, size uint64) error {
+	height := ctx.BlockHeight()
+	store := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(k.storeKey)
+
+	var tip types.BaseGasPriceRecord
+	hasTip := false
+	if bz := store.Get(types.KeyCompactBaseGasPriceHistoryTip); bz != nil {
+		if err := tip.Unmarshal(bz); err != nil {
+			return err
+		}
+		hasTip = true
+	}
+
+	changed := !hasTip || !decsEqual(tip.BaseGasPrice, price)
+	if !changed && tip.Height == height {
+		return nil
+	}
+
+	if changed {
+		delta := price
+		if hasTip {
+			delta = price.Sub(tip.BaseGasPrice)
+		}
+
+		key := types.CompactBaseGasPriceHistoryKey(height)
+
+		var change types.BaseGasPriceChange
+		if bz := store.Get(key); bz != nil {
+			if err := change.Unmarshal(bz); err != nil {
+				return err
+			}
+			delta = delta.Add(change.Delta)
+		}
+		change.Delta = delta
+
+		bz, err := change.Marshal()
+		if err != nil {
+			return err
+		}
+		store.Set(key, bz)
+	}
+
+	prevHeight := tip.Height
+	tip = types.BaseGasPriceRecord{Height: height, BaseGasPrice: price}
+
+	bz, err := tip.Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(types.KeyCompactBaseGasPriceHistoryTip, bz)
+
+	if hasTip && prevHeight == height {
+		return nil
+	}
+
+	return k.pruneCompactBaseGasPriceHistory(ctx, size)
+}
+
+// pruneCompactBaseGasPriceHistory folds the entries of the compact base gas price history at
+// heights that fell out of the last size blocks into the latest of them, which then holds
+// the absolute base gas price at the start of the retained history.
+func (k *Keeper) pruneCompactBaseGasPriceHistory(ctx sdk.Context, size uint64) error {
+	cutoff := ctx.BlockHeight() - int64(size)
+	if cutoff < 1 {
+		return nil
+	}
+
+	store := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixCompactBaseGasPriceHistory, types.CompactBaseGasPriceHistoryKey(cutoff+1))
+
+	var keys [][]byte
+	price := math.LegacyZeroDec()
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+
+		var change types.BaseGasPriceChange
+		if err := change.Unmarshal(iterator.Value()); err != nil {
+			iterator.Close()
+			return err
+		}
+		price = price.Add(change.Delta)
+	}
+	iterator.Close()
+
+	if len(keys) < 2 {
+		return nil
+	}
+
+	for _, key := range keys[:len(keys)-1] {
+		store.Delete(key)
+	}
+
+	bz, err := (&types.BaseGasPriceChange{Delta: price}).Marshal()
+	if err != nil {
+		return err
+	}
+	store.Set(keys[len(keys)-1], bz)
+
+	return nil
+}
+
+// getCompactBaseGasPriceHistory reconstructs up to limit of the most recent records of the
+// compact base gas price history, ordered by height. The price of a height without an entry
+// is the price of the closest entry below it. Records are returned from the first entry up
+// to the height the tip was last recorded at, within the last size blocks.
+func (k *Keeper) getCompactBaseGasPriceHistory(ctx sdk.Context, size, limit uint64) ([]types.BaseGasPriceRecord, error) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := store.Get(types.KeyCompactBaseGasPriceHistoryTip)
+	if bz == nil {
+		return nil, nil
+	}
+
+	var tip types.BaseGasPriceRecord
+	if err := tip.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+
+	height := ctx.BlockHeight()
+	end := min(tip.Height, height)
+
+	start := height - int64(size) + 1
+	if limit > 0 {
+		start = max(start, end-int64(limit)+1)
+	}
+
+	iterator := store.Iterator(types.KeyPrefixCompactBaseGasPriceHistory, types.CompactBaseGasPriceHistoryKey(end+1))
+	defer iterator.Close()
+
+	var history []types.BaseGasPriceRecord
+	price := math.LegacyZeroDec()
+	next := int64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		entryHeight := int64(binary.BigEndian.Uint64(iterator.Key()[len(types.KeyPrefixCompactBaseGasPriceHistory):]))
+
+		var change types.BaseGasPriceChange
+		if err := change.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		if next > 0 {
+			history = appendCompactRecords(history, price, max(next, start), entryHeight-1)
+		}
+		price = price.Add(change.Delta)
+		next = entryHeight
+	}
+
+	if next == 0 {
+		return nil, nil
+	}
+
+	return appendCompactRecords(history, price, max(next, start), end), nil
+}
+
+// appendCompactRecords appends a record of the given price for each height from the given
+// height up to and including the given end.
+func appendCompactRecords(history []types.BaseGasPriceRecord, price math.LegacyDec, from, to int64) []types.BaseGasPriceRecord {
+	for height := from; height <= to; height++ {
+		history = append(history, types.BaseGasPriceRecord{Height: height, BaseGasPrice: price})
+	}
+
+	return history
+}
+
+// clearBaseGasPriceHistory removes all records of both the naive and the compact base gas
+// price history.
+func (k *Keeper) clearBaseGasPriceHistory(ctx sdk.Context) {
+	store := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(k.storeKey)
+
+	var keys [][]byte
+	for _, prefix := range [][]byte{types.KeyPrefixBaseGasPriceHistory, types.KeyPrefixCompactBaseGasPriceHistory} {
+		iterator := storetypes.KVStorePrefixIterator(store, prefix)
+		for ; iterator.Valid(); iterator.Next() {
+			keys = append(keys, iterator.Key())
+		}
+		iterator.Close()
+	}
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	store.Delete(types.KeyCompactBaseGasPriceHistoryTip)
+}
@@ -0,0 +1,241 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/skip-mev/feemarket/testutils/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestBaseGasPriceHistory() {
+	params, err := s.feeMarketKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+
+	// setHistorySize sets the history size at the given height, as a MsgParams would.
+	setHistorySize := func(height int64, size uint64) {
+		params.BaseGasPriceHistorySize = size
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx.WithBlockHeight(height), params))
+	}
+
+	priceAt := func(height int64) math.LegacyDec {
+		return math.LegacyNewDec(height)
+	}
+
+	// setPrices sets the state once per height from the given height up to and including
+	// the given end, as the post handler and EndBlock would.
+	setPrices := func(from, to int64) {
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+
+		for height := from; height <= to; height++ {
+			ctx := s.ctx.WithBlockHeight(height)
+
+			// A tx in the block sets the state at the previous block's price before
+			// EndBlock sets the new price.
+			s.Require().NoError(s.feeMarketKeeper.SetState(ctx, state))
+			state.BaseGasPrice = priceAt(height)
+			s.Require().NoError(s.feeMarketKeeper.SetState(ctx, state))
+		}
+	}
+
+	expectHistory := func(height int64, limit uint64, heights ...int64) {
+		history, err := s.feeMarketKeeper.GetBaseGasPriceHistory(s.ctx.WithBlockHeight(height), limit)
+		s.Require().NoError(err)
+
+		var expected []types.BaseGasPriceRecord
+		for _, h := range heights {
+			expected = append(expected, types.BaseGasPriceRecord{Height: h, BaseGasPrice: priceAt(h)})
+		}
+		s.Require().Equal(expected, history)
+	}
+
+	s.Run("nothing is recorded while the history is disabled", func() {
+		setHistorySize(0, 0)
+		setPrices(1, 3)
+
+		expectHistory(3, 0)
+		s.Require().Zero(s.feeMarketKeeper.BaseGasPriceHistorySlots(s.ctx))
+	})
+
+	s.Run("fewer blocks than the size returns the available records in height order", func() {
+		setHistorySize(3, 5)
+		setPrices(4, 6)
+
+		expectHistory(6, 0, 4, 5, 6)
+	})
+
+	s.Run("the ring buffer keeps the most recent blocks", func() {
+		setPrices(7, 12)
+
+		expectHistory(12, 0, 8, 9, 10, 11, 12)
+		s.Require().Equal(5, s.feeMarketKeeper.BaseGasPriceHistorySlots(s.ctx))
+	})
+
+	s.Run("the limit returns the most recent records", func() {
+		expectHistory(12, 2, 11, 12)
+		expectHistory(12, 10, 8, 9, 10, 11, 12)
+	})
+
+	s.Run("reducing the size drops the records that no longer fit", func() {
+		setHistorySize(12, 3)
+
+		expectHistory(12, 0, 10, 11, 12)
+		s.Require().Equal(3, s.feeMarketKeeper.BaseGasPriceHistorySlots(s.ctx))
+
+		setPrices(13, 13)
+		expectHistory(13, 0, 11, 12, 13)
+	})
+
+	s.Run("increasing the size keeps the existing records", func() {
+		setHistorySize(13, 4)
+		setPrices(14, 14)
+
+		expectHistory(14, 0, 11, 12, 13, 14)
+	})
+
+	s.Run("recording the history does not charge gas", func() {
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		state.BaseGasPrice = math.LegacyNewDec(100)
+
+		gasUsed := func(size uint64) storetypes.Gas {
+			setHistorySize(20, size)
+			ctx := s.ctx.WithBlockHeight(20).WithGasMeter(storetypes.NewInfiniteGasMeter())
+			s.Require().NoError(s.feeMarketKeeper.SetState(ctx, state))
+			return ctx.GasMeter().GasConsumed()
+		}
+
+		s.Require().Equal(gasUsed(0), gasUsed(3))
+	})
+
+	s.Run("the query returns the history", func() {
+		setHistorySize(20, 3)
+		setPrices(21, 23)
+
+		resp, err := s.queryServer.BaseGasPriceHistory(s.ctx.WithBlockHeight(23), &types.BaseGasPriceHistoryRequest{Limit: 2})
+		s.Require().NoError(err)
+		s.Require().Equal([]types.BaseGasPriceRecord{
+			{Height: 22, BaseGasPrice: priceAt(22)},
+			{Height: 23, BaseGasPrice: priceAt(23)},
+		}, resp.History)
+	})
+}
+
+func (s *KeeperTestSuite) TestCompactBaseGasPriceHistory() {
+	params, err := s.feeMarketKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+
+	// priceAt changes the price every third block and returns to an earlier price, so that
+	// both unchanged blocks and negative changes are recorded.
+	priceAt := func(height int64) math.LegacyDec {
+		return math.LegacyNewDecWithPrec(10+(height/3)%4, 1)
+	}
+
+	// replay records the prices from height 1 up to and including the given end in the
+	// given mode of the history, shrinking the size from 6 to 4 at the given height, and
+	// returns the history at each height for a limit of zero and of three.
+	replay := func(compact bool, end, shrinkAt int64) [][]types.BaseGasPriceRecord {
+		params.BaseGasPriceHistorySize = 6
+		params.CompactBaseGasPriceHistory = compact
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+
+		var series [][]types.BaseGasPriceRecord
+		for height := int64(1); height <= end; height++ {
+			ctx := s.ctx.WithBlockHeight(height)
+			if height == shrinkAt {
+				params.BaseGasPriceHistorySize = 4
+				s.Require().NoError(s.feeMarketKeeper.SetParams(ctx, params))
+			}
+
+			s.Require().NoError(s.feeMarketKeeper.SetState(ctx, state))
+			state.BaseGasPrice = priceAt(height)
+			s.Require().NoError(s.feeMarketKeeper.SetState(ctx, state))
+
+			for _, limit := range []uint64{0, 3} {
+				history, err := s.feeMarketKeeper.GetBaseGasPriceHistory(ctx, limit)
+				s.Require().NoError(err)
+				series = append(series, history)
+			}
+		}
+
+		return series
+	}
+
+	s.Run("reconstructs the naive series", func() {
+		naive := replay(false, 30, 20)
+		compact := replay(true, 30, 20)
+
+		s.Require().Len(compact, len(naive))
+		for i := range naive {
+			s.Require().Len(compact[i], len(naive[i]), "history %d", i)
+			for j := range naive[i] {
+				s.Require().Equal(naive[i][j].Height, compact[i][j].Height)
+				s.Require().True(naive[i][j].BaseGasPrice.Equal(compact[i][j].BaseGasPrice),
+					"height %d: %s != %s", naive[i][j].Height, naive[i][j].BaseGasPrice, compact[i][j].BaseGasPrice)
+			}
+		}
+	})
+
+	s.Run("stores only the changes within the size", func() {
+		// The price changed at heights 27 and 30 within the last 4 blocks, and the entry
+		// folded from the older changes holds the price at the start of the history.
+		s.Require().Equal(3, s.feeMarketKeeper.CompactBaseGasPriceHistoryEntries(s.ctx))
+		s.Require().Zero(s.feeMarketKeeper.BaseGasPriceHistorySlots(s.ctx))
+	})
+
+	s.Run("switching back to the naive history drops the compact history", func() {
+		params.CompactBaseGasPriceHistory = false
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx.WithBlockHeight(30), params))
+
+		s.Require().Zero(s.feeMarketKeeper.CompactBaseGasPriceHistoryEntries(s.ctx))
+		s.Require().Zero(s.feeMarketKeeper.BaseGasPriceHistoryBytes(s.ctx))
+
+		history, err := s.feeMarketKeeper.GetBaseGasPriceHistory(s.ctx.WithBlockHeight(30), 0)
+		s.Require().NoError(err)
+		s.Require().Empty(history)
+	})
+}
+
+func BenchmarkBaseGasPriceHistoryStorage(b *testing.B) {
+	const blocks = 1_000
+
+	for _, every := range []int64{1, 10, 100} {
+		for _, compact := range []bool{false, true} {
+			b.Run(fmt.Sprintf("price changes every %d blocks/compact=%t", every, compact), func(b *testing.B) {
+				var (
+					ctx sdk.Context
+					k   *keeper.Keeper
+				)
+				for i := 0; i < b.N; i++ {
+					var tk testkeeper.TestKeepers
+					ctx, tk, _ = testkeeper.NewTestSetup(b)
+					k = tk.FeeMarketKeeper
+
+					params := types.DefaultAIMDParams()
+					params.BaseGasPriceHistorySize = blocks
+					params.CompactBaseGasPriceHistory = compact
+					state := types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
+					k.InitGenesis(ctx, *types.NewGenesisState(params, state))
+
+					for height := int64(1); height <= blocks; height++ {
+						state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(1 + height/every)
+						if err := k.SetState(ctx.WithBlockHeight(height), state); err != nil {
+							b.Fatal(err)
+						}
+					}
+				}
+
+				b.ReportMetric(float64(k.BaseGasPriceHistoryBytes(ctx)), "bytes")
+			})
+		}
+	}
+}
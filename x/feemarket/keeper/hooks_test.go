@@ -0,0 +1,100 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+type priceUpdate struct {
+	oldPrice, newPrice math.LegacyDec
+}
+
+// recordingHooks records every base gas price update and returns err, if set.
+type recordingHooks struct {
+	updates []priceUpdate
+	err     error
+}
+
+func (h *recordingHooks) AfterBaseGasPriceUpdated(_ sdk.Context, oldPrice, newPrice math.LegacyDec) error {
+	h.updates = append(h.updates, priceUpdate{oldPrice: oldPrice, newPrice: newPrice})
+	return h.err
+}
+
+func (s *KeeperTestSuite) TestFeeMarketHooks() {
+	first, second := &recordingHooks{}, &recordingHooks{}
+	s.feeMarketKeeper.SetHooks(types.NewMultiFeeMarketHooks(first, second))
+
+	state := types.DefaultState()
+	state.BaseGasPrice = math.LegacyMustNewDecFromStr("1.5")
+	s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+	s.Run("hooks are called after the base gas price changes", func() {
+		prev := state
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("2")
+		first.updates, second.updates = nil, nil
+
+		s.Require().NoError(s.feeMarketKeeper.UpdateState(s.ctx, prev, state))
+
+		expected := []priceUpdate{{oldPrice: prev.BaseGasPrice, newPrice: state.BaseGasPrice}}
+		s.Require().Equal(expected, first.updates)
+		s.Require().Equal(expected, second.updates)
+	})
+
+	s.Run("hooks are not called if the base gas price is unchanged", func() {
+		first.updates, second.updates = nil, nil
+		prev := state
+		state.LearningRate = math.LegacyMustNewDecFromStr("0.5")
+
+		s.Require().NoError(s.feeMarketKeeper.UpdateState(s.ctx, prev, state))
+		s.Require().Empty(first.updates)
+		s.Require().Empty(second.updates)
+	})
+
+	s.Run("hooks are called by the end block update", func() {
+		params, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("1")
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+		first.updates = nil
+		state.Window[state.Index] = params.MaxBlockUtilization
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		got, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]priceUpdate{{oldPrice: state.BaseGasPrice, newPrice: got.BaseGasPrice}}, first.updates)
+	})
+
+	s.Run("an error from a hook is returned and later hooks are skipped", func() {
+		first.err = errors.New("circuit breaker tripped")
+		defer func() { first.err = nil }()
+		second.updates = nil
+
+		prev := state
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("3")
+		err := s.feeMarketKeeper.UpdateState(s.ctx, prev, state)
+		s.Require().ErrorContains(err, "circuit breaker tripped")
+		s.Require().Empty(second.updates)
+	})
+
+	s.Run("hooks can only be set once", func() {
+		s.Require().Panics(func() {
+			s.feeMarketKeeper.SetHooks(&recordingHooks{})
+		})
+	})
+}
+
+func (s *KeeperTestSuite) TestNoHooks() {
+	state := types.DefaultState()
+	state.BaseGasPrice = math.LegacyMustNewDecFromStr("1.5")
+	s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+	prev := state
+	state.BaseGasPrice = math.LegacyMustNewDecFromStr("2")
+	s.Require().NoError(s.feeMarketKeeper.UpdateState(s.ctx, prev, state))
+}
@@ -0,0 +1,197 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// ImpactOnMempool returns the number of mempool txs, given by their fees and gas limits,
+// that currently pay a sufficient fee but would become underpriced if newParams were
+// applied. Applying new params resets the state, so the base gas price after the change
+// is the new MinBaseGasPrice. Txs that do not pay exactly one fee coin are ignored, as
+// they are rejected regardless of the params.
+func (k *Keeper) ImpactOnMempool(ctx sdk.Context, newParams types.Params, mempoolTxFees []sdk.Coins, mempoolTxGas []uint64) (int, error) {
+	if len(mempoolTxFees) != len(mempoolTxGas) {
+		return 0, fmt.Errorf(
+			"mismatched mempool tx fees and gas; got %d fees and %d gas limits", len(mempoolTxFees), len(mempoolTxGas),
+		)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	// If the fee market is disabled after the change, no tx is priced by it.
+	if !newParams.Enabled {
+		return 0, nil
+	}
+
+	newBaseGasPrice := sdk.NewDecCoinFromDec(newParams.FeeDenom, newParams.MinBaseGasPrice)
+
+	affected := 0
+	for i, fees := range mempoolTxFees {
+		if len(fees) != 1 {
+			continue
+		}
+
+		fee, gas := fees[0], mempoolTxGas[i]
+
+		if params.Enabled {
+			gasPrice, err := k.GetMinGasPrice(ctx, fee.Denom)
+			if err != nil || !fee.IsGTE(computeFee(gasPrice, gas)) {
+				// The tx is already underpriced under the current params.
+				continue
+			}
+		}
+
+		newGasPrice := newBaseGasPrice
+		if fee.Denom != newParams.FeeDenom {
+			newGasPrice, err = k.ResolveToDenom(ctx, newBaseGasPrice, fee.Denom)
+			if err != nil {
+				// The fee denom can no longer be used to pay fees.
+				affected++
+				continue
+			}
+		}
+
+		if !fee.IsGTE(computeFee(newGasPrice, gas)) {
+			affected++
+		}
+	}
+
+	return affected, nil
+}
+
+// FloorRevenueImpact estimates the additional fee revenue per block, in the fee denom, from
+// raising MinBaseGasPrice to newFloor. Demand is assumed to be inelastic at the margin:
+// blocks keep consuming expectedGasPerBlock regardless of the higher floor, so the impact
+// is (max(baseGasPrice, newFloor) - baseGasPrice) * expectedGasPerBlock. In practice some
+// demand is priced out, so this is an upper bound on the additional revenue.
+func (k *Keeper) FloorRevenueImpact(ctx sdk.Context, newFloor math.LegacyDec, expectedGasPerBlock uint64) (sdk.Coin, error) {
+	if newFloor.IsNil() || newFloor.IsNegative() {
+		return sdk.Coin{}, fmt.Errorf("new floor cannot be nil or negative")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	baseGasPrice, err := k.GetBaseGasPrice(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if newFloor.LTE(baseGasPrice) {
+		return sdk.NewCoin(params.FeeDenom, math.ZeroInt()), nil
+	}
+
+	gas := math.LegacyNewDecFromInt(math.NewIntFromUint64(expectedGasPerBlock))
+	impact := newFloor.Sub(baseGasPrice).Mul(gas)
+
+	return sdk.NewCoin(params.FeeDenom, impact.TruncateInt()), nil
+}
+
+// ImpactOfGasLimitChange estimates the relative change in the steady-state base gas price
+// if MaxBlockUtilization, which tracks the consensus block gas limit, were set to newLimit
+// while demand is held constant. E.g. -0.25 means the price would settle 25% lower.
+//
+// Demand is modeled as the least-squares line of utilization against price fitted over
+// the observed window (see EmpiricalElasticity). The price settles where demand meets the
+// target utilization (see EquilibriumUtilization), so the steady-state price under a limit
+// is the price at which the fitted line reaches half of that limit, held at or above
+// MinBaseGasPrice. The line is a local fit, so the estimate is less reliable for changes
+// that move the target far outside the observed utilization range. ErrInsufficientData is
+// returned if the window cannot be fitted or the fitted utilization does not fall as the
+// price rises.
+func (k *Keeper) ImpactOfGasLimitChange(ctx sdk.Context, newLimit uint64) (math.LegacyDec, error) {
+	if newLimit < 2 {
+		return math.LegacyDec{}, fmt.Errorf("new block gas limit must be at least 2; got %d", newLimit)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	slope, meanPrice, meanUtilization, err := k.fitDemand(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if !slope.IsNegative() {
+		return math.LegacyDec{}, types.ErrInsufficientData.Wrap("utilization did not fall as the base gas price rose")
+	}
+
+	// The price at which the fitted demand line reaches the target for the given limit.
+	steadyStatePrice := func(limit uint64) math.LegacyDec {
+		target := math.LegacyNewDecFromInt(math.NewIntFromUint64(limit / 2))
+		price := meanPrice.Add(target.Sub(meanUtilization).Quo(slope))
+
+		return math.LegacyMaxDec(price, params.MinBaseGasPrice)
+	}
+
+	currentPrice := steadyStatePrice(params.MaxBlockUtilization)
+	if !currentPrice.IsPositive() {
+		return math.LegacyDec{}, fmt.Errorf("the current steady-state base gas price is zero")
+	}
+
+	return steadyStatePrice(newLimit).Sub(currentPrice).Quo(currentPrice), nil
+}
+
+// ParamsForTargetCost back-solves the params under which an active user who sends
+// expectedTxsPerUserPerMonth txs a month, each consuming avgGasPerTx gas, pays
+// targetMonthlyCostPerUser on average. The target may be given in any denom the denom
+// resolver can convert to the fee denom.
+//
+// The average cost is assumed to be set by the floor: when blocks are at or below the target
+// utilization the base gas price settles at MinBaseGasPrice, so a user pays
+// txs * gas * MinBaseGasPrice a month and the floor is target / (txs * gas). Congestion
+// raises the price above the floor by the congestion multiplier, so users active during
+// congested blocks pay more than the target. Tips, dynamic gas and changes to the resolver's
+// rate are not modeled. The remaining params, including the controller coefficients, are
+// carried over from the current params as the suggested configuration, as they shape how far
+// and how fast the price moves above the floor rather than its average at low utilization.
+func (k *Keeper) ParamsForTargetCost(
+	ctx sdk.Context,
+	targetMonthlyCostPerUser sdk.DecCoin,
+	expectedTxsPerUserPerMonth int64,
+	avgGasPerTx uint64,
+) (types.Params, error) {
+	if targetMonthlyCostPerUser.Amount.IsNil() || !targetMonthlyCostPerUser.Amount.IsPositive() {
+		return types.Params{}, fmt.Errorf("target monthly cost per user must be positive")
+	}
+	if expectedTxsPerUserPerMonth <= 0 {
+		return types.Params{}, fmt.Errorf("expected txs per user per month must be positive; got %d", expectedTxsPerUserPerMonth)
+	}
+	if avgGasPerTx == 0 {
+		return types.Params{}, fmt.Errorf("average gas per tx must be positive")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.Params{}, err
+	}
+
+	target := targetMonthlyCostPerUser
+	if target.Denom != params.FeeDenom {
+		target, err = k.ResolveToDenom(ctx, target, params.FeeDenom)
+		if err != nil {
+			return types.Params{}, err
+		}
+	}
+
+	gasPerMonth := math.LegacyNewDecFromInt(math.NewIntFromUint64(avgGasPerTx)).MulInt64(expectedTxsPerUserPerMonth)
+	params.MinBaseGasPrice = target.Amount.Quo(gasPerMonth)
+
+	if err := params.ValidateBasic(); err != nil {
+		return types.Params{}, fmt.Errorf("invalid params for target cost: %w", err)
+	}
+
+	return params, nil
+}
@@ -0,0 +1,242 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestImpactOnMempool() {
+	setup := func() types.Params {
+		gs := types.DefaultGenesisState()
+		gs.Params.MinBaseGasPrice = math.LegacyOneDec()
+		gs.State.BaseGasPrice = math.LegacyOneDec()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		return gs.Params
+	}
+
+	fees := []sdk.Coins{
+		// sufficient now, insufficient after raising the floor to 2
+		sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 100)),
+		// sufficient before and after
+		sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 250)),
+		// already insufficient
+		sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 50)),
+		// sufficient now in a resolvable denom, insufficient after
+		sdk.NewCoins(sdk.NewInt64Coin("atom", 150)),
+		// too many fee coins, rejected regardless
+		sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 100), sdk.NewInt64Coin("atom", 100)),
+	}
+	gas := []uint64{100, 100, 100, 100, 100}
+
+	s.Run("counts txs that become underpriced", func() {
+		newParams := setup()
+		newParams.MinBaseGasPrice = math.LegacyNewDec(2)
+
+		affected, err := s.feeMarketKeeper.ImpactOnMempool(s.ctx, newParams, fees, gas)
+		s.Require().NoError(err)
+		s.Require().Equal(2, affected)
+	})
+
+	s.Run("lowering the floor affects no txs", func() {
+		newParams := setup()
+		newParams.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.5")
+
+		affected, err := s.feeMarketKeeper.ImpactOnMempool(s.ctx, newParams, fees, gas)
+		s.Require().NoError(err)
+		s.Require().Equal(0, affected)
+	})
+
+	s.Run("disabling the fee market affects no txs", func() {
+		newParams := setup()
+		newParams.MinBaseGasPrice = math.LegacyNewDec(2)
+		newParams.Enabled = false
+
+		affected, err := s.feeMarketKeeper.ImpactOnMempool(s.ctx, newParams, fees, gas)
+		s.Require().NoError(err)
+		s.Require().Equal(0, affected)
+	})
+
+	s.Run("mismatched inputs return an error", func() {
+		newParams := setup()
+
+		_, err := s.feeMarketKeeper.ImpactOnMempool(s.ctx, newParams, fees, gas[:1])
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestFloorRevenueImpact() {
+	setup := func(baseGasPrice math.LegacyDec) {
+		gs := types.DefaultGenesisState()
+		gs.Params.MinBaseGasPrice = math.LegacyOneDec()
+		gs.State.BaseGasPrice = baseGasPrice
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+	}
+
+	s.Run("raising the floor above the price increases revenue", func() {
+		setup(math.LegacyOneDec())
+
+		impact, err := s.feeMarketKeeper.FloorRevenueImpact(s.ctx, math.LegacyMustNewDecFromStr("1.5"), 1_000_000)
+		s.Require().NoError(err)
+		// (1.5 - 1) * 1,000,000
+		s.Require().Equal(sdk.NewInt64Coin(types.DefaultFeeDenom, 500_000), impact)
+	})
+
+	s.Run("the impact is measured from the current price, not the current floor", func() {
+		setup(math.LegacyNewDec(3))
+
+		impact, err := s.feeMarketKeeper.FloorRevenueImpact(s.ctx, math.LegacyNewDec(5), 1_000)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(types.DefaultFeeDenom, 2_000), impact)
+	})
+
+	s.Run("a floor at or below the price has no impact", func() {
+		setup(math.LegacyNewDec(3))
+
+		impact, err := s.feeMarketKeeper.FloorRevenueImpact(s.ctx, math.LegacyNewDec(2), 1_000)
+		s.Require().NoError(err)
+		s.Require().True(impact.IsZero())
+	})
+
+	s.Run("a negative floor returns an error", func() {
+		setup(math.LegacyOneDec())
+
+		_, err := s.feeMarketKeeper.FloorRevenueImpact(s.ctx, math.LegacyNewDec(-1), 1_000)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestImpactOfGasLimitChange() {
+	params := types.DefaultAIMDParams()
+	params.MinBaseGasPrice = math.LegacyOneDec()
+	params.MaxBlockUtilization = 2_000
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	record := func(prices []int64, utilizations []uint64) {
+		for i := range prices {
+			ctx := s.ctx.WithBlockHeight(10 + int64(i))
+			s.Require().NoError(s.feeMarketKeeper.RecordObservation(ctx, math.LegacyNewDec(prices[i]), utilizations[i]))
+		}
+	}
+	resetObservations := func() {
+		s.feeMarketKeeper.PruneObservations(s.ctx.WithBlockHeight(1_000_000), 0)
+	}
+
+	// utilization = 3000 - 100 * price, so with a target of 1000 the price settles at 20.
+	demand := func() {
+		record([]int64{1, 2, 3, 4}, []uint64{2_900, 2_800, 2_700, 2_600})
+	}
+
+	s.Run("doubling the limit lowers the price", func() {
+		defer resetObservations()
+		demand()
+
+		// A target of 2000 is reached at a price of 10.
+		impact, err := s.feeMarketKeeper.ImpactOfGasLimitChange(s.ctx, 4_000)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("-0.5"), impact)
+	})
+
+	s.Run("halving the limit raises the price", func() {
+		defer resetObservations()
+		demand()
+
+		// A target of 500 is reached at a price of 25.
+		impact, err := s.feeMarketKeeper.ImpactOfGasLimitChange(s.ctx, 1_000)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.25"), impact)
+	})
+
+	s.Run("the price cannot settle below the floor", func() {
+		defer resetObservations()
+		demand()
+
+		// A target of 4000 is never reached, so the price settles at the floor of 1.
+		impact, err := s.feeMarketKeeper.ImpactOfGasLimitChange(s.ctx, 8_000)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("-0.95"), impact)
+	})
+
+	s.Run("an unchanged limit has no impact", func() {
+		defer resetObservations()
+		demand()
+
+		impact, err := s.feeMarketKeeper.ImpactOfGasLimitChange(s.ctx, params.MaxBlockUtilization)
+		s.Require().NoError(err)
+		s.Require().True(impact.IsZero())
+	})
+
+	s.Run("demand that rises with the price returns an error", func() {
+		defer resetObservations()
+		record([]int64{1, 2}, []uint64{100, 200})
+
+		_, err := s.feeMarketKeeper.ImpactOfGasLimitChange(s.ctx, 4_000)
+		s.Require().ErrorIs(err, types.ErrInsufficientData)
+	})
+
+	s.Run("insufficient data returns an error", func() {
+		defer resetObservations()
+
+		_, err := s.feeMarketKeeper.ImpactOfGasLimitChange(s.ctx, 4_000)
+		s.Require().ErrorIs(err, types.ErrInsufficientData)
+	})
+
+	s.Run("an invalid limit returns an error", func() {
+		defer resetObservations()
+		demand()
+
+		_, err := s.feeMarketKeeper.ImpactOfGasLimitChange(s.ctx, 1)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestParamsForTargetCost() {
+	gs := types.DefaultGenesisState()
+	s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+	s.Run("the floor meets the target cost per user", func() {
+		params, err := s.feeMarketKeeper.ParamsForTargetCost(s.ctx, sdk.NewInt64DecCoin(types.DefaultFeeDenom, 300_000), 100, 100_000)
+		s.Require().NoError(err)
+		// 300,000 / (100 * 100,000)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.03"), params.MinBaseGasPrice)
+
+		monthlyCost := params.MinBaseGasPrice.MulInt64(100).MulInt64(100_000)
+		s.Require().Equal(math.LegacyNewDec(300_000), monthlyCost)
+
+		// The remaining params are carried over.
+		expected := gs.Params
+		expected.MinBaseGasPrice = params.MinBaseGasPrice
+		s.Require().Equal(expected, params)
+	})
+
+	s.Run("a target in another denom is converted to the fee denom", func() {
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+		s.feeMarketKeeper.SetDenomResolver(&countingDenomResolver{rate: math.LegacyNewDec(4)})
+
+		params, err := s.feeMarketKeeper.ParamsForTargetCost(s.ctx, sdk.NewInt64DecCoin("uusd", 5), 10, 2_000)
+		s.Require().NoError(err)
+		// 5 uusd is 20 of the fee denom, spread over 10 * 2,000 gas.
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.001"), params.MinBaseGasPrice)
+	})
+
+	s.Run("invalid inputs are rejected", func() {
+		_, err := s.feeMarketKeeper.ParamsForTargetCost(s.ctx, sdk.NewInt64DecCoin(types.DefaultFeeDenom, 0), 100, 100_000)
+		s.Require().Error(err)
+
+		_, err = s.feeMarketKeeper.ParamsForTargetCost(s.ctx, sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), 0, 100_000)
+		s.Require().Error(err)
+
+		_, err = s.feeMarketKeeper.ParamsForTargetCost(s.ctx, sdk.NewInt64DecCoin(types.DefaultFeeDenom, 1), 100, 0)
+		s.Require().Error(err)
+	})
+
+	s.Run("a failed conversion is returned", func() {
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+		s.feeMarketKeeper.SetDenomResolver(&types.ErrorDenomResolver{})
+
+		_, err := s.feeMarketKeeper.ParamsForTargetCost(s.ctx, sdk.NewInt64DecCoin("uusd", 5), 10, 2_000)
+		s.Require().Error(err)
+	})
+}
@@ -0,0 +1,67 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// RegisterInvariants registers all feemarket invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
+	ir.RegisterRoute(types.ModuleName, "base-gas-price", BaseGasPriceInvariant(k))
+}
+
+// BaseGasPriceInvariant checks that the base gas price is at or above the min base gas
+// price, so that blocks are never priced below the floor, and that the learning rate is
+// within the learning rate bounds of the params while the fee market is enabled. The price of a
+// disabled fee market is frozen, but never below the floor.
+func BaseGasPriceInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params, err := k.GetParams(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(
+				types.ModuleName, "base-gas-price",
+				fmt.Sprintf("unable to get params: %s", err),
+			), true
+		}
+
+		state, err := k.GetState(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(
+				types.ModuleName, "base-gas-price",
+				fmt.Sprintf("unable to get state: %s", err),
+			), true
+		}
+
+		var (
+			msg    string
+			broken bool
+		)
+
+		if state.BaseGasPrice.IsNil() || state.BaseGasPrice.LT(params.MinBaseGasPrice) {
+			broken = true
+			msg += fmt.Sprintf(
+				"\tbase gas price %s is below the min base gas price %s\n",
+				state.BaseGasPrice, params.MinBaseGasPrice,
+			)
+		}
+
+		// A disabled fee market keeps its learning rate frozen, so bounds changed since it was
+		// disabled need not hold.
+		if state.LearningRate.IsNil() || (params.Enabled &&
+			(state.LearningRate.LT(params.MinLearningRate) || state.LearningRate.GT(params.MaxLearningRate))) {
+			broken = true
+			msg += fmt.Sprintf(
+				"\tlearning rate %s is outside of the learning rate bounds [%s, %s]\n",
+				state.LearningRate, params.MinLearningRate, params.MaxLearningRate,
+			)
+		}
+
+		return sdk.FormatInvariant(
+			types.ModuleName, "base-gas-price",
+			fmt.Sprintf("base gas price and learning rate within bounds\n%s", msg),
+		), broken
+	}
+}
@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// invariantRegistry records the invariants registered with it.
+type invariantRegistry map[string]sdk.Invariant
+
+func (r invariantRegistry) RegisterRoute(moduleName, route string, invar sdk.Invariant) {
+	r[moduleName+"/"+route] = invar
+}
+
+func (s *KeeperTestSuite) TestBaseGasPriceInvariant() {
+	invariant := keeper.BaseGasPriceInvariant(s.feeMarketKeeper)
+
+	s.Run("holds for the default genesis states", func() {
+		for _, gs := range []*types.GenesisState{types.DefaultGenesisState(), types.DefaultAIMDGenesisState()} {
+			s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+			msg, broken := invariant(s.ctx)
+			s.Require().False(broken, msg)
+		}
+	})
+
+	s.Run("holds after the fee market updates at zero utilization", func() {
+		gs := types.DefaultAIMDGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		for i := 0; i < 20; i++ {
+			s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+			msg, broken := invariant(s.ctx)
+			s.Require().False(broken, msg)
+		}
+	})
+
+	s.Run("breaks if the base gas price is below the min base gas price", func() {
+		gs := types.DefaultGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		state.BaseGasPrice = gs.Params.MinBaseGasPrice.Sub(math.LegacyNewDecWithPrec(1, 18))
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		msg, broken := invariant(s.ctx)
+		s.Require().True(broken)
+		s.Require().Contains(msg, state.BaseGasPrice.String())
+		s.Require().Contains(msg, gs.Params.MinBaseGasPrice.String())
+	})
+
+	s.Run("breaks if the learning rate is out of bounds", func() {
+		gs := types.DefaultAIMDGenesisState()
+
+		for _, lr := range []math.LegacyDec{
+			gs.Params.MinLearningRate.Sub(math.LegacyNewDecWithPrec(1, 18)),
+			gs.Params.MaxLearningRate.Add(math.LegacyNewDecWithPrec(1, 18)),
+		} {
+			s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+			state, err := s.feeMarketKeeper.GetState(s.ctx)
+			s.Require().NoError(err)
+			state.LearningRate = lr
+			s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+			msg, broken := invariant(s.ctx)
+			s.Require().True(broken)
+			s.Require().Contains(msg, lr.String())
+			s.Require().Contains(msg, gs.Params.MinLearningRate.String())
+			s.Require().Contains(msg, gs.Params.MaxLearningRate.String())
+		}
+	})
+
+	s.Run("is registered under the module route", func() {
+		gs := types.DefaultGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		registry := invariantRegistry{}
+		keeper.RegisterInvariants(registry, s.feeMarketKeeper)
+
+		registered, ok := registry[types.ModuleName+"/base-gas-price"]
+		s.Require().True(ok)
+
+		msg, broken := registered(s.ctx)
+		s.Require().False(broken, msg)
+	})
+}
@@ -1,14 +1,17 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
 	"strconv"
 
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/trace"
 
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
@@ -20,6 +23,68 @@ type Keeper struct {
 	ak       types.AccountKeeper
 	resolver types.DenomResolver
 
+	// denomResolvers are the denom resolvers that can be selected with MsgSetResolver,
+	// by name. They are registered while wiring the app and only read afterwards.
+	denomResolvers map[string]types.DenomResolver
+
+	// bank is an optional keeper used to distribute the fees accumulated over a
+	// distribution epoch.
+	bank types.BankKeeper
+
+	// metadata is an optional keeper used to look up denom metadata when
+	// formatting gas prices.
+	metadata types.DenomMetadataKeeper
+
+	// distribution is an optional keeper used to look up the community pool when
+	// pricing fees paid from community funds, and the community tax when estimating
+	// validator fee shares.
+	distribution types.DistributionKeeper
+
+	// staking is an optional keeper used to look up validators when estimating
+	// their share of the fee revenue.
+	staking types.StakingKeeper
+
+	// relayFeeEstimator is an optional estimator used to price the IBC relay legs
+	// of cross-chain operations.
+	relayFeeEstimator types.RelayFeeEstimator
+
+	// tracer is an optional OpenTelemetry tracer used to annotate the fee market
+	// update. If nil, no spans are emitted.
+	tracer trace.Tracer
+
+	// corruptStatePolicy determines how state that cannot be decoded is handled.
+	// Defaults to returning an error.
+	corruptStatePolicy types.CorruptStatePolicy
+
+	// feeExemptModuleAccounts is the set of module account addresses whose
+	// transactions are exempt from fee deduction.
+	feeExemptModuleAccounts map[string]struct{}
+
+	// structuredPriceLog determines whether each EndBlock price update is also
+	// logged as a single line of JSON at info level.
+	structuredPriceLog bool
+
+	// firstBlockAtFloor determines whether the block in which the fee market is
+	// enabled is priced at the minimum base gas price and skips the EndBlock
+	// price update.
+	firstBlockAtFloor bool
+
+	// resolverCache memoizes the denom resolver's conversion rates for the duration
+	// of a block. If nil, the default, conversions are not cached.
+	resolverCache *resolverCache
+
+	// hooks are optional hooks called after fee market updates. If nil, no hooks are
+	// called.
+	hooks types.FeeMarketHooks
+
+	// metricsCache memoizes the derived metrics for the duration of a block. If nil, the
+	// metrics are computed on every call.
+	metricsCache *metricsCache
+
+	// msgTypeMultiplierCache holds the message type multipliers for the duration of a
+	// block.
+	msgTypeMultiplierCache *msgTypeMultiplierCache
+
 	// The address that is capable of executing a MsgParams message.
 	// Typically, this will be the governance module's address.
 	authority string
@@ -43,6 +108,11 @@ func NewKeeper(
 		ak:        authKeeper,
 		resolver:  resolver,
 		authority: authority,
+
+		denomResolvers: make(map[string]types.DenomResolver),
+		metricsCache:   newMetricsCache(),
+
+		msgTypeMultiplierCache: newMsgTypeMultiplierCache(),
 	}
 
 	return k
@@ -71,6 +141,27 @@ func (k *Keeper) GetEnabledHeight(ctx sdk.Context) (int64, error) {
 	return strconv.ParseInt(string(bz), 10, 64)
 }
 
+// IsEnabled returns whether the feemarket was enabled and, if so, the height at which it was
+// enabled. A missing enabled height, or the -1 stored for a feemarket that no MsgParams
+// enabled, is reported as not enabled with a height of zero.
+func (k *Keeper) IsEnabled(ctx sdk.Context) (bool, int64, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyEnabledHeight)
+	if bz == nil {
+		return false, 0, nil
+	}
+
+	height, err := strconv.ParseInt(string(bz), 10, 64)
+	if err != nil {
+		return false, 0, err
+	}
+
+	if height < 0 {
+		return false, 0, nil
+	}
+
+	return true, height, nil
+}
+
 // SetEnabledHeight sets the height at which the feemarket was enabled.
 func (k *Keeper) SetEnabledHeight(ctx sdk.Context, height int64) {
 	store := ctx.KVStore(k.storeKey)
@@ -80,18 +171,139 @@ func (k *Keeper) SetEnabledHeight(ctx sdk.Context, height int64) {
 	store.Set(types.KeyEnabledHeight, bz)
 }
 
-// ResolveToDenom converts the given coin to the given denomination.
+// GetFrozenHeight returns the height at which the feemarket was last disabled after being
+// enabled, or -1 if it never was. A disabled feemarket with a frozen height is frozen.
+func (k *Keeper) GetFrozenHeight(ctx sdk.Context) (int64, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyFrozenHeight)
+	if bz == nil {
+		return -1, nil
+	}
+
+	return strconv.ParseInt(string(bz), 10, 64)
+}
+
+// SetFrozenHeight sets the height at which the feemarket was disabled after being enabled.
+func (k *Keeper) SetFrozenHeight(ctx sdk.Context, height int64) {
+	store := ctx.KVStore(k.storeKey)
+
+	bz := []byte(strconv.FormatInt(height, 10))
+
+	store.Set(types.KeyFrozenHeight, bz)
+}
+
+// ResolveToDenom converts the given coin to the given denomination with the denom resolver.
+// The result keeps the full precision of a LegacyDec: gas prices are never rounded to the
+// precision of their denom, so that the fee computed from a converted price is rounded only
+// once, by types.ComputeFee.
 func (k *Keeper) ResolveToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
-	if k.resolver == nil {
+	resolver, err := k.denomResolver(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	if resolver == nil {
 		return sdk.DecCoin{}, types.ErrResolverNotSet
 	}
 
-	return k.resolver.ConvertToDenom(ctx, coin, denom)
+	return resolver.ConvertToDenom(ctx, coin, denom)
 }
 
-// SetDenomResolver sets the keeper's denom resolver.
+// SetDenomResolver sets the keeper's denom resolver. Any conversion rates cached from the
+// previous resolver are dropped.
 func (k *Keeper) SetDenomResolver(resolver types.DenomResolver) {
 	k.resolver = resolver
+	if k.resolverCache != nil {
+		k.resolverCache = newResolverCache()
+	}
+}
+
+// SetResolverCache sets whether the denom resolver's conversion rates are cached for the
+// duration of a block by ResolveToDenomCached. The cache is disabled by default; only enable
+// it for resolvers that convert at a linear rate that cannot change within a block.
+func (k *Keeper) SetResolverCache(enabled bool) {
+	if !enabled {
+		k.resolverCache = nil
+		return
+	}
+
+	if k.resolverCache == nil {
+		k.resolverCache = newResolverCache()
+	}
+}
+
+// SetMetricsCache sets whether the derived metrics are cached for the duration of a block
+// by DerivedMetrics. The cache is enabled by default.
+func (k *Keeper) SetMetricsCache(enabled bool) {
+	if !enabled {
+		k.metricsCache = nil
+		return
+	}
+
+	if k.metricsCache == nil {
+		k.metricsCache = newMetricsCache()
+	}
+}
+
+// SetHooks sets the hooks called after fee market updates. Use MultiFeeMarketHooks to
+// register more than one. It panics if the hooks were already set.
+func (k *Keeper) SetHooks(hooks types.FeeMarketHooks) *Keeper {
+	if k.hooks != nil {
+		panic("cannot set feemarket hooks twice")
+	}
+
+	k.hooks = hooks
+	return k
+}
+
+// SetBankKeeper sets the keeper used to distribute the fees accumulated over a
+// distribution epoch.
+func (k *Keeper) SetBankKeeper(bank types.BankKeeper) {
+	k.bank = bank
+}
+
+// SetDenomMetadataKeeper sets the keeper used to look up denom metadata.
+func (k *Keeper) SetDenomMetadataKeeper(metadata types.DenomMetadataKeeper) {
+	k.metadata = metadata
+}
+
+// SetDistributionKeeper sets the keeper used to look up the community pool and the
+// distribution params.
+func (k *Keeper) SetDistributionKeeper(distribution types.DistributionKeeper) {
+	k.distribution = distribution
+}
+
+// SetStakingKeeper sets the keeper used to look up validators.
+func (k *Keeper) SetStakingKeeper(staking types.StakingKeeper) {
+	k.staking = staking
+}
+
+// SetRelayFeeEstimator sets the estimator used to price IBC relay legs.
+func (k *Keeper) SetRelayFeeEstimator(estimator types.RelayFeeEstimator) {
+	k.relayFeeEstimator = estimator
+}
+
+// SetTracer sets the OpenTelemetry tracer used to emit fee market spans. Passing nil
+// disables tracing.
+func (k *Keeper) SetTracer(tracer trace.Tracer) {
+	k.tracer = tracer
+}
+
+// SetStructuredPriceLog sets whether each EndBlock price update is also logged as a
+// single line of JSON at info level, for log-based alerting.
+func (k *Keeper) SetStructuredPriceLog(enabled bool) {
+	k.structuredPriceLog = enabled
+}
+
+// SetFirstBlockAtFloor sets whether the block in which the fee market is enabled is priced
+// at the minimum base gas price, with the EndBlock price update skipped until the window
+// holds a full block of data.
+func (k *Keeper) SetFirstBlockAtFloor(enabled bool) {
+	k.firstBlockAtFloor = enabled
+}
+
+// SetCorruptStatePolicy sets how GetState handles state that cannot be decoded.
+func (k *Keeper) SetCorruptStatePolicy(policy types.CorruptStatePolicy) {
+	k.corruptStatePolicy = policy
 }
 
 // GetState returns the feemarket module's state.
@@ -103,22 +315,87 @@ func (k *Keeper) GetState(ctx sdk.Context) (types.State, error) {
 
 	state := types.State{}
 	if err := state.Unmarshal(bz); err != nil {
-		return types.State{}, err
+		if k.corruptStatePolicy != types.CorruptStatePolicyReset {
+			return types.State{}, err
+		}
+
+		return k.resetCorruptState(ctx, err)
 	}
 
 	return state, nil
 }
 
-// SetState sets the feemarket module's state.
+// resetCorruptState returns a freshly-initialized state derived from the params in place
+// of state that could not be decoded, logging and emitting an event so that operators
+// are alerted.
+func (k *Keeper) resetCorruptState(ctx sdk.Context, decodeErr error) (types.State, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.State{}, fmt.Errorf("unable to reset corrupt state: %w: %w", decodeErr, err)
+	}
+
+	k.Logger(ctx).Error(
+		"fee market state is corrupt; falling back to a freshly-initialized state",
+		"height", ctx.BlockHeight(),
+		"err", decodeErr,
+	)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeStateReset,
+		sdk.NewAttribute(types.AttributeKeyError, decodeErr.Error()),
+	))
+
+	return types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate), nil
+}
+
+// SetState sets the feemarket module's state and, if telemetry is enabled, reports it as
+// gauges. The base gas price is recorded in the base gas price history, if enabled. SetState
+// does not read the previous state, as the post handler calls it for every transaction, so
+// it emits no EventUpdateState and calls no hooks; see UpdateState.
 func (k *Keeper) SetState(ctx sdk.Context, state types.State) error {
 	store := ctx.KVStore(k.storeKey)
 
-	bz, err := state.Marshal()
+	bz, err := k.MarshalState(state)
 	if err != nil {
 		return err
 	}
 
 	store.Set(types.KeyState, bz)
+	k.invalidateMetricsCache()
+	k.emitStateTelemetry(ctx, state)
+
+	return k.recordBaseGasPrice(ctx, state.BaseGasPrice)
+}
+
+// UpdateState sets the state like SetState and reports how it changed from prev, the state
+// the caller held before updating it. An EventUpdateState is emitted if the base gas price or
+// the learning rate differs from prev, so that indexers can follow them without an event for
+// every utilization update. If the base gas price changed, the hooks' AfterBaseGasPriceUpdated
+// is called once the state is written. A prev without a base gas price, e.g. at genesis, is
+// no previous state. It is used by the EndBlock update, MsgParams and InitGenesis.
+func (k *Keeper) UpdateState(ctx sdk.Context, prev, state types.State) error {
+	if err := k.SetState(ctx, state); err != nil {
+		return err
+	}
+
+	if !decsEqual(prev.BaseGasPrice, state.BaseGasPrice) || !decsEqual(prev.LearningRate, state.LearningRate) {
+		if err := ctx.EventManager().EmitTypedEvent(&types.EventUpdateState{
+			BaseGasPrice:      state.BaseGasPrice,
+			LearningRate:      state.LearningRate,
+			WindowUtilization: state.SumWindow(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	oldPrice := math.LegacyZeroDec()
+	if !prev.BaseGasPrice.IsNil() {
+		oldPrice = prev.BaseGasPrice
+	}
+
+	if k.hooks != nil && !decsEqual(oldPrice, state.BaseGasPrice) {
+		return k.hooks.AfterBaseGasPriceUpdated(ctx, oldPrice, state.BaseGasPrice)
+	}
 
 	return nil
 }
@@ -138,7 +415,10 @@ func (k *Keeper) GetParams(ctx sdk.Context) (types.Params, error) {
 	return params, nil
 }
 
-// SetParams sets the feemarket module's parameters.
+// SetParams sets the feemarket module's parameters. An EventUpdateParams carrying the module
+// authority is emitted if the params differ from the previously stored params. Changing the
+// base gas price history size keeps the records that still fit, while switching to or from
+// the compact history drops them.
 func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 	store := ctx.KVStore(k.storeKey)
 
@@ -147,7 +427,59 @@ func (k *Keeper) SetParams(ctx sdk.Context, params types.Params) error {
 		return err
 	}
 
+	prev := k.getUnmetered(ctx, types.KeyParams)
+
 	store.Set(types.KeyParams, bz)
+	k.invalidateMetricsCache()
 
-	return nil
+	if err := k.resizeBaseGasPriceHistory(ctx, prev, params); err != nil {
+		return err
+	}
+
+	if prev != nil && bytes.Equal(prev, bz) {
+		return nil
+	}
+
+	return ctx.EventManager().EmitTypedEvent(&types.EventUpdateParams{
+		Authority: k.authority,
+		Params:    params,
+	})
+}
+
+// decsEqual returns true if both decs are nil or both are equal.
+func decsEqual(a, b math.LegacyDec) bool {
+	if a.IsNil() || b.IsNil() {
+		return a.IsNil() && b.IsNil()
+	}
+
+	return a.Equal(b)
+}
+
+// getUnmetered returns the value stored under the given key without charging gas. This is
+// used to compare against the previous value when emitting change events, which should not
+// change the gas cost of the writes.
+func (k *Keeper) getUnmetered(ctx sdk.Context, key []byte) []byte {
+	return ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).KVStore(k.storeKey).Get(key)
+}
+
+// ConfigFingerprint returns a hash over the params, the keeper options that change how
+// fees are priced or charged and the compiled algorithm version. Operators can compare
+// fingerprints to verify that all nodes agree on the fee market configuration.
+func (k *Keeper) ConfigFingerprint(ctx sdk.Context) ([]byte, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exempt := make([]sdk.AccAddress, 0, len(k.feeExemptModuleAccounts))
+	for addr := range k.feeExemptModuleAccounts {
+		exempt = append(exempt, sdk.AccAddress(addr))
+	}
+
+	return types.ComputeConfigFingerprint(types.AlgorithmVersion, params, types.ConfigFlags{
+		CorruptStatePolicy:      k.corruptStatePolicy,
+		FirstBlockAtFloor:       k.firstBlockAtFloor,
+		ResolverCache:           k.resolverCache != nil,
+		FeeExemptModuleAccounts: exempt,
+	})
 }
@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	txsigning "cosmossdk.io/x/tx/signing"
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -108,6 +109,11 @@ func (s *KeeperTestSuite) TestParams() {
 			MaxBlockUtilization: 10,
 			Window:              1,
 			Enabled:             true,
+
+			EffectiveMinLearningRate: math.LegacyZeroDec(),
+			TargetDeadBand:           math.LegacyZeroDec(),
+			MaxRateChangePerBlock:    math.LegacyZeroDec(),
+			BurnFraction:             math.LegacyZeroDec(),
 		}
 
 		err := s.feeMarketKeeper.SetParams(s.ctx, params)
@@ -120,6 +126,102 @@ func (s *KeeperTestSuite) TestParams() {
 	})
 }
 
+func (s *KeeperTestSuite) TestChangeEvents() {
+	typedEvents := func(ctx sdk.Context) []proto.Message {
+		var msgs []proto.Message
+		for _, event := range ctx.EventManager().ABCIEvents() {
+			msg, err := sdk.ParseTypedEvent(event)
+			s.Require().NoError(err)
+			msgs = append(msgs, msg)
+		}
+		return msgs
+	}
+
+	s.Run("state updates emit an event only when the price or learning rate changes", func() {
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+
+		// Recording utilization alone is not a change.
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		prev := state
+		state.Window[state.Index] = 100
+		s.Require().NoError(s.feeMarketKeeper.UpdateState(ctx, prev, state))
+		s.Require().Empty(typedEvents(ctx))
+
+		ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		state.BaseGasPrice = state.BaseGasPrice.MulInt64(2)
+		s.Require().NoError(s.feeMarketKeeper.UpdateState(ctx, prev, state))
+		s.Require().Equal([]proto.Message{&types.EventUpdateState{
+			BaseGasPrice:      state.BaseGasPrice,
+			LearningRate:      state.LearningRate,
+			WindowUtilization: 100,
+		}}, typedEvents(ctx))
+
+		// The per-transaction SetState does not compare, so it never emits.
+		ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		state.BaseGasPrice = state.BaseGasPrice.MulInt64(2)
+		s.Require().NoError(s.feeMarketKeeper.SetState(ctx, state))
+		s.Require().Empty(typedEvents(ctx))
+	})
+
+	s.Run("the end block update emits an event when the price changes", func() {
+		params, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		state.Window[state.Index] = params.MaxBlockUtilization
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+
+		got, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().False(got.BaseGasPrice.Equal(state.BaseGasPrice))
+		s.Require().Equal([]proto.Message{&types.EventUpdateState{
+			BaseGasPrice:      got.BaseGasPrice,
+			LearningRate:      got.LearningRate,
+			WindowUtilization: got.SumWindow(),
+		}}, typedEvents(ctx))
+	})
+
+	s.Run("param updates emit an event only when the params change", func() {
+		params, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+
+		ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+		s.Require().NoError(s.feeMarketKeeper.SetParams(ctx, params))
+		s.Require().Empty(typedEvents(ctx))
+
+		ctx = s.ctx.WithEventManager(sdk.NewEventManager())
+		params.Window = 16
+		s.Require().NoError(s.feeMarketKeeper.SetParams(ctx, params))
+		events := typedEvents(ctx)
+		s.Require().Len(events, 1)
+		update, ok := events[0].(*types.EventUpdateParams)
+		s.Require().True(ok)
+		s.Require().Equal(s.feeMarketKeeper.GetAuthority(), update.Authority)
+
+		applied, err := types.ApplyParamEvent(types.Params{}, *update)
+		s.Require().NoError(err)
+		s.Require().Equal(params, applied)
+	})
+
+	s.Run("comparing against the previous value charges no gas", func() {
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+
+		ctx := s.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		s.Require().NoError(s.feeMarketKeeper.UpdateState(ctx, state, state))
+
+		bz, err := state.Marshal()
+		s.Require().NoError(err)
+		gasConfig := storetypes.KVGasConfig()
+		expected := gasConfig.WriteCostFlat + gasConfig.WriteCostPerByte*storetypes.Gas(len(types.KeyState)+len(bz))
+		s.Require().Equal(expected, ctx.GasMeter().GasConsumed())
+	})
+}
+
 func (s *KeeperTestSuite) TestEnabledHeight() {
 	s.Run("get and set values", func() {
 		s.feeMarketKeeper.SetEnabledHeight(s.ctx, 10)
@@ -128,6 +230,37 @@ func (s *KeeperTestSuite) TestEnabledHeight() {
 		s.Require().NoError(err)
 		s.Require().Equal(int64(10), got)
 	})
+
+	s.Run("is enabled at a stored non-negative height", func() {
+		for _, height := range []int64{0, 10} {
+			s.feeMarketKeeper.SetEnabledHeight(s.ctx, height)
+
+			enabled, got, err := s.feeMarketKeeper.IsEnabled(s.ctx)
+			s.Require().NoError(err)
+			s.Require().True(enabled)
+			s.Require().Equal(height, got)
+		}
+	})
+
+	s.Run("is not enabled if never enabled", func() {
+		s.feeMarketKeeper.SetEnabledHeight(s.ctx, -1)
+
+		enabled, got, err := s.feeMarketKeeper.IsEnabled(s.ctx)
+		s.Require().NoError(err)
+		s.Require().False(enabled)
+		s.Require().Zero(got)
+
+		s.feeMarketKeeper.DeleteEnabledHeight(s.ctx)
+
+		enabled, got, err = s.feeMarketKeeper.IsEnabled(s.ctx)
+		s.Require().NoError(err)
+		s.Require().False(enabled)
+		s.Require().Zero(got)
+
+		height, err := s.feeMarketKeeper.GetEnabledHeight(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(int64(-1), height)
+	})
 }
 
 // TestEncodingConfig specifies the concrete encoding types to use for a given app.
@@ -183,3 +316,98 @@ func InterfaceRegistry() codectypes.InterfaceRegistry {
 
 	return interfaceRegistry
 }
+
+func (s *KeeperTestSuite) TestConfigFingerprint() {
+	base, err := s.feeMarketKeeper.ConfigFingerprint(s.ctx)
+	s.Require().NoError(err)
+
+	s.Run("changes when the params change", func() {
+		params, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		params.Alpha = params.Alpha.Add(math.LegacyMustNewDecFromStr("0.01"))
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+		defer func() {
+			s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, types.DefaultParams()))
+		}()
+
+		fingerprint, err := s.feeMarketKeeper.ConfigFingerprint(s.ctx)
+		s.Require().NoError(err)
+		s.Require().NotEqual(base, fingerprint)
+	})
+
+	s.Run("changes when a mode flag changes", func() {
+		s.feeMarketKeeper.SetCorruptStatePolicy(types.CorruptStatePolicyReset)
+		defer s.feeMarketKeeper.SetCorruptStatePolicy(types.CorruptStatePolicyStrict)
+
+		fingerprint, err := s.feeMarketKeeper.ConfigFingerprint(s.ctx)
+		s.Require().NoError(err)
+		s.Require().NotEqual(base, fingerprint)
+	})
+
+	s.Run("changes when a fee exempt module account is added", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetFeeExemptModuleAccounts(types.ModuleName))
+		defer func() { s.Require().NoError(s.feeMarketKeeper.SetFeeExemptModuleAccounts()) }()
+
+		fingerprint, err := s.feeMarketKeeper.ConfigFingerprint(s.ctx)
+		s.Require().NoError(err)
+		s.Require().NotEqual(base, fingerprint)
+	})
+
+	s.Run("changes when first block pricing is enabled", func() {
+		s.feeMarketKeeper.SetFirstBlockAtFloor(true)
+		defer s.feeMarketKeeper.SetFirstBlockAtFloor(false)
+
+		fingerprint, err := s.feeMarketKeeper.ConfigFingerprint(s.ctx)
+		s.Require().NoError(err)
+		s.Require().NotEqual(base, fingerprint)
+	})
+
+	s.Run("is unchanged for the same configuration", func() {
+		fingerprint, err := s.feeMarketKeeper.ConfigFingerprint(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(base, fingerprint)
+	})
+}
+
+func BenchmarkStateRoundTrip(b *testing.B) {
+	params := types.DefaultAIMDParams()
+	params.Window = 100_000
+	state := types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
+	for i := range state.Window {
+		state.Window[i] = params.TargetBlockUtilization()
+	}
+
+	b.Run("marshal and unmarshal", func(b *testing.B) {
+		b.ReportAllocs()
+		for i := 0; i < b.N; i++ {
+			bz, err := state.Marshal()
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			var got types.State
+			if err := got.Unmarshal(bz); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+
+	b.Run("set and get", func(b *testing.B) {
+		ctx, tk, _ := testkeeper.NewTestSetup(b)
+		k := tk.FeeMarketKeeper
+		k.InitGenesis(ctx, *types.NewGenesisState(params, state))
+
+		b.ReportAllocs()
+		b.ResetTimer()
+		for i := 0; i < b.N; i++ {
+			got, err := k.GetState(ctx)
+			if err != nil {
+				b.Fatal(err)
+			}
+
+			if err := k.SetState(ctx, got); err != nil {
+				b.Fatal(err)
+			}
+		}
+	})
+}
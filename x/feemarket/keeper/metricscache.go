@@ -0,0 +1,135 @@
+package keeper
+
+import (
+	"sync"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// metricsCache memoizes the derived metrics for the duration of a block. The metrics are
+// kept for the height and kind of context they were computed in, see metricsCacheKey, and
+// dropped as soon as a different one is seen. They are also dropped whenever the params,
+// state or observations they are derived from are written, so that a block's EndBlock
+// update is never served stale.
+type metricsCache struct {
+	mu      sync.RWMutex
+	key     metricsCacheKey
+	metrics *types.DerivedMetrics
+}
+
+// metricsCacheKey identifies the store the metrics were derived from. Queries run against
+// the committed state in a context that is flagged as CheckTx, so they are told apart from
+// CheckTx itself, which runs against the mempool state, by having no tx bytes.
+type metricsCacheKey struct {
+	height    int64
+	execMode  sdk.ExecMode
+	isCheckTx bool
+	isQuery   bool
+}
+
+func newMetricsCacheKey(ctx sdk.Context) metricsCacheKey {
+	return metricsCacheKey{
+		height:    ctx.BlockHeight(),
+		execMode:  ctx.ExecMode(),
+		isCheckTx: ctx.IsCheckTx(),
+		isQuery:   ctx.IsCheckTx() && len(ctx.TxBytes()) == 0,
+	}
+}
+
+func newMetricsCache() *metricsCache {
+	return &metricsCache{}
+}
+
+// get returns a copy of the metrics cached for the context's key, if any. Concurrent gets
+// only take the read lock.
+func (c *metricsCache) get(ctx sdk.Context) (types.DerivedMetrics, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	if c.metrics == nil || c.key != newMetricsCacheKey(ctx) {
+		return types.DerivedMetrics{}, false
+	}
+
+	return c.metrics.Clone(), true
+}
+
+// set caches a copy of the metrics for the context's key.
+func (c *metricsCache) set(ctx sdk.Context, metrics types.DerivedMetrics) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	cached := metrics.Clone()
+	c.key = newMetricsCacheKey(ctx)
+	c.metrics = &cached
+}
+
+// invalidate drops the cached metrics.
+func (c *metricsCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.metrics = nil
+}
+
+// invalidateMetricsCache drops the cached derived metrics, if the cache is enabled. It must
+// be called by every write to the params, state or observations.
+func (k *Keeper) invalidateMetricsCache() {
+	if k.metricsCache != nil {
+		k.metricsCache.invalidate()
+	}
+}
+
+// DerivedMetrics returns the average utilization, base gas price trend and congestion
+// multiplier at the current height. The metrics are computed once per block and served from
+// the cache for the rest of it, so that query-heavy nodes do not recompute them for every
+// query. If the cache is disabled with SetMetricsCache, the metrics are computed on every call.
+func (k *Keeper) DerivedMetrics(ctx sdk.Context) (types.DerivedMetrics, error) {
+	if k.metricsCache != nil {
+		if metrics, ok := k.metricsCache.get(ctx); ok {
+			return metrics, nil
+		}
+	}
+
+	metrics, err := k.computeDerivedMetrics(ctx)
+	if err != nil {
+		return types.DerivedMetrics{}, err
+	}
+
+	if k.metricsCache != nil {
+		k.metricsCache.set(ctx, metrics)
+	}
+
+	return metrics, nil
+}
+
+// computeDerivedMetrics computes the derived metrics from the store.
+func (k *Keeper) computeDerivedMetrics(ctx sdk.Context) (types.DerivedMetrics, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.DerivedMetrics{}, err
+	}
+
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return types.DerivedMetrics{}, err
+	}
+
+	observations, err := k.GetObservations(ctx)
+	if err != nil {
+		return types.DerivedMetrics{}, err
+	}
+
+	trend := math.LegacyZeroDec()
+	if changes := priceChanges(observations); len(changes) > 0 {
+		trend = meanDec(changes)
+	}
+
+	return types.DerivedMetrics{
+		AverageUtilization:   state.GetAverageUtilization(params),
+		Trend:                trend,
+		CongestionMultiplier: params.CongestionMultiplier(state.BaseGasPrice),
+	}, nil
+}
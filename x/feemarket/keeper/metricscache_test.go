@@ -0,0 +1,176 @@
+package keeper_test
+
+import (
+	"sync"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/skip-mev/feemarket/testutils/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestDerivedMetrics() {
+	params := types.DefaultParams()
+	params.MinBaseGasPrice = math.LegacyOneDec()
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	state := types.NewState(params.Window, math.LegacyNewDec(2), params.MinLearningRate)
+	s.Require().NoError(state.Update(params.MaxBlockUtilization/2, params))
+	s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+	for height, price := range []int64{4, 5} {
+		ctx := s.ctx.WithBlockHeight(int64(height + 1))
+		s.Require().NoError(s.feeMarketKeeper.RecordObservation(ctx, math.LegacyNewDec(price), 0))
+	}
+
+	// rawState writes the state without going through SetState, so that the cache is not
+	// invalidated.
+	rawState := func(ctx sdk.Context, baseGasPrice math.LegacyDec) {
+		updated := state
+		updated.BaseGasPrice = baseGasPrice
+		bz, err := updated.Marshal()
+		s.Require().NoError(err)
+		s.feeMarketKeeper.SetRawState(ctx, bz)
+	}
+
+	s.Run("metrics are derived from the params, state and observations", func() {
+		metrics, err := s.feeMarketKeeper.DerivedMetrics(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state.GetAverageUtilization(params), metrics.AverageUtilization)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.25"), metrics.Trend)
+		s.Require().Equal(math.LegacyNewDec(2), metrics.CongestionMultiplier)
+	})
+
+	s.Run("metrics are cached until the height changes", func() {
+		ctx := s.ctx.WithBlockHeight(10).WithExecMode(sdk.ExecModeCheck)
+		_, err := s.feeMarketKeeper.DerivedMetrics(ctx)
+		s.Require().NoError(err)
+
+		rawState(ctx, math.LegacyNewDec(3))
+		metrics, err := s.feeMarketKeeper.DerivedMetrics(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(2), metrics.CongestionMultiplier)
+
+		metrics, err = s.feeMarketKeeper.DerivedMetrics(ctx.WithBlockHeight(11))
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(3), metrics.CongestionMultiplier)
+
+		metrics, err = s.feeMarketKeeper.DerivedMetrics(ctx.WithBlockHeight(11).WithExecMode(sdk.ExecModeFinalize))
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(3), metrics.CongestionMultiplier)
+	})
+
+	s.Run("queries are not served the metrics cached by CheckTx", func() {
+		// both are flagged as CheckTx, but only CheckTx carries the tx bytes
+		checkCtx := s.ctx.WithBlockHeight(15).WithIsCheckTx(true).WithTxBytes([]byte("tx"))
+		queryCtx := s.ctx.WithBlockHeight(15).WithIsCheckTx(true)
+
+		rawState(checkCtx, math.LegacyNewDec(2))
+		_, err := s.feeMarketKeeper.DerivedMetrics(checkCtx)
+		s.Require().NoError(err)
+
+		rawState(queryCtx, math.LegacyNewDec(3))
+		metrics, err := s.feeMarketKeeper.DerivedMetrics(queryCtx)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(3), metrics.CongestionMultiplier)
+	})
+
+	s.Run("writing the state invalidates the cache", func() {
+		ctx := s.ctx.WithBlockHeight(20)
+		_, err := s.feeMarketKeeper.DerivedMetrics(ctx)
+		s.Require().NoError(err)
+
+		updated := state
+		updated.BaseGasPrice = math.LegacyNewDec(4)
+		s.Require().NoError(s.feeMarketKeeper.SetState(ctx, updated))
+
+		metrics, err := s.feeMarketKeeper.DerivedMetrics(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(4), metrics.CongestionMultiplier)
+	})
+
+	s.Run("cached metrics cannot be mutated by callers", func() {
+		ctx := s.ctx.WithBlockHeight(30)
+		metrics, err := s.feeMarketKeeper.DerivedMetrics(ctx)
+		s.Require().NoError(err)
+		expected := metrics.CongestionMultiplier.Clone()
+
+		metrics.CongestionMultiplier.MulInt64Mut(10)
+
+		metrics, err = s.feeMarketKeeper.DerivedMetrics(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, metrics.CongestionMultiplier)
+	})
+
+	s.Run("parallel queries see the same metrics", func() {
+		ctx := s.ctx.WithBlockHeight(40)
+		expected, err := s.feeMarketKeeper.DerivedMetrics(ctx)
+		s.Require().NoError(err)
+
+		var wg sync.WaitGroup
+		results := make([]types.DerivedMetrics, 16)
+		errs := make([]error, len(results))
+		for i := range results {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				results[i], errs[i] = s.feeMarketKeeper.DerivedMetrics(ctx)
+			}(i)
+		}
+		wg.Wait()
+
+		for i := range results {
+			s.Require().NoError(errs[i])
+			s.Require().Equal(expected, results[i])
+		}
+	})
+
+	s.Run("a disabled cache computes the metrics on every call", func() {
+		s.feeMarketKeeper.SetMetricsCache(false)
+		defer s.feeMarketKeeper.SetMetricsCache(true)
+
+		ctx := s.ctx.WithBlockHeight(50)
+		_, err := s.feeMarketKeeper.DerivedMetrics(ctx)
+		s.Require().NoError(err)
+
+		rawState(ctx, math.LegacyNewDec(5))
+		metrics, err := s.feeMarketKeeper.DerivedMetrics(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(5), metrics.CongestionMultiplier)
+	})
+}
+
+func BenchmarkDerivedMetrics(b *testing.B) {
+	for _, tc := range []struct {
+		name   string
+		cached bool
+	}{
+		{name: "uncached", cached: false},
+		{name: "cached", cached: true},
+	} {
+		b.Run(tc.name, func(b *testing.B) {
+			ctx, tk, _ := testkeeper.NewTestSetup(b)
+			k := tk.FeeMarketKeeper
+			k.SetMetricsCache(tc.cached)
+
+			params := types.DefaultAIMDParams()
+			params.Window = 1_000
+			state := types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
+			k.InitGenesis(ctx, *types.NewGenesisState(params, state))
+			for i := int64(0); i < 100; i++ {
+				if err := k.RecordObservation(ctx.WithBlockHeight(i+1), params.MinBaseGasPrice.MulInt64(i+1), 0); err != nil {
+					b.Fatal(err)
+				}
+			}
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := k.DerivedMetrics(ctx); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,75 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 migrates the fee market state from the legacy format without a window to the
+// current format. See MigrateLegacyState.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.MigrateLegacyState(ctx)
+}
+
+// MigrateLegacyState migrates a state stored in the legacy format, which had no window, to the
+// current format. The legacy format shares the base gas price and learning rate fields of the
+// current one, so a legacy state decodes into a state with an empty window, which would make
+// the fee market behave as if the chain was idle. The migrated state keeps the legacy base gas
+// price and learning rate, which must be positive, and has a zeroed window sized to the current
+// params. A state that already has a window may predate the running window sum, which then
+// decodes as zero, so its window sum is recomputed from the window. No state at all is left
+// untouched, as are the params.
+func (k *Keeper) MigrateLegacyState(ctx sdk.Context) error {
+	bz := ctx.KVStore(k.storeKey).Get(types.KeyState)
+	if bz == nil {
+		return nil
+	}
+
+	var legacy types.State
+	if err := legacy.Unmarshal(bz); err != nil {
+		return fmt.Errorf("unable to decode legacy state: %w", err)
+	}
+
+	if len(legacy.Window) > 0 {
+		if !legacy.ReconcileWindowSum() {
+			return nil
+		}
+
+		k.Logger(ctx).Info(
+			"migrated the fee market state to a running window sum",
+			"window_sum", legacy.WindowSum,
+		)
+
+		return k.SetState(ctx, legacy)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	state := types.NewState(params.Window, legacy.BaseGasPrice, legacy.LearningRate)
+	if err := state.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid legacy state: %w", err)
+	}
+
+	k.Logger(ctx).Info(
+		"migrated the fee market state from the legacy format without a window",
+		"window", params.Window,
+	)
+
+	return k.SetState(ctx, state)
+}
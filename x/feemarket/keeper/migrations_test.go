@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestMigrateLegacyState() {
+	params := types.DefaultAIMDParams()
+	migrator := keeper.NewMigrator(s.feeMarketKeeper)
+
+	s.Run("synthesizes a zeroed window for a legacy state", func() {
+		s.setGenesisState(params, types.DefaultAIMDState())
+
+		// The legacy format only had the base gas price and learning rate fields.
+		baseGasPrice := params.MinBaseGasPrice.MulInt64(3)
+		learningRate := math.LegacyMustNewDecFromStr("0.2")
+		legacy := types.State{BaseGasPrice: baseGasPrice, LearningRate: learningRate}
+		bz, err := legacy.Marshal()
+		s.Require().NoError(err)
+		s.feeMarketKeeper.SetRawState(s.ctx, bz)
+
+		s.Require().NoError(migrator.Migrate1to2(s.ctx))
+
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(types.NewState(params.Window, baseGasPrice, learningRate), state)
+
+		migratedParams, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params, migratedParams)
+
+		s.Require().NoError(types.NewGenesisState(migratedParams, state).ValidateBasic())
+	})
+
+	s.Run("leaves a state in the current format untouched", func() {
+		state := types.DefaultAIMDState()
+		state.Window[0] = params.TargetBlockUtilization()
+		state.ReconcileWindowSum()
+		s.setGenesisState(params, state)
+
+		s.Require().NoError(migrator.Migrate1to2(s.ctx))
+
+		migrated, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state, migrated)
+	})
+
+	s.Run("recomputes the window sum of a state that predates it", func() {
+		s.setGenesisState(params, types.DefaultAIMDState())
+
+		// A state with a window written before the running window sum existed decodes with a
+		// zero window sum.
+		state := types.DefaultAIMDState()
+		state.Window[0] = params.TargetBlockUtilization()
+		state.Window[1] = params.MaxBlockUtilization
+		state.Index = 2
+		bz, err := state.Marshal()
+		s.Require().NoError(err)
+		s.feeMarketKeeper.SetRawState(s.ctx, bz)
+
+		s.Require().NoError(migrator.Migrate1to2(s.ctx))
+
+		migrated, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params.TargetBlockUtilization()+params.MaxBlockUtilization, migrated.WindowSum)
+		s.Require().Equal(migrated.SumWindow(), migrated.WindowSum)
+
+		state.ReconcileWindowSum()
+		s.Require().Equal(state, migrated)
+	})
+
+	s.Run("errors on a legacy state without a base gas price", func() {
+		s.setGenesisState(params, types.DefaultAIMDState())
+
+		legacy := types.State{LearningRate: params.MinLearningRate}
+		bz, err := legacy.Marshal()
+		s.Require().NoError(err)
+		s.feeMarketKeeper.SetRawState(s.ctx, bz)
+
+		s.Require().Error(migrator.Migrate1to2(s.ctx))
+	})
+}
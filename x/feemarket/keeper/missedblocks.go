@@ -0,0 +1,79 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ReconcileMissedBlocks pads the fee market window for blocks at the given heights whose
+// EndBlock did not run, e.g. because the module was not wired into EndBlock at the time.
+// Each missed block is recorded at the target block utilization, which is neutral for the
+// controller, so the window keeps covering the last Window blocks without the missed blocks
+// being read as empty ones that would pull the average down. The utilization recorded so
+// far for the current block is kept, and the base gas price and learning rate are left
+// unchanged.
+//
+// Duplicate heights are counted once. Every height must be positive and below the current
+// block height. Missing more blocks than fit in the window is equivalent to missing the
+// window length, since every entry is padded.
+func (k *Keeper) ReconcileMissedBlocks(ctx sdk.Context, missedHeights []int64) error {
+	seen := make(map[int64]struct{}, len(missedHeights))
+	heights := make([]int64, 0, len(missedHeights))
+	for _, height := range missedHeights {
+		if height <= 0 || height >= ctx.BlockHeight() {
+			return fmt.Errorf("missed height %d must be positive and below the current height %d", height, ctx.BlockHeight())
+		}
+
+		if _, ok := seen[height]; ok {
+			continue
+		}
+		seen[height] = struct{}{}
+		heights = append(heights, height)
+	}
+
+	if len(heights) == 0 {
+		return nil
+	}
+	sort.Slice(heights, func(i, j int) bool { return heights[i] < heights[j] })
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(state.Window) == 0 {
+		return fmt.Errorf("fee market window is empty")
+	}
+
+	missed := uint64(len(heights))
+	if missed > uint64(len(state.Window)) {
+		missed = uint64(len(state.Window))
+	}
+
+	// The missed blocks precede the current block, so they take the current slot and the
+	// current block's utilization moves to the slot after them.
+	current := state.Window[state.Index]
+	target := params.TargetBlockUtilization()
+	for i := uint64(0); i < missed; i++ {
+		state.SetCurrentUtilization(target)
+		state.IncrementHeight()
+	}
+	state.SetCurrentUtilization(current)
+
+	k.Logger(ctx).Info(
+		"reconciled fee market window after missed blocks",
+		"missed", len(heights),
+		"first_missed_height", heights[0],
+		"last_missed_height", heights[len(heights)-1],
+		"padded_utilization", target,
+	)
+
+	return k.SetState(ctx, state)
+}
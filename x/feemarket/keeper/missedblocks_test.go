@@ -0,0 +1,107 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestReconcileMissedBlocks() {
+	// The current block, at index 2, has used 100 gas so far. Every other entry is empty, as
+	// it would be on a chain that did not run EndBlock for a while.
+	setup := func() (types.Params, types.State) {
+		gs := types.DefaultAIMDGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		params, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+
+		state.Index = 2
+		state.SetCurrentUtilization(100)
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		return params, state
+	}
+
+	s.Run("no missed blocks leaves the window untouched", func() {
+		_, expected := setup()
+		ctx := s.ctx.WithBlockHeight(104)
+
+		s.Require().NoError(s.feeMarketKeeper.ReconcileMissedBlocks(ctx, nil))
+
+		state, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, state)
+	})
+
+	s.Run("pads missed blocks at the target utilization", func() {
+		params, expected := setup()
+		ctx := s.ctx.WithBlockHeight(104)
+		target := params.TargetBlockUtilization()
+
+		// Blocks 101, 102 and 103 were missed, and are given in no particular order.
+		s.Require().NoError(s.feeMarketKeeper.ReconcileMissedBlocks(ctx, []int64{103, 101, 102, 101}))
+
+		state, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().Len(state.Window, int(params.Window))
+		s.Require().Equal(uint64(5), state.Index)
+		s.Require().Equal([]uint64{0, 0, target, target, target, 100, 0, 0}, state.Window)
+		s.Require().Equal(state.SumWindow(), state.WindowSum)
+		s.Require().Equal(expected.BaseGasPrice, state.BaseGasPrice)
+		s.Require().Equal(expected.LearningRate, state.LearningRate)
+
+		// The padding raises the average towards the target, where advancing over empty
+		// blocks would have left it near zero.
+		s.Require().True(state.GetAverageUtilization(params).GT(expected.GetAverageUtilization(params)))
+		s.Require().True(state.GetNetUtilization(params).GT(expected.GetNetUtilization(params)))
+	})
+
+	s.Run("more missed blocks than the window pads every entry", func() {
+		params, _ := setup()
+		ctx := s.ctx.WithBlockHeight(1000)
+		target := params.TargetBlockUtilization()
+
+		missed := make([]int64, 0, 20)
+		for height := int64(980); height < 1000; height++ {
+			missed = append(missed, height)
+		}
+		s.Require().NoError(s.feeMarketKeeper.ReconcileMissedBlocks(ctx, missed))
+
+		state, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().Len(state.Window, int(params.Window))
+		s.Require().Equal(uint64(2), state.Index)
+		s.Require().Equal([]uint64{target, target, 100, target, target, target, target, target}, state.Window)
+		s.Require().Equal(state.SumWindow(), state.WindowSum)
+
+		// Every block but the current one is at the target, so the average sits just
+		// below the target utilization.
+		sum := target*(params.Window-1) + 100
+		s.Require().Equal(sum, state.WindowSum)
+		s.Require().True(state.GetNetUtilization(params).IsNegative())
+	})
+
+	s.Run("rejects a missed height at or above the current height", func() {
+		_, expected := setup()
+		ctx := s.ctx.WithBlockHeight(104)
+
+		s.Require().Error(s.feeMarketKeeper.ReconcileMissedBlocks(ctx, []int64{103, 104}))
+
+		state, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, state)
+	})
+
+	s.Run("rejects a non-positive missed height", func() {
+		_, expected := setup()
+		ctx := s.ctx.WithBlockHeight(104)
+
+		s.Require().Error(s.feeMarketKeeper.ReconcileMissedBlocks(ctx, []int64{0}))
+
+		state, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, state)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/skip-mev/feemarket/x/feemarket/types"
@@ -30,6 +31,12 @@ func (ms MsgServer) Params(goCtx context.Context, msg *types.MsgParams) (*types.
 		return nil, fmt.Errorf("invalid authority to execute message")
 	}
 
+	// Reject invalid params, e.g. a min learning rate above the max, before anything is
+	// written so that a bad proposal leaves the state untouched.
+	if err := msg.Params.ValidateBasic(); err != nil {
+		return nil, fmt.Errorf("invalid params: %w", err)
+	}
+
 	gotParams, err := ms.k.GetParams(ctx)
 	if err != nil {
 		return nil, fmt.Errorf("error getting params: %w", err)
@@ -40,15 +47,107 @@ func (ms MsgServer) Params(goCtx context.Context, msg *types.MsgParams) (*types.
 		ms.k.SetEnabledHeight(ctx, ctx.BlockHeight())
 	}
 
+	// if going from enabled -> disabled, the fee market is frozen at the current height. The
+	// enabled height is left as is, as a fee market enabled since genesis has none.
+	if gotParams.Enabled && !msg.Params.Enabled {
+		ms.k.SetFrozenHeight(ctx, ctx.BlockHeight())
+	}
+
 	params := msg.Params
 	if err := ms.k.SetParams(ctx, params); err != nil {
 		return nil, fmt.Errorf("error setting params: %w", err)
 	}
 
+	// The state is reset only once the new params are stored, so that it is derived from the
+	// new values. Otherwise the window is resized to the new length and the base gas price and
+	// learning rate are kept, raised to the new floor and, while enabled, brought within the new
+	// learning rate bounds. A disabled fee market keeps its learning rate frozen. A reset only
+	// reads the previous state for the hooks, so without them it is reported as a change.
+	var prev types.State
+	if !msg.ResetState || ms.k.hooks != nil {
+		prev, err = ms.k.GetState(ctx)
+		if err != nil {
+			return nil, fmt.Errorf("error getting state: %w", err)
+		}
+	}
+
 	newState := types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
-	if err := ms.k.SetState(ctx, newState); err != nil {
+	if !msg.ResetState {
+		newState = prev
+		newState.ResizeWindow(params.Window)
+		newState.BaseGasPrice = math.LegacyMaxDec(prev.BaseGasPrice, params.MinBaseGasPrice)
+		if params.Enabled {
+			newState.LearningRate = math.LegacyMinDec(math.LegacyMaxDec(prev.LearningRate, params.MinLearningRate), params.MaxLearningRate)
+		}
+	}
+	if err := ms.k.UpdateState(ctx, prev, newState); err != nil {
 		return nil, fmt.Errorf("error setting state: %w", err)
 	}
 
 	return &types.MsgParamsResponse{}, nil
 }
+
+// ResetCommunityPoolContributions defines a method that resets the cumulative community pool
+// contributions. The signer of the message must be the module authority.
+func (ms MsgServer) ResetCommunityPoolContributions(goCtx context.Context, msg *types.MsgResetCommunityPoolContributions) (*types.MsgResetCommunityPoolContributionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	if err := ms.k.ResetCommunityPoolContributions(ctx); err != nil {
+		return nil, fmt.Errorf("error resetting community pool contributions: %w", err)
+	}
+
+	return &types.MsgResetCommunityPoolContributionsResponse{}, nil
+}
+
+// SetResolver defines a method that selects the denom resolver the fee market converts fees
+// with by the name it was registered under. The signer of the message must be the module
+// authority.
+func (ms MsgServer) SetResolver(goCtx context.Context, msg *types.MsgSetResolver) (*types.MsgSetResolverResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	if err := ms.k.SetDenomResolverByName(ctx, msg.Name); err != nil {
+		return nil, fmt.Errorf("error setting denom resolver: %w", err)
+	}
+
+	return &types.MsgSetResolverResponse{}, nil
+}
+
+// SetMsgTypeMultiplier defines a method that sets the multiplier applied to the base gas price
+// for a message type. The signer of the message must be the module authority.
+func (ms MsgServer) SetMsgTypeMultiplier(goCtx context.Context, msg *types.MsgSetMsgTypeMultiplier) (*types.MsgSetMsgTypeMultiplierResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	if err := ms.k.SetMsgTypeMultiplier(ctx, msg.MsgTypeUrl, msg.Multiplier); err != nil {
+		return nil, fmt.Errorf("error setting message type multiplier: %w", err)
+	}
+
+	return &types.MsgSetMsgTypeMultiplierResponse{}, nil
+}
+
+// SetDenomMinGasPrice defines a method that sets the minimum gas price enforced for a denom.
+// The signer of the message must be the module authority.
+func (ms MsgServer) SetDenomMinGasPrice(goCtx context.Context, msg *types.MsgSetDenomMinGasPrice) (*types.MsgSetDenomMinGasPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if msg.Authority != ms.k.GetAuthority() {
+		return nil, fmt.Errorf("invalid authority to execute message")
+	}
+
+	if err := ms.k.SetDenomMinGasPrice(ctx, msg.Denom, msg.MinGasPrice); err != nil {
+		return nil, fmt.Errorf("error setting denom min gas price: %w", err)
+	}
+
+	return &types.MsgSetDenomMinGasPriceResponse{}, nil
+}
@@ -1,21 +1,99 @@
 package keeper_test
 
 import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
 func (s *KeeperTestSuite) TestMsgParams() {
-	s.Run("accepts a req with no params", func() {
+	s.Run("rejects a req with no params", func() {
 		req := &types.MsgParams{
 			Authority: s.authorityAccount.String(),
 		}
-		resp, err := s.msgServer.Params(s.ctx, req)
+		_, err := s.msgServer.Params(s.ctx, req)
+		s.Require().Error(err)
+	})
+
+	s.Run("rejects invalid params without writing to the store", func() {
+		current, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
 		s.Require().NoError(err)
-		s.Require().NotNil(resp)
 
-		params, err := s.feeMarketKeeper.GetParams(s.ctx)
+		disabled := current
+		disabled.Enabled = false
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, disabled))
+		defer func() {
+			s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, current))
+		}()
+		enabledHeight, err := s.feeMarketKeeper.GetEnabledHeight(s.ctx)
 		s.Require().NoError(err)
-		s.Require().False(params.Enabled)
+
+		testCases := []struct {
+			name   string
+			field  string
+			modify func(*types.Params)
+		}{
+			{
+				name:  "min learning rate above max learning rate",
+				field: "min learning rate",
+				modify: func(p *types.Params) {
+					p.MinLearningRate = p.MaxLearningRate.Add(math.LegacyMustNewDecFromStr("0.01"))
+				},
+			},
+			{
+				name:   "negative alpha",
+				field:  "alpha",
+				modify: func(p *types.Params) { p.Alpha = math.LegacyNewDec(-1) },
+			},
+			{
+				name:   "negative beta",
+				field:  "beta",
+				modify: func(p *types.Params) { p.Beta = math.LegacyNewDec(-1) },
+			},
+			{
+				name:   "negative gamma",
+				field:  "gamma",
+				modify: func(p *types.Params) { p.Gamma = math.LegacyNewDec(-1) },
+			},
+			{
+				name:   "negative delta",
+				field:  "delta",
+				modify: func(p *types.Params) { p.Delta = math.LegacyNewDec(-1) },
+			},
+			{
+				name:   "negative min base gas price",
+				field:  "min base gas price",
+				modify: func(p *types.Params) { p.MinBaseGasPrice = math.LegacyNewDec(-1) },
+			},
+		}
+
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				params := types.DefaultParams()
+				tc.modify(&params)
+
+				_, err := s.msgServer.Params(s.ctx.WithBlockHeight(42), &types.MsgParams{
+					Authority: s.authorityAccount.String(),
+					Params:    params,
+				})
+				s.Require().ErrorContains(err, tc.field)
+
+				gotParams, err := s.feeMarketKeeper.GetParams(s.ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(disabled, gotParams)
+
+				gotState, err := s.feeMarketKeeper.GetState(s.ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(state, gotState)
+
+				gotHeight, err := s.feeMarketKeeper.GetEnabledHeight(s.ctx)
+				s.Require().NoError(err)
+				s.Require().Equal(enabledHeight, gotHeight)
+			})
+		}
 	})
 
 	s.Run("accepts a req with params", func() {
@@ -44,6 +122,10 @@ func (s *KeeperTestSuite) TestMsgParams() {
 		s.ctx = s.ctx.WithBlockHeight(s.ctx.BlockHeight())
 		enabledParams := types.DefaultParams()
 
+		startParams := types.DefaultParams()
+		startParams.Enabled = false
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, startParams))
+
 		req := &types.MsgParams{
 			Authority: s.authorityAccount.String(),
 			Params:    enabledParams,
@@ -95,8 +177,9 @@ func (s *KeeperTestSuite) TestMsgParams() {
 
 		params.Window = 100
 		req := &types.MsgParams{
-			Authority: s.authorityAccount.String(),
-			Params:    params,
+			Authority:  s.authorityAccount.String(),
+			Params:     params,
+			ResetState: true,
 		}
 		_, err = s.msgServer.Params(s.ctx, req)
 		s.Require().NoError(err)
@@ -106,4 +189,116 @@ func (s *KeeperTestSuite) TestMsgParams() {
 		s.Require().Equal(params.Window, uint64(len(state.Window)))
 		s.Require().Equal(state.Window[0], uint64(0))
 	})
+
+	s.Run("derives the reset state from the new params", func() {
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		state.BaseGasPrice = state.BaseGasPrice.MulInt64(10)
+		state.Window[0] = 42
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		params := types.DefaultAIMDParams()
+		params.Window = 12
+		params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("2.5")
+		params.MinLearningRate = math.LegacyMustNewDecFromStr("0.02")
+		_, err = s.msgServer.Params(s.ctx, &types.MsgParams{
+			Authority:  s.authorityAccount.String(),
+			Params:     params,
+			ResetState: true,
+		})
+		s.Require().NoError(err)
+
+		state, err = s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate), state)
+	})
+
+	s.Run("keeps the state resized to the new window unless reset", func() {
+		params := types.DefaultAIMDParams()
+		params.Window = 4
+		state := types.NewState(params.Window, params.MinBaseGasPrice.MulInt64(3), params.MaxLearningRate)
+		state.Window = []uint64{3, 4, 1, 2}
+		state.Index = 1
+		state.ReconcileWindowSum()
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		params.Window = 2
+		params.MaxLearningRate = params.MinLearningRate.MulInt64(2)
+		_, err := s.msgServer.Params(s.ctx, &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		})
+		s.Require().NoError(err)
+
+		got, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]uint64{3, 4}, got.Window)
+		s.Require().Equal(uint64(7), got.WindowSum)
+		s.Require().Equal(state.BaseGasPrice, got.BaseGasPrice)
+		// the learning rate is brought within the new bounds
+		s.Require().Equal(params.MaxLearningRate, got.LearningRate)
+
+		// a new floor above the kept price raises it
+		params.MinBaseGasPrice = state.BaseGasPrice.MulInt64(2)
+		_, err = s.msgServer.Params(s.ctx, &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		})
+		s.Require().NoError(err)
+
+		got, err = s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(params.MinBaseGasPrice, got.BaseGasPrice)
+		s.Require().Equal([]uint64{3, 4}, got.Window)
+	})
+}
+
+func (s *KeeperTestSuite) TestParamEventReconstruction() {
+	ctx := s.ctx.WithEventManager(sdk.NewEventManager())
+
+	updates := []types.Params{types.DefaultParams(), types.DefaultAIMDParams(), types.DefaultAIMDParams()}
+	updates[1].MinBaseGasPrice = math.LegacyMustNewDecFromStr("2.5")
+	updates[2].Window = 16
+	updates[2].Enabled = false
+
+	for _, params := range updates {
+		_, err := s.msgServer.Params(ctx, &types.MsgParams{
+			Authority: s.authorityAccount.String(),
+			Params:    params,
+		})
+		s.Require().NoError(err)
+	}
+
+	// Reconstruct the params by following the emitted events only.
+	reconstructed := types.Params{}
+	applied := 0
+	for _, event := range ctx.EventManager().ABCIEvents() {
+		msg, err := sdk.ParseTypedEvent(event)
+		if err != nil {
+			continue
+		}
+
+		update, ok := msg.(*types.EventUpdateParams)
+		if !ok {
+			continue
+		}
+		s.Require().Equal(s.authorityAccount.String(), update.Authority)
+
+		reconstructed, err = types.ApplyParamEvent(reconstructed, *update)
+		s.Require().NoError(err)
+		applied++
+	}
+	// The first update leaves the default params unchanged, so it emits no event.
+	s.Require().Equal(len(updates)-1, applied)
+
+	params, err := s.feeMarketKeeper.GetParams(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(params, reconstructed)
+
+	s.Run("invalid params in an event are rejected", func() {
+		got, err := types.ApplyParamEvent(reconstructed, types.EventUpdateParams{})
+		s.Require().Error(err)
+		s.Require().Equal(reconstructed, got)
+	})
 }
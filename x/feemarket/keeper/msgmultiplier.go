@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// SetMsgTypeMultiplier sets the multiplier applied to the base gas price for the given message
+// type URL, e.g. to charge more for messages that are expensive to the state machine relative to
+// their gas cost. A multiplier of one removes the multiplier of the message type.
+func (k *Keeper) SetMsgTypeMultiplier(ctx sdk.Context, msgTypeURL string, multiplier math.LegacyDec) error {
+	if err := types.ValidateMsgTypeMultiplier(msgTypeURL, multiplier); err != nil {
+		return err
+	}
+
+	k.msgTypeMultiplierCache.invalidate()
+
+	store := ctx.KVStore(k.storeKey)
+	if multiplier.Equal(math.LegacyOneDec()) {
+		store.Delete(types.MsgTypeMultiplierKey(msgTypeURL))
+		return nil
+	}
+
+	bz, err := multiplier.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(types.MsgTypeMultiplierKey(msgTypeURL), bz)
+	return nil
+}
+
+// GetMsgTypeMultipliers returns the base gas price multipliers set with SetMsgTypeMultiplier,
+// sorted by message type URL.
+func (k *Keeper) GetMsgTypeMultipliers(ctx sdk.Context) ([]types.MsgTypeMultiplier, error) {
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.KeyPrefixMsgTypeMultiplier)
+	defer iterator.Close()
+
+	var multipliers []types.MsgTypeMultiplier
+	for ; iterator.Valid(); iterator.Next() {
+		var multiplier math.LegacyDec
+		if err := multiplier.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		multipliers = append(multipliers, types.MsgTypeMultiplier{
+			MsgTypeUrl: string(iterator.Key()[len(types.KeyPrefixMsgTypeMultiplier):]),
+			Multiplier: multiplier,
+		})
+	}
+
+	return multipliers, nil
+}
+
+// EffectiveGasPrice returns the base gas price, floored at the min base gas price, multiplied by
+// the multiplier of the given message type URL, or the floored base gas price itself if no
+// multiplier is set for it.
+func (k *Keeper) EffectiveGasPrice(ctx sdk.Context, msgTypeURL string) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	baseGasPrice, err := k.GetBaseGasPrice(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	if params.MinBaseGasPrice.GT(baseGasPrice) {
+		baseGasPrice = params.MinBaseGasPrice
+	}
+
+	multiplier, err := k.msgTypeMultiplier(ctx, msgTypeURL)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	return baseGasPrice.Mul(multiplier), nil
+}
+
+// MaxMsgTypeMultiplier returns the highest multiplier of the given messages' types, counting
+// message types without a multiplier as one. The ante and post handlers scale the min gas price
+// of a tx by it, so that a tx pays the multiplier of its most expensive message. A tx without
+// messages has a multiplier of one.
+func (k *Keeper) MaxMsgTypeMultiplier(ctx sdk.Context, msgs []sdk.Msg) (math.LegacyDec, error) {
+	highest := math.LegacyOneDec()
+	for i, msg := range msgs {
+		multiplier, err := k.msgTypeMultiplier(ctx, sdk.MsgTypeURL(msg))
+		if err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		if i == 0 || multiplier.GT(highest) {
+			highest = multiplier
+		}
+	}
+
+	return highest, nil
+}
+
+// msgTypeMultiplier returns the multiplier of the given message type URL, or one if none is
+// set. The multipliers are read without charging gas once per block, see msgTypeMultipliers,
+// so that txs cost the same whether a multiplier is set or not and the number of messages in
+// a tx does not multiply the reads.
+func (k *Keeper) msgTypeMultiplier(ctx sdk.Context, msgTypeURL string) (math.LegacyDec, error) {
+	multipliers, err := k.msgTypeMultipliers(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	multiplier, ok := multipliers[msgTypeURL]
+	if !ok {
+		return math.LegacyOneDec(), nil
+	}
+
+	return multiplier, nil
+}
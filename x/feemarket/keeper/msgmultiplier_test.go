@@ -0,0 +1,154 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestMsgTypeMultiplier() {
+	const (
+		sendURL = "/cosmos.bank.v1beta1.MsgSend"
+		execURL = "/cosmwasm.wasm.v1.MsgExecuteContract"
+	)
+
+	gs := types.DefaultGenesisState()
+	gs.Params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.001")
+	gs.State.BaseGasPrice = math.LegacyMustNewDecFromStr("0.025")
+	s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+	s.Run("unlisted message types pay the base gas price", func() {
+		price, err := s.feeMarketKeeper.EffectiveGasPrice(s.ctx, sendURL)
+		s.Require().NoError(err)
+		s.Require().Equal(gs.State.BaseGasPrice, price)
+
+		multipliers, err := s.feeMarketKeeper.GetMsgTypeMultipliers(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Empty(multipliers)
+	})
+
+	s.Run("applies the multiplier of the message type", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetMsgTypeMultiplier(s.ctx, execURL, math.LegacyMustNewDecFromStr("2.5")))
+
+		// 0.025 * 2.5 = 0.0625
+		price, err := s.feeMarketKeeper.EffectiveGasPrice(s.ctx, execURL)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.0625"), price)
+
+		price, err = s.feeMarketKeeper.EffectiveGasPrice(s.ctx, sendURL)
+		s.Require().NoError(err)
+		s.Require().Equal(gs.State.BaseGasPrice, price)
+	})
+
+	s.Run("the base gas price is floored at the min base gas price", func() {
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		defer func() { s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state)) }()
+
+		below := state
+		below.BaseGasPrice = math.LegacyMustNewDecFromStr("0.0005")
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, below))
+
+		// 0.001 * 2.5 = 0.0025
+		price, err := s.feeMarketKeeper.EffectiveGasPrice(s.ctx, execURL)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.0025"), price)
+	})
+
+	s.Run("a fractional multiplier discounts the message type", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetMsgTypeMultiplier(s.ctx, sendURL, math.LegacyMustNewDecFromStr("0.5")))
+
+		// 0.025 * 0.5 = 0.0125
+		price, err := s.feeMarketKeeper.EffectiveGasPrice(s.ctx, sendURL)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.0125"), price)
+
+		multipliers, err := s.feeMarketKeeper.GetMsgTypeMultipliers(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.MsgTypeMultiplier{
+			{MsgTypeUrl: sendURL, Multiplier: math.LegacyMustNewDecFromStr("0.5")},
+			{MsgTypeUrl: execURL, Multiplier: math.LegacyMustNewDecFromStr("2.5")},
+		}, multipliers)
+	})
+
+	s.Run("a multiplier of one removes it", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetMsgTypeMultiplier(s.ctx, sendURL, math.LegacyOneDec()))
+
+		multipliers, err := s.feeMarketKeeper.GetMsgTypeMultipliers(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.MsgTypeMultiplier{
+			{MsgTypeUrl: execURL, Multiplier: math.LegacyMustNewDecFromStr("2.5")},
+		}, multipliers)
+	})
+
+	s.Run("rejects invalid multipliers", func() {
+		s.Require().Error(s.feeMarketKeeper.SetMsgTypeMultiplier(s.ctx, "", math.LegacyOneDec()))
+		s.Require().Error(s.feeMarketKeeper.SetMsgTypeMultiplier(s.ctx, sendURL, math.LegacyNewDec(-1)))
+		s.Require().Error(s.feeMarketKeeper.SetMsgTypeMultiplier(s.ctx, sendURL, math.LegacyZeroDec()))
+		s.Require().Error(s.feeMarketKeeper.SetMsgTypeMultiplier(s.ctx, sendURL, math.LegacyDec{}))
+	})
+
+	s.Run("can be set through governance", func() {
+		msg := types.NewMsgSetMsgTypeMultiplier(s.authorityAccount.String(), sendURL, math.LegacyNewDec(3))
+		_, err := s.msgServer.SetMsgTypeMultiplier(s.ctx, &msg)
+		s.Require().NoError(err)
+
+		// 0.025 * 3 = 0.075
+		price, err := s.feeMarketKeeper.EffectiveGasPrice(s.ctx, sendURL)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.075"), price)
+	})
+
+	s.Run("a tx pays the highest multiplier of its messages", func() {
+		multiSendURL := sdk.MsgTypeURL(&banktypes.MsgMultiSend{})
+		s.Require().NoError(s.feeMarketKeeper.SetMsgTypeMultiplier(s.ctx, multiSendURL, math.LegacyMustNewDecFromStr("0.5")))
+
+		multiplier, err := s.feeMarketKeeper.MaxMsgTypeMultiplier(s.ctx, []sdk.Msg{&banktypes.MsgMultiSend{}, &banktypes.MsgSend{}})
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(3), multiplier)
+
+		multiplier, err = s.feeMarketKeeper.MaxMsgTypeMultiplier(s.ctx, []sdk.Msg{&banktypes.MsgMultiSend{}})
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.5"), multiplier)
+
+		// message types without a multiplier count as one
+		multiplier, err = s.feeMarketKeeper.MaxMsgTypeMultiplier(s.ctx, []sdk.Msg{&banktypes.MsgMultiSend{}, &banktypes.MsgUpdateParams{}})
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyOneDec(), multiplier)
+
+		multiplier, err = s.feeMarketKeeper.MaxMsgTypeMultiplier(s.ctx, nil)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyOneDec(), multiplier)
+	})
+
+	s.Run("rejects a message from another authority", func() {
+		msg := types.NewMsgSetMsgTypeMultiplier(sdk.AccAddress("other").String(), sendURL, math.LegacyNewDec(5))
+		_, err := s.msgServer.SetMsgTypeMultiplier(s.ctx, &msg)
+		s.Require().Error(err)
+	})
+	s.Run("the multipliers are read once per block", func() {
+		ctx := s.ctx.WithBlockHeight(s.ctx.BlockHeight() + 1).WithGasMeter(storetypes.NewInfiniteGasMeter())
+		msgs := make([]sdk.Msg, 100)
+		for i := range msgs {
+			msgs[i] = &banktypes.MsgSend{}
+		}
+
+		multiplier, err := s.feeMarketKeeper.MaxMsgTypeMultiplier(ctx, msgs)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(3), multiplier)
+		s.Require().Zero(ctx.GasMeter().GasConsumed())
+
+		// a write that bypasses SetMsgTypeMultiplier is only seen from the next block
+		s.feeMarketKeeper.SetRawMsgTypeMultiplier(ctx, sendURL, math.LegacyNewDec(4))
+		multiplier, err = s.feeMarketKeeper.MaxMsgTypeMultiplier(ctx, msgs)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(3), multiplier)
+
+		multiplier, err = s.feeMarketKeeper.MaxMsgTypeMultiplier(ctx.WithBlockHeight(ctx.BlockHeight()+1), msgs)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(4), multiplier)
+	})
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"sync"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// msgTypeMultiplierCache holds the message type multipliers for the duration of a block, so
+// that they are read from the store once per block rather than once per message. They are
+// kept for the height and kind of context they were read in, see metricsCacheKey, and
+// dropped as soon as a different one is seen or a multiplier is set.
+type msgTypeMultiplierCache struct {
+	mu          sync.Mutex
+	key         metricsCacheKey
+	multipliers map[string]math.LegacyDec
+}
+
+func newMsgTypeMultiplierCache() *msgTypeMultiplierCache {
+	return &msgTypeMultiplierCache{}
+}
+
+// invalidate drops the cached multipliers.
+func (c *msgTypeMultiplierCache) invalidate() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.multipliers = nil
+}
+
+// msgTypeMultipliers returns the multipliers of all message types that have one, keyed by
+// message type URL. They are read without charging gas on the first call for the context's
+// key, and served from the cache on later calls. The returned map must not be modified.
+func (k *Keeper) msgTypeMultipliers(ctx sdk.Context) (map[string]math.LegacyDec, error) {
+	c := k.msgTypeMultiplierCache
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	key := newMetricsCacheKey(ctx)
+	if c.multipliers != nil && c.key == key {
+		return c.multipliers, nil
+	}
+
+	list, err := k.GetMsgTypeMultipliers(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+	if err != nil {
+		return nil, err
+	}
+
+	multipliers := make(map[string]math.LegacyDec, len(list))
+	for _, m := range list {
+		multipliers[m.MsgTypeUrl] = m.Multiplier
+	}
+
+	c.key = key
+	c.multipliers = multipliers
+	return multipliers, nil
+}
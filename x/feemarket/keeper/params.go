@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// ValidateParamsAgainstState checks newParams against the current state and consensus
+// params, giving proposers context that the stateless Params.ValidateBasic cannot. An
+// error is returned for changes that must not be applied and a warning for each change
+// that is allowed but risky. Applying new params resets the state (see MsgServer.Params),
+// so the warnings describe what the reset does to the live market:
+//
+//   - a new MinBaseGasPrice above the current base gas price makes the price jump to it;
+//   - a new MinBaseGasPrice below the current base gas price makes the price drop to it;
+//   - a new Window shorter than the number of blocks with recorded utilization;
+//   - a new MaxBlockUtilization above the consensus block gas limit, which blocks can
+//     never reach.
+//
+// A target block utilization above the consensus block gas limit is an error, as the
+// base gas price could then never rise.
+func (k *Keeper) ValidateParamsAgainstState(ctx sdk.Context, newParams types.Params) (warnings []string, err error) {
+	if err := newParams.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if block := ctx.ConsensusParams().Block; block != nil && block.MaxGas > 0 {
+		maxGas := uint64(block.MaxGas)
+
+		if target := newParams.TargetBlockUtilization(); target > maxGas {
+			return nil, fmt.Errorf(
+				"target block utilization %d exceeds the consensus block gas limit %d", target, maxGas,
+			)
+		}
+
+		if newParams.MaxBlockUtilization > maxGas {
+			warnings = append(warnings, fmt.Sprintf(
+				"max block utilization %d exceeds the consensus block gas limit %d and can never be reached",
+				newParams.MaxBlockUtilization, maxGas,
+			))
+		}
+	}
+
+	switch {
+	case newParams.MinBaseGasPrice.GT(state.BaseGasPrice):
+		warnings = append(warnings, fmt.Sprintf(
+			"min base gas price %s is above the current base gas price %s; the price will jump to it immediately",
+			newParams.MinBaseGasPrice, state.BaseGasPrice,
+		))
+	case newParams.MinBaseGasPrice.LT(state.BaseGasPrice):
+		warnings = append(warnings, fmt.Sprintf(
+			"min base gas price %s is below the current base gas price %s; the price will drop to it immediately",
+			newParams.MinBaseGasPrice, state.BaseGasPrice,
+		))
+	}
+
+	filled := uint64(0)
+	for _, utilization := range state.Window {
+		if utilization > 0 {
+			filled++
+		}
+	}
+
+	if newParams.Window < filled {
+		warnings = append(warnings, fmt.Sprintf(
+			"window %d is shorter than the %d blocks with recorded utilization",
+			newParams.Window, filled,
+		))
+	}
+
+	return warnings, nil
+}
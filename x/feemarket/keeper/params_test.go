@@ -0,0 +1,102 @@
+package keeper_test
+
+import (
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestValidateParamsAgainstState() {
+	params := types.DefaultAIMDParams()
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	setState := func(baseGasPrice math.LegacyDec, window []uint64) {
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = baseGasPrice
+		state.Window = window
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+	}
+
+	s.Run("unchanged params at the floor have no warnings", func() {
+		setState(params.MinBaseGasPrice, make([]uint64, params.Window))
+
+		warnings, err := s.feeMarketKeeper.ValidateParamsAgainstState(s.ctx, params)
+		s.Require().NoError(err)
+		s.Require().Empty(warnings)
+	})
+
+	s.Run("raising the floor above the base gas price warns of a jump", func() {
+		setState(params.MinBaseGasPrice, make([]uint64, params.Window))
+
+		newParams := params
+		newParams.MinBaseGasPrice = params.MinBaseGasPrice.MulInt64(10)
+
+		warnings, err := s.feeMarketKeeper.ValidateParamsAgainstState(s.ctx, newParams)
+		s.Require().NoError(err)
+		s.Require().Len(warnings, 1)
+		s.Require().Contains(warnings[0], "jump")
+	})
+
+	s.Run("a floor below the base gas price warns of a drop", func() {
+		setState(params.MinBaseGasPrice.MulInt64(10), make([]uint64, params.Window))
+
+		warnings, err := s.feeMarketKeeper.ValidateParamsAgainstState(s.ctx, params)
+		s.Require().NoError(err)
+		s.Require().Len(warnings, 1)
+		s.Require().Contains(warnings[0], "drop")
+	})
+
+	s.Run("shrinking the window below the fill level warns", func() {
+		window := make([]uint64, params.Window)
+		for i := 0; i < 4; i++ {
+			window[i] = 1_000
+		}
+		setState(params.MinBaseGasPrice, window)
+
+		newParams := params
+		newParams.Window = 2
+
+		warnings, err := s.feeMarketKeeper.ValidateParamsAgainstState(s.ctx, newParams)
+		s.Require().NoError(err)
+		s.Require().Len(warnings, 1)
+		s.Require().Contains(warnings[0], "window 2 is shorter than the 4 blocks")
+
+		// A window at the fill level keeps every recorded block.
+		newParams.Window = 4
+		warnings, err = s.feeMarketKeeper.ValidateParamsAgainstState(s.ctx, newParams)
+		s.Require().NoError(err)
+		s.Require().Empty(warnings)
+	})
+
+	s.Run("a max block utilization above the consensus gas limit warns", func() {
+		setState(params.MinBaseGasPrice, make([]uint64, params.Window))
+
+		maxGas := int64(params.MaxBlockUtilization) - 1
+		ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: maxGas}})
+
+		warnings, err := s.feeMarketKeeper.ValidateParamsAgainstState(ctx, params)
+		s.Require().NoError(err)
+		s.Require().Len(warnings, 1)
+		s.Require().Contains(warnings[0], "can never be reached")
+	})
+
+	s.Run("a target above the consensus gas limit is an error", func() {
+		setState(params.MinBaseGasPrice, make([]uint64, params.Window))
+
+		maxGas := int64(params.TargetBlockUtilization()) - 1
+		ctx := s.ctx.WithConsensusParams(cmtproto.ConsensusParams{Block: &cmtproto.BlockParams{MaxGas: maxGas}})
+
+		_, err := s.feeMarketKeeper.ValidateParamsAgainstState(ctx, params)
+		s.Require().Error(err)
+	})
+
+	s.Run("invalid params are an error", func() {
+		newParams := params
+		newParams.Window = 0
+
+		_, err := s.feeMarketKeeper.ValidateParamsAgainstState(s.ctx, newParams)
+		s.Require().Error(err)
+	})
+}
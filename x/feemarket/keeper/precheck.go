@@ -0,0 +1,189 @@
+package keeper
+
+import (
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// PreCheckFee validates the fee of the given tx against the current gas price and returns
+// the tx's mempool priority, so that a custom mempool can check a tx and order it in a
+// single call on Insert. The checks and priority are those of the fee market ante handler,
+// see CheckFee: a tx the fee market does not charge, e.g. while it is disabled or below
+// the height that enabled it, yields a priority of zero, and a fee below the required fee
+// returns sdkerrors.ErrInsufficientFee.
+func (k *Keeper) PreCheckFee(ctx sdk.Context, tx sdk.Tx) (priority int64, err error) {
+	check, err := k.CheckFee(ctx, tx, false)
+	if err != nil || check == nil {
+		return 0, err
+	}
+
+	return check.Priority, nil
+}
+
+// CheckFee checks the fee provided by the given tx against the current state of the fee
+// market and computes the tx's priority. It is shared by the fee market ante handler and
+// PreCheckFee, so that a mempool checks a tx exactly like CheckTx does. A nil
+// FeeCheck is returned if the fee market does not charge the tx: at genesis, while it is
+// disabled and not frozen, below the height that enabled it, or if the fee payer is a fee
+// exempt module account. A disabled fee market with a frozen height charges its last base
+// gas price. When simulating, the fee is not checked and the required fee is emitted instead.
+func (k *Keeper) CheckFee(ctx sdk.Context, tx sdk.Tx, simulate bool) (*types.FeeCheck, error) {
+	// GenTx consume no fee
+	if ctx.BlockHeight() == 0 {
+		return nil, nil
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	gas := feeTx.GetGas() // use provided gas limit
+	if !simulate && gas == 0 {
+		return nil, sdkerrors.ErrInvalidGasLimit.Wrapf("must provide positive gas")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to get fee market params")
+	}
+
+	if params.Enabled {
+		enabledHeight, err := k.GetEnabledHeight(ctx)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "unable to get fee market enabled height")
+		}
+
+		// the feemarket is not yet enabled below the height that enabled it
+		if ctx.BlockHeight() < enabledHeight {
+			return nil, nil
+		}
+	} else {
+		frozenHeight, err := k.GetFrozenHeight(ctx)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "unable to get fee market frozen height")
+		}
+
+		// return if disabled and not frozen
+		if frozenHeight < 0 {
+			return nil, nil
+		}
+	}
+
+	// allowlisted module accounts are exempt from fee deduction. The exemption follows the
+	// fee payer, who signs the tx, and not the granter, which any tx can name.
+	if k.IsFeeExempt(ctx, feeTx.FeePayer()) {
+		return nil, nil
+	}
+
+	feeCoins := feeTx.GetFee()
+	if len(feeCoins) == 0 && !simulate {
+		return nil, errorsmod.Wrapf(types.ErrNoFeeCoins, "got length %d", len(feeCoins))
+	}
+	if len(feeCoins) > 1 {
+		return nil, errorsmod.Wrapf(types.ErrTooManyFeeCoins, "got length %d", len(feeCoins))
+	}
+
+	if params.RejectGasAboveBlockLimit && !simulate {
+		if err := types.CheckBlockGasLimit(ctx, params, gas); err != nil {
+			return nil, err
+		}
+	}
+
+	// if simulating - create a dummy zero value for the user
+	payCoin := sdk.NewCoin(params.FeeDenom, sdkmath.ZeroInt())
+	if !simulate {
+		payCoin = feeCoins[0]
+	}
+
+	// the window holds no data for the first block after enablement, so price at the floor
+	getMinGasPrice := k.GetMinGasPrice
+	if params.Enabled {
+		firstBlock, err := k.IsFirstBlock(ctx)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "unable to determine first block")
+		}
+		if firstBlock {
+			getMinGasPrice = k.GetFloorGasPrice
+		}
+	}
+
+	minGasPrice, err := getMinGasPrice(ctx, payCoin.GetDenom())
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to get min gas price for denom %s", payCoin.GetDenom())
+	}
+
+	// the tx pays the multiplier of its most expensive message type
+	multiplier, err := k.MaxMsgTypeMultiplier(ctx, tx.GetMsgs())
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to get message type multiplier")
+	}
+	minGasPrice.Amount = minGasPrice.Amount.Mul(multiplier)
+
+	// the account that pays the fee receives the loyalty discount it qualifies for
+	discount, err := k.AccountFeeDiscount(ctx, params, types.FeeDeductionAddress(feeTx))
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to get fee discount")
+	}
+	minGasPrice.Amount = minGasPrice.Amount.Mul(sdkmath.LegacyOneDec().Sub(discount))
+
+	ctx.Logger().Debug("fee deduct ante handle",
+		"min gas prices", minGasPrice,
+		"fee", feeCoins,
+		"gas limit", gas,
+	)
+
+	if simulate {
+		// simulated txs are not rejected for their fee, so report the fee they require instead
+		types.EmitRequiredFee(ctx, minGasPrice, gas)
+	} else {
+		// a chain without a min base gas price may let the price reach zero on purpose
+		if params.MinBaseGasPrice.IsPositive() {
+			if err := types.CheckRequiredFeeNonZero(ctx, minGasPrice, gas); err != nil {
+				return nil, err
+			}
+		}
+
+		if _, _, err := types.CheckTxFee(ctx, minGasPrice, payCoin, int64(gas), true); err != nil {
+			return nil, errorsmod.Wrapf(err, "error checking fee")
+		}
+	}
+
+	priorityFee, err := k.resolveTxPriorityCoins(ctx, payCoin, params.FeeDenom)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "error resolving fee priority")
+	}
+
+	baseGasPrice, err := getMinGasPrice(ctx, params.FeeDenom)
+	if err != nil {
+		return nil, err
+	}
+	baseGasPrice.Amount = baseGasPrice.Amount.Mul(multiplier)
+
+	return &types.FeeCheck{
+		PayCoin:     payCoin,
+		Gas:         gas,
+		MinGasPrice: minGasPrice,
+		Priority:    types.GetTxPriority(priorityFee, int64(gas), baseGasPrice),
+	}, nil
+}
+
+// resolveTxPriorityCoins converts the coins to the proper denom used for tx prioritization calculation.
+func (k *Keeper) resolveTxPriorityCoins(ctx sdk.Context, fee sdk.Coin, baseDenom string) (sdk.Coin, error) {
+	if fee.Denom == baseDenom {
+		return fee, nil
+	}
+
+	feeDec := sdk.NewDecCoinFromCoin(fee)
+	convertedDec, err := k.ResolveToDenomCached(ctx, feeDec, baseDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// truncate down
+	return sdk.NewCoin(baseDenom, convertedDec.Amount.TruncateInt()), nil
+}
@@ -0,0 +1,74 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestPreCheckFee() {
+	const gasLimit = 100_000
+
+	buildTx := func(fee sdk.Coins) sdk.Tx {
+		_, _, addr := testdata.KeyTestPubAddr()
+
+		txBuilder := s.encCfg.TxConfig.NewTxBuilder()
+		s.Require().NoError(txBuilder.SetMsgs(testdata.NewTestMsg(addr)))
+		txBuilder.SetFeeAmount(fee)
+		txBuilder.SetGasLimit(gasLimit)
+		txBuilder.SetFeePayer(addr)
+		return txBuilder.GetTx()
+	}
+
+	gs := types.DefaultGenesisState()
+	gs.State.BaseGasPrice = math.LegacyMustNewDecFromStr("2")
+	s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+	s.Run("sufficient fee returns a positive priority", func() {
+		fee := sdk.NewCoin(types.DefaultFeeDenom, math.NewInt(3*gasLimit))
+
+		priority, err := s.feeMarketKeeper.PreCheckFee(s.ctx, buildTx(sdk.NewCoins(fee)))
+		s.Require().NoError(err)
+		s.Require().Positive(priority)
+
+		// The priority matches the one assigned by the ante handler.
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(types.GetTxPriority(fee, gasLimit, gasPrice), priority)
+	})
+
+	s.Run("a higher fee returns a higher priority", func() {
+		low, err := s.feeMarketKeeper.PreCheckFee(s.ctx, buildTx(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 2*gasLimit))))
+		s.Require().NoError(err)
+
+		high, err := s.feeMarketKeeper.PreCheckFee(s.ctx, buildTx(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 4*gasLimit))))
+		s.Require().NoError(err)
+		s.Require().Greater(high, low)
+	})
+
+	s.Run("insufficient fee returns an error", func() {
+		_, err := s.feeMarketKeeper.PreCheckFee(s.ctx, buildTx(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, gasLimit))))
+		s.Require().ErrorIs(err, sdkerrors.ErrInsufficientFee)
+	})
+
+	s.Run("blocks before the enabled height are not checked", func() {
+		s.feeMarketKeeper.SetEnabledHeight(s.ctx, s.ctx.BlockHeight()+1)
+		defer s.feeMarketKeeper.SetEnabledHeight(s.ctx, -1)
+
+		priority, err := s.feeMarketKeeper.PreCheckFee(s.ctx, buildTx(sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 1))))
+		s.Require().NoError(err)
+		s.Require().Zero(priority)
+	})
+
+	s.Run("missing or multiple fee coins return an error", func() {
+		_, err := s.feeMarketKeeper.PreCheckFee(s.ctx, buildTx(sdk.NewCoins()))
+		s.Require().ErrorIs(err, types.ErrNoFeeCoins)
+
+		fee := sdk.NewCoins(sdk.NewInt64Coin(types.DefaultFeeDenom, 3*gasLimit), sdk.NewInt64Coin("atom", 3*gasLimit))
+		_, err = s.feeMarketKeeper.PreCheckFee(s.ctx, buildTx(fee))
+		s.Require().ErrorIs(err, types.ErrTooManyFeeCoins)
+	})
+}
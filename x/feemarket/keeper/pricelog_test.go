@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+
+	"cosmossdk.io/log"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateFeeMarketStructuredPriceLog() {
+	// priceLogs returns the structured price update log lines written to the buffer.
+	priceLogs := func(buf *bytes.Buffer) []map[string]interface{} {
+		var logs []map[string]interface{}
+
+		scanner := bufio.NewScanner(buf)
+		for scanner.Scan() {
+			var entry map[string]interface{}
+			s.Require().NoError(json.Unmarshal(scanner.Bytes(), &entry))
+
+			var payload map[string]interface{}
+			msg, _ := entry["message"].(string)
+			if json.Unmarshal([]byte(msg), &payload) == nil && payload["event"] == types.PriceUpdateLogEvent {
+				logs = append(logs, payload)
+			}
+		}
+
+		return logs
+	}
+
+	s.Run("no structured log is emitted when disabled", func() {
+		var buf bytes.Buffer
+		ctx := s.ctx.WithLogger(log.NewLogger(&buf, log.OutputJSONOption()))
+
+		s.setGenesisState(types.DefaultAIMDParams(), types.DefaultAIMDState())
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+		s.Require().Empty(priceLogs(&buf))
+	})
+
+	s.Run("structured log is valid json with the expected keys", func() {
+		s.feeMarketKeeper.SetStructuredPriceLog(true)
+		defer s.feeMarketKeeper.SetStructuredPriceLog(false)
+
+		var buf bytes.Buffer
+		ctx := s.ctx.WithLogger(log.NewLogger(&buf, log.OutputJSONOption()))
+
+		params := types.DefaultAIMDParams()
+		s.setGenesisState(params, types.DefaultAIMDState())
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(ctx))
+
+		logs := priceLogs(&buf)
+		s.Require().Len(logs, 1)
+
+		state, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+
+		line := logs[0]
+		s.Require().Equal(float64(ctx.BlockHeight()), line["height"])
+		s.Require().Equal(params.FeeDenom, line["fee_denom"])
+		s.Require().Equal(params.MinBaseGasPrice.String(), line["previous_base_gas_price"])
+		s.Require().Equal(state.BaseGasPrice.String(), line["base_gas_price"])
+		s.Require().Equal(params.MinBaseGasPrice.String(), line["min_base_gas_price"])
+		s.Require().Equal(state.LearningRate.String(), line["learning_rate"])
+		s.Require().Contains(line, "average_utilization")
+	})
+}
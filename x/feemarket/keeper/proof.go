@@ -0,0 +1,41 @@
+package keeper
+
+import (
+	"fmt"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// GetStateWithProof returns the fee market state committed at the given height along with
+// its Merkle proof against the app hash of that height. The queryable is typically the
+// app's committed multistore (e.g. BaseApp.CommitMultiStore()); a height of zero queries
+// the latest committed height. The height must not have been pruned.
+func (k *Keeper) GetStateWithProof(queryable storetypes.Queryable, height int64) (types.StateProof, error) {
+	res, err := queryable.Query(&storetypes.RequestQuery{
+		Path:   fmt.Sprintf("/%s/key", k.storeKey.Name()),
+		Data:   types.KeyState,
+		Height: height,
+		Prove:  true,
+	})
+	if err != nil {
+		return types.StateProof{}, err
+	}
+
+	if len(res.Value) == 0 {
+		return types.StateProof{}, fmt.Errorf("no fee market state committed at height %d", res.Height)
+	}
+
+	var state types.State
+	if err := k.cdc.Unmarshal(res.Value, &state); err != nil {
+		return types.StateProof{}, err
+	}
+
+	return types.StateProof{
+		Height: res.Height,
+		State:  state,
+		Value:  res.Value,
+		Proof:  res.ProofOps,
+	}, nil
+}
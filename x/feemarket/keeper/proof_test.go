@@ -0,0 +1,76 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestGetStateWithProof() {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+	otherKey := storetypes.NewKVStoreKey("other")
+
+	cms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	cms.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, nil)
+	cms.MountStoreWithDB(otherKey, storetypes.StoreTypeIAVL, nil)
+	s.Require().NoError(cms.LoadLatestVersion())
+
+	k := keeper.NewKeeper(s.encCfg.Codec, storeKey, nil, nil, s.authorityAccount.String())
+	ctx := sdk.NewContext(cms, cmtproto.Header{}, false, log.NewNopLogger())
+
+	state := types.DefaultAIMDState()
+	state.BaseGasPrice = math.LegacyMustNewDecFromStr("1.5")
+	s.Require().NoError(k.SetState(ctx, state))
+	ctx.KVStore(otherKey).Set([]byte("key"), []byte("value"))
+	commitID := cms.Commit()
+
+	proof, err := k.GetStateWithProof(cms, commitID.Version)
+	s.Require().NoError(err)
+	s.Require().Equal(commitID.Version, proof.Height)
+	s.Require().Equal(state, proof.State)
+
+	s.Run("proof verifies against the committed root", func() {
+		s.Require().NoError(proof.Verify(commitID.Hash, types.StoreKey))
+	})
+
+	s.Run("proof does not verify against another root", func() {
+		root := append([]byte{}, commitID.Hash...)
+		root[0] ^= 0xff
+		s.Require().Error(proof.Verify(root, types.StoreKey))
+	})
+
+	s.Run("proof does not verify a tampered state", func() {
+		tampered := proof
+		tampered.State.BaseGasPrice = math.LegacyMustNewDecFromStr("0.5")
+		s.Require().Error(tampered.Verify(commitID.Hash, types.StoreKey))
+
+		bz, err := tampered.State.Marshal()
+		s.Require().NoError(err)
+		tampered.Value = bz
+		s.Require().Error(tampered.Verify(commitID.Hash, types.StoreKey))
+	})
+
+	s.Run("proof does not verify for another store", func() {
+		s.Require().Error(proof.Verify(commitID.Hash, "other"))
+	})
+
+	s.Run("proves the state at an earlier height", func() {
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("3")
+		s.Require().NoError(k.SetState(ctx, state))
+		latest := cms.Commit()
+
+		earlier, err := k.GetStateWithProof(cms, commitID.Version)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("1.5"), earlier.State.BaseGasPrice)
+		s.Require().NoError(earlier.Verify(commitID.Hash, types.StoreKey))
+		s.Require().Error(earlier.Verify(latest.Hash, types.StoreKey))
+	})
+}
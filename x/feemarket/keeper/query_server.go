@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"fmt"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/skip-mev/feemarket/x/feemarket/types"
@@ -24,7 +26,7 @@ func NewQueryServer(keeper Keeper) types.QueryServer {
 func (q QueryServer) Params(goCtx context.Context, _ *types.ParamsRequest) (*types.ParamsResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	params, err := q.k.GetParams(ctx)
+	params, _, err := q.k.readMarket(ctx, false)
 	return &types.ParamsResponse{Params: params}, err
 }
 
@@ -32,22 +34,154 @@ func (q QueryServer) Params(goCtx context.Context, _ *types.ParamsRequest) (*typ
 func (q QueryServer) State(goCtx context.Context, _ *types.StateRequest) (*types.StateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	state, err := q.k.GetState(ctx)
+	_, state, err := q.k.readMarket(ctx, true)
 	return &types.StateResponse{State: state}, err
 }
 
-// GasPrice defines a method that returns the current feemarket base gas price.
+// GasPrice defines a method that returns the current feemarket base gas price, rounded up to
+// the precision of the requested denom.
 func (q QueryServer) GasPrice(goCtx context.Context, req *types.GasPriceRequest) (*types.GasPriceResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	gasPrice, err := q.k.GetMinGasPrice(ctx, req.GetDenom())
-	return &types.GasPriceResponse{Price: gasPrice}, err
+	gasPrice, precision, err := q.k.GetGasPriceQuote(ctx, req.GetDenom())
+	return &types.GasPriceResponse{Price: gasPrice, Precision: precision}, err
 }
 
-// GasPrices defines a method that returns the current feemarket list of gas prices.
-func (q QueryServer) GasPrices(goCtx context.Context, _ *types.GasPricesRequest) (*types.GasPricesResponse, error) {
+// GasPrices defines a method that returns the current feemarket list of gas prices. If the
+// request names denoms, the gas price is returned in each of them; otherwise it is returned
+// in the fee denom and all extra denoms of the denom resolver.
+func (q QueryServer) GasPrices(goCtx context.Context, req *types.GasPricesRequest) (*types.GasPricesResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	if len(req.GetDenoms()) > 0 {
+		gasPrices, err := q.k.GetMinGasPricesInDenoms(ctx, req.GetDenoms())
+		return &types.GasPricesResponse{Prices: gasPrices}, err
+	}
+
 	gasPrices, err := q.k.GetMinGasPrices(ctx)
 	return &types.GasPricesResponse{Prices: gasPrices}, err
 }
+
+// MinGasPriceConfig defines a method that returns the current minimum gas price formatted
+// as a cosmos-sdk minimum-gas-prices string.
+func (q QueryServer) MinGasPriceConfig(goCtx context.Context, req *types.MinGasPriceConfigRequest) (*types.MinGasPriceConfigResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	minGasPrices, err := q.k.GetMinGasPriceConfig(ctx, req.GetDenom())
+	return &types.MinGasPriceConfigResponse{MinimumGasPrices: minGasPrices}, err
+}
+
+// RevenueByMsgType defines a method that returns the fee revenue collected over the window
+// attributed to the primary message type of each transaction.
+func (q QueryServer) RevenueByMsgType(goCtx context.Context, _ *types.RevenueByMsgTypeRequest) (*types.RevenueByMsgTypeResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	revenue, total, err := q.k.GetRevenueByMsgType(ctx)
+	return &types.RevenueByMsgTypeResponse{Revenue: revenue, Total: total}, err
+}
+
+// AccountFeeSpend defines a method that returns the fees paid by an account over the account
+// fee spend window.
+func (q QueryServer) AccountFeeSpend(goCtx context.Context, req *types.AccountFeeSpendRequest) (*types.AccountFeeSpendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	addr, err := sdk.AccAddressFromBech32(req.GetAddress())
+	if err != nil {
+		return nil, err
+	}
+
+	fees, err := q.k.GetAccountFeeSpend(ctx, addr)
+	return &types.AccountFeeSpendResponse{Fees: fees}, err
+}
+
+// EIP1559Equivalent defines a method that returns the current parameters translated into their
+// EIP-1559 equivalents.
+func (q QueryServer) EIP1559Equivalent(goCtx context.Context, _ *types.EIP1559EquivalentRequest) (*types.EIP1559EquivalentResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := q.k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return params.EIP1559Equivalent(), nil
+}
+
+// BaseGasPriceHistory defines a method that returns the base gas prices of the most recent
+// blocks retained by the base gas price history.
+func (q QueryServer) BaseGasPriceHistory(goCtx context.Context, req *types.BaseGasPriceHistoryRequest) (*types.BaseGasPriceHistoryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	history, err := q.k.GetBaseGasPriceHistory(ctx, req.GetLimit())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.BaseGasPriceHistoryResponse{History: history}, nil
+}
+
+// CommunityPoolContributions defines a method that returns the share of the fees distributed
+// by the fee market that was allocated to the community pool.
+func (q QueryServer) CommunityPoolContributions(goCtx context.Context, _ *types.CommunityPoolContributionsRequest) (*types.CommunityPoolContributionsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	contributions, err := q.k.GetCommunityPoolContributions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	window, err := q.k.GetWindowCommunityPoolContributions(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.CommunityPoolContributionsResponse{Contributions: contributions, Window: window}, nil
+}
+
+// Utilization defines a method that returns how full the blocks of the window have been.
+func (q QueryServer) Utilization(goCtx context.Context, _ *types.UtilizationRequest) (*types.UtilizationResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	utilization, gas, window, err := q.k.GetUtilization(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.UtilizationResponse{Utilization: utilization, WindowGas: gas, Window: window}, nil
+}
+
+// ProjectGasPrice defines a method that returns the base gas price and learning rate
+// projected a number of blocks into the future at a constant utilization.
+func (q QueryServer) ProjectGasPrice(goCtx context.Context, req *types.ProjectGasPriceRequest) (*types.ProjectGasPriceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	assumedUtilization, err := math.LegacyNewDecFromStr(req.GetAssumedUtilization())
+	if err != nil {
+		return nil, fmt.Errorf("invalid assumed utilization: %w", err)
+	}
+
+	baseGasPrice, learningRate, err := q.k.ProjectGasPrice(ctx, req.GetBlocks(), assumedUtilization)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.ProjectGasPriceResponse{BaseGasPrice: baseGasPrice, LearningRate: learningRate}, nil
+}
+
+// MarketInfo defines a method that returns the current feemarket parameters and state along
+// with the current min gas price in the fee denom and the congestion multiplier.
+func (q QueryServer) MarketInfo(goCtx context.Context, _ *types.MarketInfoRequest) (*types.MarketInfoResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, state, minGasPrice, err := q.k.GetMarketInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MarketInfoResponse{
+		Params:               params,
+		State:                state,
+		MinGasPrice:          minGasPrice,
+		CongestionMultiplier: params.CongestionMultiplier(state.BaseGasPrice),
+	}, nil
+}
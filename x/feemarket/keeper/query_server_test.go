@@ -2,8 +2,13 @@ package keeper_test
 
 import (
 	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/mock"
 
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
 	"github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/skip-mev/feemarket/x/feemarket/types/mocks"
 )
 
 func (s *KeeperTestSuite) TestParamsRequest() {
@@ -33,6 +38,11 @@ func (s *KeeperTestSuite) TestParamsRequest() {
 			MaxBlockUtilization: 10,
 			Window:              1,
 			Enabled:             true,
+
+			EffectiveMinLearningRate: math.LegacyZeroDec(),
+			TargetDeadBand:           math.LegacyZeroDec(),
+			MaxRateChangePerBlock:    math.LegacyZeroDec(),
+			BurnFraction:             math.LegacyZeroDec(),
 		}
 		err := s.feeMarketKeeper.SetParams(s.ctx, params)
 		s.Require().NoError(err)
@@ -156,4 +166,407 @@ func (s *KeeperTestSuite) TestBaseFeeRequest() {
 
 		s.Require().Equal(resp.GetPrice(), fee)
 	})
+
+	s.Run("defaults to the fee denom", func() {
+		params := types.DefaultParams()
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, types.DefaultState()))
+
+		resp, err := s.queryServer.GasPrice(s.ctx, &types.GasPriceRequest{})
+		s.Require().NoError(err)
+
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, params.FeeDenom)
+		s.Require().NoError(err)
+
+		s.Require().Equal(params.FeeDenom, resp.GetPrice().Denom)
+		s.Require().Equal(gasPrice, resp.GetPrice())
+	})
+}
+
+func (s *KeeperTestSuite) TestGasPriceQuotePrecision() {
+	metadataKeeper := mocks.NewDenomMetadataKeeper(s.T())
+	metadataKeeper.On("GetDenomMetaData", mock.Anything, "uatom").Return(banktypes.Metadata{
+		Base:    "uatom",
+		Display: "atom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+	}, true).Maybe()
+	metadataKeeper.On("GetDenomMetaData", mock.Anything, "aevmos").Return(banktypes.Metadata{
+		Base:    "aevmos",
+		Display: "evmos",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "aevmos", Exponent: 0},
+			{Denom: "evmos", Exponent: 18},
+		},
+	}, true).Maybe()
+	metadataKeeper.On("GetDenomMetaData", mock.Anything, "unregistered").Return(banktypes.Metadata{}, false).Maybe()
+	s.feeMarketKeeper.SetDenomMetadataKeeper(metadataKeeper)
+	defer s.feeMarketKeeper.SetDenomMetadataKeeper(nil)
+
+	params := types.DefaultParams()
+	params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.025")
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	state := types.DefaultState()
+	state.BaseGasPrice = math.LegacyMustNewDecFromStr("0.025000000123456789")
+	s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+	s.Run("quotes a 6-decimal denom at 6 decimals, rounded up", func() {
+		gasPrice, precision, err := s.feeMarketKeeper.GetGasPriceQuote(s.ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(6), precision)
+		s.Require().Equal(sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("0.025001")), gasPrice)
+	})
+
+	s.Run("quotes an 18-decimal denom at full precision", func() {
+		gasPrice, precision, err := s.feeMarketKeeper.GetGasPriceQuote(s.ctx, "aevmos")
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(18), precision)
+		s.Require().Equal(sdk.NewDecCoinFromDec("aevmos", state.BaseGasPrice), gasPrice)
+	})
+
+	s.Run("denoms without metadata use the default precision", func() {
+		gasPrice, precision, err := s.feeMarketKeeper.GetGasPriceQuote(s.ctx, "unregistered")
+		s.Require().NoError(err)
+		s.Require().Equal(types.DefaultDenomPrecision, precision)
+		s.Require().Equal(sdk.NewDecCoinFromDec("unregistered", state.BaseGasPrice), gasPrice)
+
+		// The query server's keeper has no metadata keeper, so every denom uses the
+		// default precision.
+		resp, err := s.queryServer.GasPrice(s.ctx, &types.GasPriceRequest{Denom: "uatom"})
+		s.Require().NoError(err)
+		s.Require().Equal(types.DefaultDenomPrecision, resp.Precision)
+		s.Require().Equal(sdk.NewDecCoinFromDec("uatom", state.BaseGasPrice), resp.Price)
+	})
+
+	s.Run("a price within the precision is unchanged", func() {
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("0.025")
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		gasPrice, _, err := s.feeMarketKeeper.GetGasPriceQuote(s.ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec("uatom", state.BaseGasPrice), gasPrice)
+	})
+}
+
+func (s *KeeperTestSuite) TestGasPricesRequest() {
+	params := types.DefaultParams()
+	params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.025")
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	state := types.DefaultState()
+	state.BaseGasPrice = math.LegacyMustNewDecFromStr("0.025")
+	s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+	s.Run("an empty request returns the gas prices in all available denoms", func() {
+		resp, err := s.queryServer.GasPrices(s.ctx, &types.GasPricesRequest{})
+		s.Require().NoError(err)
+
+		gasPrices, err := s.feeMarketKeeper.GetMinGasPrices(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(gasPrices, resp.GetPrices())
+	})
+
+	s.Run("returns the gas price in each requested denom", func() {
+		resp, err := s.queryServer.GasPrices(s.ctx, &types.GasPricesRequest{
+			Denoms: []string{"uatom", params.FeeDenom, "uosmo", "uatom"},
+		})
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec(params.FeeDenom, state.BaseGasPrice),
+			sdk.NewDecCoinFromDec("uatom", state.BaseGasPrice),
+			sdk.NewDecCoinFromDec("uosmo", state.BaseGasPrice),
+		), resp.GetPrices())
+	})
+
+	s.Run("errors if a requested denom cannot be resolved", func() {
+		s.feeMarketKeeper.SetDenomResolver(&types.ErrorDenomResolver{})
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+		queryServer := keeper.NewQueryServer(*s.feeMarketKeeper)
+		_, err := queryServer.GasPrices(s.ctx, &types.GasPricesRequest{Denoms: []string{"uatom"}})
+		s.Require().Error(err)
+
+		_, err = queryServer.GasPrice(s.ctx, &types.GasPriceRequest{Denom: "uatom"})
+		s.Require().Error(err)
+	})
+
+	s.Run("errors if no denom resolver is set", func() {
+		s.feeMarketKeeper.SetDenomResolver(nil)
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+		queryServer := keeper.NewQueryServer(*s.feeMarketKeeper)
+		_, err := queryServer.GasPrices(s.ctx, &types.GasPricesRequest{Denoms: []string{"uatom"}})
+		s.Require().ErrorIs(err, types.ErrResolverNotSet)
+
+		_, err = queryServer.GasPrice(s.ctx, &types.GasPriceRequest{Denom: "uatom"})
+		s.Require().ErrorIs(err, types.ErrResolverNotSet)
+
+		// The fee denom is always available.
+		resp, err := queryServer.GasPrices(s.ctx, &types.GasPricesRequest{Denoms: []string{params.FeeDenom}})
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(sdk.NewDecCoinFromDec(params.FeeDenom, state.BaseGasPrice)), resp.GetPrices())
+	})
+}
+
+func (s *KeeperTestSuite) TestMinGasPriceConfigRequest() {
+	s.Run("can get min gas price config in the fee denom", func() {
+		params := types.DefaultParams()
+		params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.025")
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+		state := types.DefaultState()
+		state.BaseGasPrice = math.LegacyMustNewDecFromStr("0.025")
+		err := s.feeMarketKeeper.SetState(s.ctx, state)
+		s.Require().NoError(err)
+
+		req := &types.MinGasPriceConfigRequest{}
+		resp, err := s.queryServer.MinGasPriceConfig(s.ctx, req)
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+		s.Require().Equal("0.025"+types.DefaultFeeDenom, resp.GetMinimumGasPrices())
+
+		// The result must be accepted by the SDK's minimum-gas-prices parser.
+		parsed, err := sdk.ParseDecCoins(resp.GetMinimumGasPrices())
+		s.Require().NoError(err)
+
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoins(gasPrice), parsed)
+	})
+
+	s.Run("can get min gas price config in a resolved denom", func() {
+		req := &types.MinGasPriceConfigRequest{
+			Denom: "atom",
+		}
+		resp, err := s.queryServer.MinGasPriceConfig(s.ctx, req)
+		s.Require().NoError(err)
+		s.Require().NotNil(resp)
+
+		parsed, err := sdk.ParseDecCoin(resp.GetMinimumGasPrices())
+		s.Require().NoError(err)
+
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, req.GetDenom())
+		s.Require().NoError(err)
+		s.Require().Equal(gasPrice, parsed)
+	})
+
+	s.Run("uses the base denom from the denom metadata", func() {
+		metadataKeeper := mocks.NewDenomMetadataKeeper(s.T())
+		metadataKeeper.On("GetDenomMetaData", mock.Anything, types.DefaultFeeDenom).Return(banktypes.Metadata{
+			Base:    types.DefaultFeeDenom,
+			Display: "STAKE",
+		}, true)
+		s.feeMarketKeeper.SetDenomMetadataKeeper(metadataKeeper)
+		defer s.feeMarketKeeper.SetDenomMetadataKeeper(nil)
+
+		minGasPrices, err := s.feeMarketKeeper.GetMinGasPriceConfig(s.ctx, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+
+		parsed, err := sdk.ParseDecCoin(minGasPrices)
+		s.Require().NoError(err)
+		s.Require().Equal(types.DefaultFeeDenom, parsed.Denom)
+	})
+}
+
+func (s *KeeperTestSuite) TestEIP1559EquivalentRequest() {
+	s.Run("can get the eip-1559 equivalent of the current params", func() {
+		params := types.DefaultParams()
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+		resp, err := s.queryServer.EIP1559Equivalent(s.ctx, &types.EIP1559EquivalentRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(params.EIP1559Equivalent(), resp)
+		s.Require().Equal(math.LegacyNewDec(8), resp.BaseFeeChangeDenominator)
+		s.Require().True(resp.Exact)
+	})
+}
+
+func (s *KeeperTestSuite) TestUtilizationRequest() {
+	params := types.DefaultAIMDParams()
+	state := types.DefaultAIMDState()
+	s.setGenesisState(params, state)
+
+	s.Run("an empty window has a utilization of exactly zero", func() {
+		resp, err := s.queryServer.Utilization(s.ctx, &types.UtilizationRequest{})
+		s.Require().NoError(err)
+		s.Require().True(resp.Utilization.IsZero())
+		s.Require().Equal(uint64(0), resp.WindowGas)
+		s.Require().Equal(params.Window, resp.Window)
+	})
+
+	s.Run("returns the gas used over the window relative to its capacity", func() {
+		state.Window[0] = params.MaxBlockUtilization
+		state.Window[1] = params.TargetBlockUtilization()
+		state.ReconcileWindowSum()
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		resp, err := s.queryServer.Utilization(s.ctx, &types.UtilizationRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(params.MaxBlockUtilization+params.TargetBlockUtilization(), resp.WindowGas)
+		s.Require().Equal(params.Window, resp.Window)
+
+		// One and a half full blocks over the window.
+		expected := math.LegacyNewDecWithPrec(15, 1).QuoInt64(int64(params.Window))
+		s.Require().True(expected.Equal(resp.Utilization), resp.Utilization.String())
+	})
+
+	s.Run("a window of full blocks has a utilization of one", func() {
+		for i := range state.Window {
+			state.Window[i] = params.MaxBlockUtilization
+		}
+		state.ReconcileWindowSum()
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		resp, err := s.queryServer.Utilization(s.ctx, &types.UtilizationRequest{})
+		s.Require().NoError(err)
+		s.Require().True(math.LegacyOneDec().Equal(resp.Utilization))
+	})
+}
+
+func (s *KeeperTestSuite) TestProjectGasPriceRequest() {
+	params := types.DefaultAIMDParams()
+	state := types.DefaultAIMDState()
+	state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(10)
+	s.setGenesisState(params, state)
+
+	project := func(blocks uint64, utilization string) (*types.ProjectGasPriceResponse, error) {
+		return s.queryServer.ProjectGasPrice(s.ctx, &types.ProjectGasPriceRequest{
+			Blocks:             blocks,
+			AssumedUtilization: utilization,
+		})
+	}
+
+	s.Run("runs the end block update forward at the assumed utilization", func() {
+		resp, err := project(5, "0.75")
+		s.Require().NoError(err)
+
+		expected := state
+		expected.Window = append([]uint64(nil), state.Window...)
+		for i := 0; i < 5; i++ {
+			expected.SetCurrentUtilization(params.MaxBlockUtilization * 3 / 4)
+			expected.UpdateLearningRate(params)
+			expected.UpdateBaseGasPrice(params)
+			expected.IncrementHeight()
+		}
+
+		s.Require().Equal(expected.BaseGasPrice, resp.BaseGasPrice)
+		s.Require().Equal(expected.LearningRate, resp.LearningRate)
+		s.Require().True(resp.BaseGasPrice.GT(state.BaseGasPrice))
+	})
+
+	s.Run("zero blocks returns the current state", func() {
+		resp, err := project(0, "1")
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice, resp.BaseGasPrice)
+		s.Require().Equal(state.LearningRate, resp.LearningRate)
+	})
+
+	s.Run("clamps to the min base gas price and the learning rate bounds", func() {
+		resp, err := project(1_000, "0")
+		s.Require().NoError(err)
+		s.Require().Equal(params.MinBaseGasPrice, resp.BaseGasPrice)
+		s.Require().Equal(params.MaxLearningRate, resp.LearningRate)
+
+		resp, err = project(1_000, "0.5")
+		s.Require().NoError(err)
+		s.Require().Equal(params.MinLearningRate, resp.LearningRate)
+	})
+
+	s.Run("leaves the stored state untouched", func() {
+		stored, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(state, stored)
+	})
+
+	s.Run("rejects invalid requests", func() {
+		_, err := project(keeper.MaxProjectionBlocks+1, "0.5")
+		s.Require().Error(err)
+
+		_, err = project(5, "1.5")
+		s.Require().Error(err)
+
+		_, err = project(5, "")
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestMarketInfoRequest() {
+	params := types.DefaultParams()
+	state := types.DefaultState()
+	s.setGenesisState(params, state)
+
+	s.Run("returns the params, state and min gas price in the fee denom", func() {
+		resp, err := s.queryServer.MarketInfo(s.ctx, &types.MarketInfoRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(params, resp.Params)
+		s.Require().Equal(state, resp.State)
+
+		expected, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, params.FeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, resp.MinGasPrice)
+	})
+
+	s.Run("floors the min gas price at the min base gas price", func() {
+		state.BaseGasPrice = params.MinBaseGasPrice.QuoInt64(2)
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		resp, err := s.queryServer.MarketInfo(s.ctx, &types.MarketInfoRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice, resp.State.BaseGasPrice)
+		s.Require().Equal(params.FeeDenom, resp.MinGasPrice.Denom)
+		s.Require().True(params.MinBaseGasPrice.Equal(resp.MinGasPrice.Amount), resp.MinGasPrice.String())
+	})
+
+	s.Run("returns the congestion multiplier", func() {
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(3)
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		resp, err := s.queryServer.MarketInfo(s.ctx, &types.MarketInfoRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(3), resp.CongestionMultiplier)
+
+		expected, err := s.feeMarketKeeper.CongestionMultiplier(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, resp.CongestionMultiplier)
+	})
+
+	s.Run("the congestion multiplier is zero with a zero min base gas price", func() {
+		zeroFloor := params
+		zeroFloor.MinBaseGasPrice = math.LegacyZeroDec()
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, zeroFloor))
+		defer func() { s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params)) }()
+
+		resp, err := s.queryServer.MarketInfo(s.ctx, &types.MarketInfoRequest{})
+		s.Require().NoError(err)
+		s.Require().True(resp.CongestionMultiplier.IsZero())
+	})
+
+	s.Run("agrees with the params and state queries", func() {
+		resp, err := s.queryServer.MarketInfo(s.ctx, &types.MarketInfoRequest{})
+		s.Require().NoError(err)
+
+		paramsResp, err := s.queryServer.Params(s.ctx, &types.ParamsRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(resp.Params, paramsResp.Params)
+
+		stateResp, err := s.queryServer.State(s.ctx, &types.StateRequest{})
+		s.Require().NoError(err)
+		s.Require().Equal(resp.State, stateResp.State)
+	})
+
+	s.Run("the params query does not read the state", func() {
+		s.feeMarketKeeper.SetRawState(s.ctx, []byte("corrupt"))
+		defer func() { s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state)) }()
+
+		_, err := s.queryServer.Params(s.ctx, &types.ParamsRequest{})
+		s.Require().NoError(err)
+
+		_, err = s.queryServer.State(s.ctx, &types.StateRequest{})
+		s.Require().Error(err)
+		_, err = s.queryServer.MarketInfo(s.ctx, &types.MarketInfoRequest{})
+		s.Require().Error(err)
+	})
 }
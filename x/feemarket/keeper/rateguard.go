@@ -0,0 +1,206 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// GetLastResolverRate returns the last good rate at which the denom resolver converted one
+// unit of the fee denom into the given denom, as recorded at the end of the last block. The
+// boolean is false if no rate has been recorded for the denom. The read is charged, as the
+// ante and post handlers read the rate of every fee paid in a denom other than the fee denom.
+func (k *Keeper) GetLastResolverRate(ctx sdk.Context, denom string) (math.LegacyDec, bool, error) {
+	bz := ctx.KVStore(k.storeKey).Get(types.ResolverRateKey(denom))
+	if bz == nil {
+		return math.LegacyDec{}, false, nil
+	}
+
+	var rate math.LegacyDec
+	if err := rate.Unmarshal(bz); err != nil {
+		return math.LegacyDec{}, false, err
+	}
+
+	return rate, true, nil
+}
+
+// setLastResolverRate records the last good rate at which the denom resolver converted one
+// unit of the fee denom into the given denom.
+func (k *Keeper) setLastResolverRate(ctx sdk.Context, denom string, rate math.LegacyDec) error {
+	bz, err := rate.Marshal()
+	if err != nil {
+		return err
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.ResolverRateKey(denom), bz)
+	return nil
+}
+
+// RecordResolverRates records, at the end of the block, the rate at which the denom resolver
+// converts the fee denom into each of its extra denoms, for the rate guard to compare the
+// next block's conversions against. A rate that moved more than MaxRateChangePerBlock since
+// the last good rate is flagged, and the recorded rate only moves toward it by
+// MaxRateChangePerBlock, so that a genuine move is tracked at a bounded speed while a
+// manipulated rate is never trusted outright. The rates of denoms the resolver no longer
+// returns are removed. Nothing is recorded if the guard is disabled. Conversion failures
+// are logged and skipped so that they never halt the chain.
+func (k *Keeper) RecordResolverRates(ctx sdk.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !rateGuardEnabled(params) {
+		return nil
+	}
+
+	resolver, err := k.denomResolver(ctx)
+	if err != nil {
+		k.Logger(ctx).Error("failed to get the denom resolver for the rate guard", "err", err)
+		return nil
+	}
+
+	if resolver == nil {
+		k.pruneResolverRates(ctx, nil)
+		return nil
+	}
+
+	denoms, err := resolver.ExtraDenoms(ctx)
+	if err != nil {
+		k.Logger(ctx).Info("failed to get extra denoms for the rate guard", "err", err)
+		return nil
+	}
+
+	k.pruneResolverRates(ctx, denoms)
+
+	unit := sdk.NewDecCoinFromDec(params.FeeDenom, math.LegacyOneDec())
+	for _, denom := range denoms {
+		if denom == params.FeeDenom {
+			continue
+		}
+
+		converted, err := k.ResolveToDenom(ctx, unit, denom)
+		if err != nil || !converted.Amount.IsPositive() {
+			k.Logger(ctx).Info("failed to convert the fee denom for the rate guard", "denom", denom, "err", err)
+			continue
+		}
+
+		rate := converted.Amount
+		last, ok, err := k.GetLastResolverRate(ctx, denom)
+		if err != nil {
+			return err
+		}
+
+		if ok && rateMovedTooFar(params, last, rate) {
+			k.flagResolverRate(ctx, denom, rate, last)
+			rate = boundRate(params, last, rate)
+		}
+
+		if err := k.setLastResolverRate(ctx, denom, rate); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// pruneResolverRates removes the recorded rates of all denoms but the given ones.
+func (k *Keeper) pruneResolverRates(ctx sdk.Context, denoms []string) {
+	keep := make(map[string]struct{}, len(denoms))
+	for _, denom := range denoms {
+		keep[denom] = struct{}{}
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixResolverRate)
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		denom := string(iterator.Key()[len(types.KeyPrefixResolverRate):])
+		if _, ok := keep[denom]; !ok {
+			keys = append(keys, iterator.Key())
+		}
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// guardGasPrice returns the gas price converted from baseGasPrice in the fee denom, unless
+// the rate of the conversion moved more than MaxRateChangePerBlock from the last good rate.
+// In that case the conversion is flagged and the gas price is priced at the last good rate
+// instead. Conversions into denoms without a recorded rate are returned unchanged.
+func (k *Keeper) guardGasPrice(
+	ctx sdk.Context,
+	params types.Params,
+	baseGasPrice math.LegacyDec,
+	converted sdk.DecCoin,
+) (sdk.DecCoin, error) {
+	if !rateGuardEnabled(params) || !baseGasPrice.IsPositive() || converted.Denom == params.FeeDenom {
+		return converted, nil
+	}
+
+	last, ok, err := k.GetLastResolverRate(ctx, converted.Denom)
+	if err != nil || !ok {
+		return converted, err
+	}
+
+	rate := converted.Amount.Quo(baseGasPrice)
+	if !rateMovedTooFar(params, last, rate) {
+		return converted, nil
+	}
+
+	k.flagResolverRate(ctx, converted.Denom, rate, last)
+	return sdk.NewDecCoinFromDec(converted.Denom, baseGasPrice.Mul(last)), nil
+}
+
+// flagResolverRate emits an event and logs an error for a resolver rate that moved too fast.
+func (k *Keeper) flagResolverRate(ctx sdk.Context, denom string, rate, last math.LegacyDec) {
+	k.Logger(ctx).Error(
+		"resolver rate moved more than the max rate change per block",
+		"denom", denom,
+		"rate", rate,
+		"last_rate", last,
+	)
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeResolverRateFlagged,
+		sdk.NewAttribute(types.AttributeKeyDenom, denom),
+		sdk.NewAttribute(types.AttributeKeyRate, rate.String()),
+		sdk.NewAttribute(types.AttributeKeyLastRate, last.String()),
+	))
+}
+
+// rateGuardEnabled returns true if the params bound the resolver rate change per block.
+func rateGuardEnabled(params types.Params) bool {
+	return !params.MaxRateChangePerBlock.IsNil() && params.MaxRateChangePerBlock.IsPositive()
+}
+
+// rateMovedTooFar returns true if rate differs from a positive last rate by more than
+// MaxRateChangePerBlock, relative to the last rate.
+func rateMovedTooFar(params types.Params, last, rate math.LegacyDec) bool {
+	if !last.IsPositive() {
+		return false
+	}
+
+	return rate.Sub(last).Abs().Quo(last).GT(params.MaxRateChangePerBlock)
+}
+
+// boundRate returns last moved toward rate by at most MaxRateChangePerBlock, relative to
+// last. If the bound would take the rate to zero or below, rate is returned.
+func boundRate(params types.Params, last, rate math.LegacyDec) math.LegacyDec {
+	step := last.Mul(params.MaxRateChangePerBlock)
+	if rate.GT(last) {
+		return last.Add(step)
+	}
+
+	if bounded := last.Sub(step); bounded.IsPositive() {
+		return bounded
+	}
+
+	return rate
+}
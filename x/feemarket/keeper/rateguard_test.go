@@ -0,0 +1,126 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestResolverRateGuard() {
+	params := types.DefaultParams()
+	params.MaxRateChangePerBlock = math.LegacyMustNewDecFromStr("0.1")
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	resolver := &prospectiveDenomResolver{denom: "uatom", rate: math.LegacyNewDec(10)}
+	s.feeMarketKeeper.SetDenomResolver(resolver)
+	defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+	// flagged returns the resolver rate flagged events emitted on the context.
+	flagged := func(ctx sdk.Context) []sdk.Event {
+		var events []sdk.Event
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type == types.EventTypeResolverRateFlagged {
+				events = append(events, event)
+			}
+		}
+		return events
+	}
+
+	// The first block records the rate.
+	s.Require().NoError(s.feeMarketKeeper.RecordResolverRates(s.ctx.WithBlockHeight(1)))
+	rate, ok, err := s.feeMarketKeeper.GetLastResolverRate(s.ctx, "uatom")
+	s.Require().NoError(err)
+	s.Require().True(ok)
+	s.Require().Equal(math.LegacyNewDec(10), rate)
+
+	s.Run("a normal rate change is accepted", func() {
+		resolver.rate = math.LegacyNewDec(11)
+		ctx := s.ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec("uatom", params.MinBaseGasPrice.MulInt64(11)), gasPrice)
+		s.Require().Empty(flagged(ctx))
+
+		s.Require().NoError(s.feeMarketKeeper.RecordResolverRates(ctx))
+		rate, _, err := s.feeMarketKeeper.GetLastResolverRate(ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyNewDec(11), rate)
+		s.Require().Empty(flagged(ctx))
+	})
+
+	s.Run("an extreme jump is flagged and priced at the last good rate", func() {
+		resolver.rate = math.LegacyNewDec(1_000)
+		ctx := s.ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec("uatom", params.MinBaseGasPrice.MulInt64(11)), gasPrice)
+
+		events := flagged(ctx)
+		s.Require().Len(events, 1)
+		denom, ok := events[0].GetAttribute(types.AttributeKeyDenom)
+		s.Require().True(ok)
+		s.Require().Equal("uatom", denom.Value)
+
+		gasPrices, err := s.feeMarketKeeper.GetMinGasPrices(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(gasPrice, sdk.NewDecCoinFromDec("uatom", gasPrices.AmountOf("uatom")))
+	})
+
+	s.Run("a sustained jump is tracked at the bounded speed", func() {
+		resolver.rate = math.LegacyNewDec(1_000)
+		ctx := s.ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+
+		s.Require().NoError(s.feeMarketKeeper.RecordResolverRates(ctx))
+		rate, _, err := s.feeMarketKeeper.GetLastResolverRate(ctx, "uatom")
+		s.Require().NoError(err)
+		// 11 * (1 + 0.1)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("12.1"), rate)
+		s.Require().Len(flagged(ctx), 1)
+	})
+
+	s.Run("reading the last good rate is charged", func() {
+		ctx := s.ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		_, ok, err := s.feeMarketKeeper.GetLastResolverRate(ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().True(ok)
+		s.Require().Positive(ctx.GasMeter().GasConsumed())
+	})
+
+	s.Run("rates of denoms the resolver no longer returns are removed", func() {
+		resolver.denom = "uosmo"
+		resolver.rate = math.LegacyNewDec(5)
+		defer func() { resolver.denom = "uatom" }()
+
+		s.Require().NoError(s.feeMarketKeeper.RecordResolverRates(s.ctx.WithBlockHeight(4)))
+
+		_, ok, err := s.feeMarketKeeper.GetLastResolverRate(s.ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().False(ok)
+
+		rate, ok, err := s.feeMarketKeeper.GetLastResolverRate(s.ctx, "uosmo")
+		s.Require().NoError(err)
+		s.Require().True(ok)
+		s.Require().Equal(math.LegacyNewDec(5), rate)
+	})
+
+	s.Run("a disabled guard accepts any rate", func() {
+		disabled := params
+		disabled.MaxRateChangePerBlock = math.LegacyZeroDec()
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, disabled))
+		defer func() {
+			s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+		}()
+
+		resolver.rate = math.LegacyNewDec(1_000)
+		ctx := s.ctx.WithBlockHeight(4).WithEventManager(sdk.NewEventManager())
+
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec("uatom", params.MinBaseGasPrice.MulInt64(1_000)), gasPrice)
+		s.Require().Empty(flagged(ctx))
+	})
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// MarshalState encodes the given state as it is written to the store by SetState, so that
+// replay tooling can marshal a state once and write it with SetStateRaw.
+func (k *Keeper) MarshalState(state types.State) ([]byte, error) {
+	return state.Marshal()
+}
+
+// SetStateRaw writes the given pre-marshaled state to the store without re-encoding it. The
+// bytes must decode to a state that passes ValidateBasic, whose index is within its window
+// and whose running window sum matches its window. Unlike SetState, no telemetry, events,
+// price history or hooks are emitted, so it is only suited to reconstructing state, e.g.
+// when replaying blocks.
+func (k *Keeper) SetStateRaw(ctx sdk.Context, bz []byte) error {
+	var state types.State
+	if err := state.Unmarshal(bz); err != nil {
+		return fmt.Errorf("unable to decode raw fee market state: %w", err)
+	}
+
+	if err := state.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid raw fee market state: %w", err)
+	}
+
+	if state.Index >= uint64(len(state.Window)) {
+		return fmt.Errorf("invalid raw fee market state: index %d is outside the window of %d blocks", state.Index, len(state.Window))
+	}
+
+	if sum := state.SumWindow(); sum != state.WindowSum {
+		return fmt.Errorf("invalid raw fee market state: window sum %d does not match the window total %d", state.WindowSum, sum)
+	}
+
+	k.SetStateRawTrusted(ctx, bz)
+	return nil
+}
+
+// SetStateRawTrusted writes the given pre-marshaled state to the store like SetStateRaw, but
+// without decoding and validating it first. Only use it for bytes produced by MarshalState
+// from a valid state.
+func (k *Keeper) SetStateRawTrusted(ctx sdk.Context, bz []byte) {
+	ctx.KVStore(k.storeKey).Set(types.KeyState, bz)
+	k.invalidateMetricsCache()
+}
@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestSetStateRaw() {
+	params := types.DefaultAIMDParams()
+	template := types.NewState(params.Window, params.MinBaseGasPrice.MulInt64(2), params.MinLearningRate)
+
+	s.Run("writes marshaled state", func() {
+		bz, err := s.feeMarketKeeper.MarshalState(template)
+		s.Require().NoError(err)
+		s.Require().NoError(s.feeMarketKeeper.SetStateRaw(s.ctx, bz))
+
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(template, state)
+	})
+
+	s.Run("rejects bytes that do not decode", func() {
+		s.Require().Error(s.feeMarketKeeper.SetStateRaw(s.ctx, []byte{0xff, 0xff, 0xff}))
+
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(template, state)
+	})
+
+	s.Run("rejects a structurally invalid state", func() {
+		invalid := template
+		invalid.Window = nil
+
+		bz, err := s.feeMarketKeeper.MarshalState(invalid)
+		s.Require().NoError(err)
+		s.Require().Error(s.feeMarketKeeper.SetStateRaw(s.ctx, bz))
+	})
+
+	s.Run("rejects an index outside the window", func() {
+		invalid := template
+		invalid.Index = uint64(len(template.Window))
+
+		bz, err := s.feeMarketKeeper.MarshalState(invalid)
+		s.Require().NoError(err)
+		s.Require().ErrorContains(s.feeMarketKeeper.SetStateRaw(s.ctx, bz), "index")
+	})
+
+	s.Run("rejects a window sum that does not match the window", func() {
+		invalid := template
+		invalid.Window = append([]uint64(nil), template.Window...)
+		invalid.Window[0] = 100
+
+		bz, err := s.feeMarketKeeper.MarshalState(invalid)
+		s.Require().NoError(err)
+		s.Require().ErrorContains(s.feeMarketKeeper.SetStateRaw(s.ctx, bz), "window sum")
+
+		invalid.ReconcileWindowSum()
+		bz, err = s.feeMarketKeeper.MarshalState(invalid)
+		s.Require().NoError(err)
+		s.Require().NoError(s.feeMarketKeeper.SetStateRaw(s.ctx, bz))
+	})
+
+	s.Run("trusted input is written unchecked", func() {
+		s.feeMarketKeeper.SetStateRawTrusted(s.ctx, []byte{0xff, 0xff, 0xff})
+
+		_, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().Error(err)
+	})
+}
@@ -0,0 +1,76 @@
+package keeper
+
+import (
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// Report returns a snapshot of the fee market at the current height for audits: the
+// params, state, derived metrics, revenue recorded over the window and health score. The
+// report is deterministic: every list is sorted and every decimal is a LegacyDec with
+// fixed precision, so its encoding is identical across calls at the same height.
+func (k *Keeper) Report(ctx sdk.Context) (types.Report, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	metrics, err := k.DerivedMetrics(ctx)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	warmupFactor, err := k.GetWarmupFactor(ctx, params)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	minGasPrices, err := k.GetMinGasPrices(ctx)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	revenue, totalRevenue, err := k.GetRevenueByMsgType(ctx)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	healthScore, scores, err := k.HealthScore(ctx)
+	if err != nil {
+		return types.Report{}, err
+	}
+
+	components := make([]types.HealthComponent, 0, len(scores))
+	for name, score := range scores {
+		components = append(components, types.HealthComponent{
+			Name:  name,
+			Score: uint32(score),
+		})
+	}
+	sort.Slice(components, func(i, j int) bool {
+		return components[i].Name < components[j].Name
+	})
+
+	return types.Report{
+		Height:               ctx.BlockHeight(),
+		Params:               params,
+		State:                state,
+		BaseGasPrice:         state.BaseGasPrice,
+		CongestionMultiplier: metrics.CongestionMultiplier,
+		WarmupFactor:         warmupFactor,
+		AverageUtilization:   metrics.AverageUtilization,
+		MinGasPrices:         minGasPrices,
+		Revenue:              revenue,
+		TotalRevenue:         totalRevenue,
+		HealthScore:          uint32(healthScore),
+		HealthComponents:     components,
+	}, nil
+}
@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestReport() {
+	params := types.DefaultAIMDParams()
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	state := types.DefaultAIMDState()
+	state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(3)
+	state.Window = []uint64{params.TargetBlockUtilization(), params.MaxBlockUtilization, 0, 1_000, 0, 0, 0, 0}
+	s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+	ctx := s.ctx.WithBlockHeight(10)
+	const (
+		msgSend = "/cosmos.bank.v1beta1.MsgSend"
+		msgVote = "/cosmos.gov.v1.MsgVote"
+	)
+	s.Require().NoError(s.feeMarketKeeper.RecordRevenue(ctx, msgVote, sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 5))))
+	s.Require().NoError(s.feeMarketKeeper.RecordRevenue(ctx, msgSend, sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 10))))
+
+	s.Run("contains the params, state and derived metrics", func() {
+		report, err := s.feeMarketKeeper.Report(ctx)
+		s.Require().NoError(err)
+
+		s.Require().Equal(int64(10), report.Height)
+		s.Require().Equal(params, report.Params)
+		s.Require().Equal(state, report.State)
+		s.Require().Equal(state.BaseGasPrice, report.BaseGasPrice)
+		s.Require().Equal(int64(3), report.CongestionMultiplier.TruncateInt64())
+		s.Require().Equal(state.GetAverageUtilization(params), report.AverageUtilization)
+
+		minGasPrices, err := s.feeMarketKeeper.GetMinGasPrices(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(minGasPrices, report.MinGasPrices)
+
+		revenue, total, err := s.feeMarketKeeper.GetRevenueByMsgType(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(revenue, report.Revenue)
+		s.Require().Equal(total, report.TotalRevenue)
+
+		score, components, err := s.feeMarketKeeper.HealthScore(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint32(score), report.HealthScore)
+		s.Require().Equal([]types.HealthComponent{
+			{Name: keeper.HealthComponentClamping, Score: uint32(components[keeper.HealthComponentClamping])},
+			{Name: keeper.HealthComponentConvergence, Score: uint32(components[keeper.HealthComponentConvergence])},
+			{Name: keeper.HealthComponentFloorProximity, Score: uint32(components[keeper.HealthComponentFloorProximity])},
+			{Name: keeper.HealthComponentResponsiveness, Score: uint32(components[keeper.HealthComponentResponsiveness])},
+		}, report.HealthComponents)
+	})
+
+	s.Run("is byte-identical across repeated calls at the same height", func() {
+		report, err := s.feeMarketKeeper.Report(ctx)
+		s.Require().NoError(err)
+		expected, err := report.Marshal()
+		s.Require().NoError(err)
+
+		for i := 0; i < 10; i++ {
+			report, err := s.feeMarketKeeper.Report(ctx)
+			s.Require().NoError(err)
+
+			bz, err := report.Marshal()
+			s.Require().NoError(err)
+			s.Require().Equal(expected, bz)
+		}
+	})
+}
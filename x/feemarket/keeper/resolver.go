@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// resolverConsistencyTestAmount is the amount of denomA round-tripped through the
+// resolver by CheckResolverConsistency.
+var resolverConsistencyTestAmount = math.LegacyNewDec(1_000_000)
+
+// CheckResolverConsistency is an operator diagnostic which converts a test amount of
+// denomA to denomB and back using the denom resolver, and reports whether the result is
+// within the given relative tolerance of the original amount.
+func (k *Keeper) CheckResolverConsistency(ctx sdk.Context, denomA, denomB string, tolerance math.LegacyDec) (bool, error) {
+	if tolerance.IsNil() || tolerance.IsNegative() {
+		return false, fmt.Errorf("tolerance cannot be nil or negative")
+	}
+
+	original := sdk.NewDecCoinFromDec(denomA, resolverConsistencyTestAmount)
+
+	converted, err := k.ResolveToDenom(ctx, original, denomB)
+	if err != nil {
+		return false, err
+	}
+
+	roundTrip, err := k.ResolveToDenom(ctx, converted, denomA)
+	if err != nil {
+		return false, err
+	}
+
+	if roundTrip.Denom != denomA {
+		return false, nil
+	}
+
+	deviation := roundTrip.Amount.Sub(original.Amount).Abs().Quo(original.Amount)
+	return deviation.LTE(tolerance), nil
+}
+
+// PreviewDenom returns the current base gas price resolved into denom with the configured
+// denom resolver, regardless of whether denom is currently accepted for fees. This lets
+// operators check the effective price in a prospective fee denom before enabling it.
+func (k *Keeper) PreviewDenom(ctx sdk.Context, denom string) (sdk.DecCoin, error) {
+	resolver, err := k.denomResolver(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	if resolver == nil {
+		return sdk.DecCoin{}, types.ErrResolverNotSet
+	}
+
+	return k.PreviewDenomWithResolver(ctx, denom, resolver)
+}
+
+// PreviewDenomWithResolver is PreviewDenom using the given resolver, e.g. a resolver that
+// is about to be rolled out to accept denom, instead of the configured one. An error is
+// returned if the base gas price cannot be converted into denom or converts to a zero
+// price, which would make txs paying in denom free.
+func (k *Keeper) PreviewDenomWithResolver(ctx sdk.Context, denom string, resolver types.DenomResolver) (sdk.DecCoin, error) {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	baseGasPrice, err := k.GetBaseGasPrice(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	gasPrice := sdk.NewDecCoinFromDec(params.FeeDenom, baseGasPrice)
+	if denom == params.FeeDenom {
+		return gasPrice, nil
+	}
+
+	converted, err := resolver.ConvertToDenom(ctx, gasPrice, denom)
+	if err != nil {
+		return sdk.DecCoin{}, fmt.Errorf("unable to convert base gas price %s to %s: %w", gasPrice, denom, err)
+	}
+
+	if converted.Denom != denom {
+		return sdk.DecCoin{}, fmt.Errorf("resolver converted base gas price %s to %s; expected denom %s", gasPrice, converted, denom)
+	}
+
+	if gasPrice.IsPositive() && !converted.IsPositive() {
+		return sdk.DecCoin{}, fmt.Errorf("base gas price %s converts to a zero gas price in %s", gasPrice, denom)
+	}
+
+	return converted, nil
+}
+
+// ValidateResolverCoverage returns the denoms in acceptedDenoms that the configured denom
+// resolver cannot currently convert the base gas price into, in the order they are given.
+// Txs paying fees in these denoms would be rejected, so operators can catch gaps in the
+// resolver before users do. The fee denom is always covered.
+func (k *Keeper) ValidateResolverCoverage(ctx sdk.Context, acceptedDenoms []string) ([]string, error) {
+	resolver, err := k.denomResolver(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if resolver == nil {
+		return nil, types.ErrResolverNotSet
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	baseGasPrice, err := k.GetBaseGasPrice(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := sdk.NewDecCoinFromDec(params.FeeDenom, baseGasPrice)
+
+	seen := make(map[string]struct{}, len(acceptedDenoms))
+	var uncovered []string
+	for _, denom := range acceptedDenoms {
+		if _, ok := seen[denom]; ok || denom == params.FeeDenom {
+			continue
+		}
+		seen[denom] = struct{}{}
+
+		converted, err := resolver.ConvertToDenom(ctx, gasPrice, denom)
+		if err != nil || converted.Denom != denom {
+			uncovered = append(uncovered, denom)
+		}
+	}
+
+	return uncovered, nil
+}
+
+// RealFloor returns the MinBaseGasPrice floor expressed in referenceDenom, e.g. a stable
+// denom, using the configured denom resolver. As the fee denom loses value against the
+// reference, the real value of the nominal floor drifts down, so governance can track it
+// to decide when to adjust the floor.
+func (k *Keeper) RealFloor(ctx sdk.Context, referenceDenom string) (sdk.DecCoin, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	floor := sdk.NewDecCoinFromDec(params.FeeDenom, params.MinBaseGasPrice)
+	if referenceDenom == params.FeeDenom {
+		return floor, nil
+	}
+
+	realFloor, err := k.ResolveToDenom(ctx, floor, referenceDenom)
+	if err != nil {
+		return sdk.DecCoin{}, fmt.Errorf("unable to convert floor %s to %s: %w", floor, referenceDenom, err)
+	}
+
+	if realFloor.Denom != referenceDenom {
+		return sdk.DecCoin{}, fmt.Errorf("resolver converted floor %s to %s; expected denom %s", floor, realFloor, referenceDenom)
+	}
+
+	return realFloor, nil
+}
+
+// CheapestFeeDenom returns the fee owed for the given gas limit (see GetRequiredFee) in
+// whichever of the candidate denoms has the smallest real cost, i.e. the fee converted back
+// into the fee denom with the denom resolver. Ties are broken by the smallest denom, so the
+// result does not depend on the order of the candidates. Candidates whose fee cannot be
+// resolved are skipped, and an error is returned only if none of them can be.
+func (k *Keeper) CheapestFeeDenom(ctx sdk.Context, gasLimit uint64, candidates []string) (sdk.Coin, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	denoms := make([]string, len(candidates))
+	copy(denoms, candidates)
+	sort.Strings(denoms)
+
+	var (
+		cheapest     sdk.Coin
+		cheapestCost math.LegacyDec
+	)
+	for _, denom := range denoms {
+		fee, err := k.GetRequiredFee(ctx, gasLimit, denom)
+		if err != nil {
+			continue
+		}
+
+		cost := sdk.NewDecCoinFromCoin(fee)
+		if denom != params.FeeDenom {
+			cost, err = k.ResolveToDenom(ctx, cost, params.FeeDenom)
+			if err != nil || cost.Denom != params.FeeDenom {
+				continue
+			}
+		}
+
+		if cheapestCost.IsNil() || cost.Amount.LT(cheapestCost) {
+			cheapest, cheapestCost = fee, cost.Amount
+		}
+	}
+
+	if cheapestCost.IsNil() {
+		return sdk.Coin{}, fmt.Errorf("unable to resolve the fee for gas limit %d into any of the candidate denoms %v", gasLimit, candidates)
+	}
+
+	return cheapest, nil
+}
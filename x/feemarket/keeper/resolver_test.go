@@ -0,0 +1,334 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/skip-mev/feemarket/x/feemarket/types/mocks"
+)
+
+// skewedDenomResolver converts every coin at a 2:1 rate regardless of direction, so a
+// round trip quadruples the original amount.
+type skewedDenomResolver struct{}
+
+func (r *skewedDenomResolver) ConvertToDenom(_ sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	return sdk.NewDecCoinFromDec(denom, coin.Amount.MulInt64(2)), nil
+}
+
+func (r *skewedDenomResolver) ExtraDenoms(_ sdk.Context) ([]string, error) {
+	return []string{}, nil
+}
+
+// prospectiveDenomResolver converts fee denom coins into a single new denom at a fixed rate
+// and fails for any other denom.
+type prospectiveDenomResolver struct {
+	denom string
+	rate  math.LegacyDec
+}
+
+func (r *prospectiveDenomResolver) ConvertToDenom(_ sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	if denom != r.denom {
+		return sdk.DecCoin{}, fmt.Errorf("no price for %s", denom)
+	}
+
+	return sdk.NewDecCoinFromDec(denom, coin.Amount.Mul(r.rate)), nil
+}
+
+func (r *prospectiveDenomResolver) ExtraDenoms(_ sdk.Context) ([]string, error) {
+	return []string{r.denom}, nil
+}
+
+func (s *KeeperTestSuite) TestCheckResolverConsistency() {
+	s.Run("consistent resolver passes", func() {
+		s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+		ok, err := s.feeMarketKeeper.CheckResolverConsistency(s.ctx, types.DefaultFeeDenom, "atom", math.LegacyZeroDec())
+		s.Require().NoError(err)
+		s.Require().True(ok)
+	})
+
+	s.Run("inconsistent resolver fails", func() {
+		s.feeMarketKeeper.SetDenomResolver(&skewedDenomResolver{})
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+		ok, err := s.feeMarketKeeper.CheckResolverConsistency(s.ctx, types.DefaultFeeDenom, "atom", math.LegacyMustNewDecFromStr("0.01"))
+		s.Require().NoError(err)
+		s.Require().False(ok)
+
+		// A round trip quadruples the amount, a deviation of 300%.
+		ok, err = s.feeMarketKeeper.CheckResolverConsistency(s.ctx, types.DefaultFeeDenom, "atom", math.LegacyNewDec(3))
+		s.Require().NoError(err)
+		s.Require().True(ok)
+	})
+
+	s.Run("resolver errors are returned", func() {
+		s.feeMarketKeeper.SetDenomResolver(&types.ErrorDenomResolver{})
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+		_, err := s.feeMarketKeeper.CheckResolverConsistency(s.ctx, types.DefaultFeeDenom, "atom", math.LegacyZeroDec())
+		s.Require().Error(err)
+	})
+
+	s.Run("negative tolerance returns an error", func() {
+		_, err := s.feeMarketKeeper.CheckResolverConsistency(s.ctx, types.DefaultFeeDenom, "atom", math.LegacyNewDec(-1))
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestPreviewDenom() {
+	gs := types.DefaultGenesisState()
+	gs.Params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.025")
+	gs.State.BaseGasPrice = math.LegacyMustNewDecFromStr("0.025")
+	s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+	resolver := &prospectiveDenomResolver{denom: "unewdenom", rate: math.LegacyNewDec(40)}
+
+	s.Run("resolves the base gas price with a provided resolver", func() {
+		gasPrice, err := s.feeMarketKeeper.PreviewDenomWithResolver(s.ctx, "unewdenom", resolver)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec("unewdenom", math.LegacyOneDec()), gasPrice)
+	})
+
+	s.Run("resolves the base gas price with the configured resolver", func() {
+		s.feeMarketKeeper.SetDenomResolver(resolver)
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+		gasPrice, err := s.feeMarketKeeper.PreviewDenom(s.ctx, "unewdenom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec("unewdenom", math.LegacyOneDec()), gasPrice)
+	})
+
+	s.Run("the fee denom previews the base gas price", func() {
+		gasPrice, err := s.feeMarketKeeper.PreviewDenomWithResolver(s.ctx, gs.Params.FeeDenom, resolver)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec(gs.Params.FeeDenom, gs.State.BaseGasPrice), gasPrice)
+	})
+
+	s.Run("a denom the resolver cannot convert returns an error", func() {
+		_, err := s.feeMarketKeeper.PreviewDenomWithResolver(s.ctx, "uother", resolver)
+		s.Require().ErrorContains(err, "unable to convert base gas price")
+	})
+
+	s.Run("a zero converted price returns an error", func() {
+		zeroResolver := &prospectiveDenomResolver{denom: "unewdenom", rate: math.LegacyZeroDec()}
+
+		_, err := s.feeMarketKeeper.PreviewDenomWithResolver(s.ctx, "unewdenom", zeroResolver)
+		s.Require().ErrorContains(err, "zero gas price")
+	})
+}
+
+// rateDenomResolver converts between the fee denom and the denoms it has a rate for, in
+// units of the denom per unit of the fee denom, and fails for any other denom.
+type rateDenomResolver struct {
+	rates map[string]math.LegacyDec
+}
+
+func (r *rateDenomResolver) ConvertToDenom(_ sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	if rate, ok := r.rates[denom]; ok && coin.Denom == types.DefaultFeeDenom {
+		return sdk.NewDecCoinFromDec(denom, coin.Amount.Mul(rate)), nil
+	}
+
+	if rate, ok := r.rates[coin.Denom]; ok && denom == types.DefaultFeeDenom {
+		return sdk.NewDecCoinFromDec(denom, coin.Amount.Quo(rate)), nil
+	}
+
+	return sdk.DecCoin{}, fmt.Errorf("no price for %s", denom)
+}
+
+func (r *rateDenomResolver) ExtraDenoms(_ sdk.Context) ([]string, error) {
+	denoms := make([]string, 0, len(r.rates))
+	for denom := range r.rates {
+		denoms = append(denoms, denom)
+	}
+
+	return denoms, nil
+}
+
+func (s *KeeperTestSuite) TestCheapestFeeDenom() {
+	const gasLimit = 1_000_000
+
+	s.feeMarketKeeper.SetDenomResolver(&rateDenomResolver{rates: map[string]math.LegacyDec{
+		"uatom": math.LegacyNewDec(2),
+		"uosmo": math.LegacyNewDec(4),
+	}})
+	defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+	s.Run("breaks ties in the real cost by the smallest denom", func() {
+		for _, candidates := range [][]string{
+			{types.DefaultFeeDenom, "uatom", "uosmo"},
+			{"uosmo", "uatom", types.DefaultFeeDenom},
+		} {
+			expected, err := s.feeMarketKeeper.GetRequiredFee(s.ctx, gasLimit, types.DefaultFeeDenom)
+			s.Require().NoError(err)
+
+			fee, err := s.feeMarketKeeper.CheapestFeeDenom(s.ctx, gasLimit, candidates)
+			s.Require().NoError(err)
+			s.Require().Equal(expected, fee)
+		}
+	})
+
+	s.Run("returns the denom with the smallest real cost", func() {
+		// Raise the price in the fee denom and in uatom above the converted base gas price.
+		price, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, types.DefaultFeeDenom)
+		s.Require().NoError(err)
+		s.Require().NoError(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, types.DefaultFeeDenom, price.Amount.MulInt64(2)))
+		s.Require().NoError(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, "uatom", price.Amount.MulInt64(4)))
+		defer func() {
+			s.Require().NoError(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, types.DefaultFeeDenom, math.LegacyZeroDec()))
+			s.Require().NoError(s.feeMarketKeeper.SetDenomMinGasPrice(s.ctx, "uatom", math.LegacyZeroDec()))
+		}()
+
+		expected, err := s.feeMarketKeeper.GetRequiredFee(s.ctx, gasLimit, "uosmo")
+		s.Require().NoError(err)
+
+		fee, err := s.feeMarketKeeper.CheapestFeeDenom(s.ctx, gasLimit, []string{types.DefaultFeeDenom, "uatom", "uosmo"})
+		s.Require().NoError(err)
+		s.Require().Equal(expected, fee)
+		s.Require().Equal("uosmo", fee.Denom)
+	})
+
+	s.Run("skips the denoms that cannot be resolved", func() {
+		fee, err := s.feeMarketKeeper.CheapestFeeDenom(s.ctx, gasLimit, []string{"ujuno", "uosmo"})
+		s.Require().NoError(err)
+		s.Require().Equal("uosmo", fee.Denom)
+	})
+
+	s.Run("errors if none of the denoms can be resolved", func() {
+		_, err := s.feeMarketKeeper.CheapestFeeDenom(s.ctx, gasLimit, []string{"ujuno", "uusdc"})
+		s.Require().ErrorContains(err, "ujuno")
+
+		_, err = s.feeMarketKeeper.CheapestFeeDenom(s.ctx, gasLimit, nil)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestResolveToDenomFullPrecision() {
+	metadataKeeper := mocks.NewDenomMetadataKeeper(s.T())
+	metadataKeeper.On("GetDenomMetaData", mock.Anything, mock.Anything).Return(banktypes.Metadata{
+		Base:    "uatom",
+		Display: "atom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "uatom", Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+	}, true).Maybe()
+	s.feeMarketKeeper.SetDenomMetadataKeeper(metadataKeeper)
+	defer s.feeMarketKeeper.SetDenomMetadataKeeper(nil)
+
+	rate := math.LegacyMustNewDecFromStr("0.000000333333333333")
+	s.feeMarketKeeper.SetDenomResolver(&rateDenomResolver{rates: map[string]math.LegacyDec{"uatom": rate}})
+	defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+	coin := sdk.NewDecCoinFromDec(types.DefaultFeeDenom, math.LegacyOneDec())
+	expected := sdk.NewDecCoinFromDec("uatom", rate)
+
+	s.Run("converted prices are not rounded to the denom precision", func() {
+		converted, err := s.feeMarketKeeper.ResolveToDenom(s.ctx, coin, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(expected, converted)
+
+		// The cached conversion keeps the full precision alike, both on a miss and on a hit.
+		for i := 0; i < 2; i++ {
+			converted, err = s.feeMarketKeeper.ResolveToDenomCached(s.ctx, coin, "uatom")
+			s.Require().NoError(err)
+			s.Require().Equal(expected, converted)
+		}
+	})
+
+	s.Run("the fee is rounded once", func() {
+		converted, err := s.feeMarketKeeper.ResolveToDenom(s.ctx, coin, "uatom")
+		s.Require().NoError(err)
+
+		// 3,000,000 gas at 0.000000333333333333 costs 0.999999999999999, which is rounded
+		// up to 1. Rounding the price up to 0.000001 first would have charged 3.
+		fee := types.ComputeFee(converted, 3_000_000, types.RoundingModeCeil)
+		s.Require().Equal(sdk.NewInt64Coin("uatom", 1), fee)
+	})
+}
+
+func (s *KeeperTestSuite) TestValidateResolverCoverage() {
+	s.feeMarketKeeper.SetDenomResolver(&prospectiveDenomResolver{denom: "uatom", rate: math.LegacyNewDec(2)})
+	defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+	s.Run("returns the accepted denoms the resolver cannot convert", func() {
+		uncovered, err := s.feeMarketKeeper.ValidateResolverCoverage(s.ctx, []string{types.DefaultFeeDenom, "uatom", "uosmo"})
+		s.Require().NoError(err)
+		s.Require().Equal([]string{"uosmo"}, uncovered)
+	})
+
+	s.Run("full coverage returns no denoms", func() {
+		uncovered, err := s.feeMarketKeeper.ValidateResolverCoverage(s.ctx, []string{types.DefaultFeeDenom, "uatom"})
+		s.Require().NoError(err)
+		s.Require().Empty(uncovered)
+	})
+
+	s.Run("duplicate denoms are reported once", func() {
+		uncovered, err := s.feeMarketKeeper.ValidateResolverCoverage(s.ctx, []string{"uosmo", "ujuno", "uosmo"})
+		s.Require().NoError(err)
+		s.Require().Equal([]string{"uosmo", "ujuno"}, uncovered)
+	})
+
+	s.Run("no resolver returns an error", func() {
+		s.feeMarketKeeper.SetDenomResolver(nil)
+
+		_, err := s.feeMarketKeeper.ValidateResolverCoverage(s.ctx, []string{"uatom"})
+		s.Require().ErrorIs(err, types.ErrResolverNotSet)
+	})
+}
+
+func (s *KeeperTestSuite) TestRealFloor() {
+	params, err := s.feeMarketKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.01")
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+	s.Run("expresses the floor at the exchange rate to the reference denom", func() {
+		s.feeMarketKeeper.SetDenomResolver(&prospectiveDenomResolver{denom: "uusdc", rate: math.LegacyMustNewDecFromStr("0.5")})
+
+		floor, err := s.feeMarketKeeper.RealFloor(s.ctx, "uusdc")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec("uusdc", math.LegacyMustNewDecFromStr("0.005")), floor)
+	})
+
+	s.Run("the real floor drifts down as the fee denom loses value", func() {
+		s.feeMarketKeeper.SetDenomResolver(&prospectiveDenomResolver{denom: "uusdc", rate: math.LegacyMustNewDecFromStr("0.25")})
+
+		floor, err := s.feeMarketKeeper.RealFloor(s.ctx, "uusdc")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec("uusdc", math.LegacyMustNewDecFromStr("0.0025")), floor)
+	})
+
+	s.Run("the floor in the fee denom is the nominal floor", func() {
+		floor, err := s.feeMarketKeeper.RealFloor(s.ctx, params.FeeDenom)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewDecCoinFromDec(params.FeeDenom, params.MinBaseGasPrice), floor)
+	})
+
+	s.Run("errors if the reference denom cannot be resolved", func() {
+		_, err := s.feeMarketKeeper.RealFloor(s.ctx, "uatom")
+		s.Require().Error(err)
+
+		s.feeMarketKeeper.SetDenomResolver(nil)
+		_, err = s.feeMarketKeeper.RealFloor(s.ctx, "uusdc")
+		s.Require().ErrorIs(err, types.ErrResolverNotSet)
+	})
+}
@@ -0,0 +1,97 @@
+package keeper
+
+import (
+	"sync"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// resolverCacheKey identifies a cached conversion rate from one denom to another.
+type resolverCacheKey struct {
+	from string
+	to   string
+}
+
+// resolverCache memoizes the conversion rates returned by the denom resolver for the
+// duration of a block. Rates are kept separately per execution mode, so that rates seen
+// while checking txs against the committed state never leak into block execution, and are
+// dropped as soon as a different height is seen.
+type resolverCache struct {
+	mu       sync.Mutex
+	height   int64
+	execMode sdk.ExecMode
+	rates    map[resolverCacheKey]math.LegacyDec
+}
+
+func newResolverCache() *resolverCache {
+	return &resolverCache{rates: make(map[resolverCacheKey]math.LegacyDec)}
+}
+
+// get returns the cached rate from one denom to another at the context's height and
+// execution mode, if any.
+func (c *resolverCache) get(ctx sdk.Context, from, to string) (math.LegacyDec, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.invalidateIfStale(ctx)
+	rate, ok := c.rates[resolverCacheKey{from: from, to: to}]
+	return rate, ok
+}
+
+// set caches the rate from one denom to another at the context's height and execution
+// mode.
+func (c *resolverCache) set(ctx sdk.Context, from, to string, rate math.LegacyDec) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.invalidateIfStale(ctx)
+	c.rates[resolverCacheKey{from: from, to: to}] = rate
+}
+
+// clear drops all cached rates, e.g. once a different denom resolver is selected.
+func (c *resolverCache) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.rates = make(map[resolverCacheKey]math.LegacyDec)
+}
+
+// invalidateIfStale drops all cached rates if they were cached at a different height or
+// execution mode than the context's. The caller must hold the lock.
+func (c *resolverCache) invalidateIfStale(ctx sdk.Context) {
+	if c.height == ctx.BlockHeight() && c.execMode == ctx.ExecMode() {
+		return
+	}
+
+	c.height = ctx.BlockHeight()
+	c.execMode = ctx.ExecMode()
+	c.rates = make(map[resolverCacheKey]math.LegacyDec)
+}
+
+// ResolveToDenomCached is ResolveToDenom with the conversion rate from coin's denom to the
+// given denom memoized for the rest of the block. On a miss, the coin is converted by the
+// resolver and the rate it implies is cached; later conversions between the same denoms in
+// the same block apply the cached rate instead of calling the resolver. This assumes the
+// resolver converts at a linear rate that does not change within a block. Errors are not
+// cached. Unless the cache is enabled with SetResolverCache, every call goes to the resolver.
+func (k *Keeper) ResolveToDenomCached(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if k.resolverCache == nil {
+		return k.ResolveToDenom(ctx, coin, denom)
+	}
+
+	if rate, ok := k.resolverCache.get(ctx, coin.Denom, denom); ok {
+		return sdk.NewDecCoinFromDec(denom, coin.Amount.Mul(rate)), nil
+	}
+
+	converted, err := k.ResolveToDenom(ctx, coin, denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	if coin.Amount.IsPositive() && converted.Denom == denom {
+		k.resolverCache.set(ctx, coin.Denom, denom, converted.Amount.Quo(coin.Amount))
+	}
+
+	return converted, nil
+}
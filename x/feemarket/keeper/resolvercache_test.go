@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	testkeeper "github.com/skip-mev/feemarket/testutils/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// countingDenomResolver converts every coin at a configurable rate and counts the
+// conversions it performs.
+type countingDenomResolver struct {
+	rate  math.LegacyDec
+	calls int
+}
+
+func (r *countingDenomResolver) ConvertToDenom(_ sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	r.calls++
+	return sdk.NewDecCoinFromDec(denom, coin.Amount.Mul(r.rate)), nil
+}
+
+func (r *countingDenomResolver) ExtraDenoms(_ sdk.Context) ([]string, error) {
+	return []string{}, nil
+}
+
+func (s *KeeperTestSuite) TestResolveToDenomCached() {
+	s.feeMarketKeeper.SetResolverCache(true)
+	defer s.feeMarketKeeper.SetResolverCache(false)
+	defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+	coin := func(amount int64) sdk.DecCoin {
+		return sdk.NewInt64DecCoin(types.DefaultFeeDenom, amount)
+	}
+
+	s.Run("conversions within a block are memoized", func() {
+		resolver := &countingDenomResolver{rate: math.LegacyNewDec(2)}
+		s.feeMarketKeeper.SetDenomResolver(resolver)
+
+		ctx := s.ctx.WithBlockHeight(10).WithExecMode(sdk.ExecModeFinalize)
+		converted, err := s.feeMarketKeeper.ResolveToDenomCached(ctx, coin(5), "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64DecCoin("uatom", 10), converted)
+
+		// The rate changes within the block, but the cached rate is applied.
+		resolver.rate = math.LegacyNewDec(3)
+		converted, err = s.feeMarketKeeper.ResolveToDenomCached(ctx, coin(7), "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64DecCoin("uatom", 14), converted)
+		s.Require().Equal(1, resolver.calls)
+
+		// A different pair of denoms misses.
+		_, err = s.feeMarketKeeper.ResolveToDenomCached(ctx, coin(7), "uosmo")
+		s.Require().NoError(err)
+		s.Require().Equal(2, resolver.calls)
+
+		// The next block sees the new rate.
+		converted, err = s.feeMarketKeeper.ResolveToDenomCached(ctx.WithBlockHeight(11), coin(7), "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64DecCoin("uatom", 21), converted)
+		s.Require().Equal(3, resolver.calls)
+	})
+
+	s.Run("rates are not shared between execution modes", func() {
+		resolver := &countingDenomResolver{rate: math.LegacyNewDec(2)}
+		s.feeMarketKeeper.SetDenomResolver(resolver)
+
+		ctx := s.ctx.WithBlockHeight(10)
+		_, err := s.feeMarketKeeper.ResolveToDenomCached(ctx.WithExecMode(sdk.ExecModeCheck), coin(5), "uatom")
+		s.Require().NoError(err)
+
+		resolver.rate = math.LegacyNewDec(3)
+		converted, err := s.feeMarketKeeper.ResolveToDenomCached(ctx.WithExecMode(sdk.ExecModeFinalize), coin(5), "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64DecCoin("uatom", 15), converted)
+		s.Require().Equal(2, resolver.calls)
+	})
+
+	s.Run("setting a new resolver drops the cached rates", func() {
+		ctx := s.ctx.WithBlockHeight(10)
+		s.feeMarketKeeper.SetDenomResolver(&countingDenomResolver{rate: math.LegacyNewDec(2)})
+		_, err := s.feeMarketKeeper.ResolveToDenomCached(ctx, coin(5), "uatom")
+		s.Require().NoError(err)
+
+		resolver := &countingDenomResolver{rate: math.LegacyNewDec(3)}
+		s.feeMarketKeeper.SetDenomResolver(resolver)
+		converted, err := s.feeMarketKeeper.ResolveToDenomCached(ctx, coin(5), "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64DecCoin("uatom", 15), converted)
+		s.Require().Equal(1, resolver.calls)
+	})
+
+	s.Run("a disabled cache always calls the resolver", func() {
+		s.feeMarketKeeper.SetResolverCache(false)
+		defer s.feeMarketKeeper.SetResolverCache(true)
+
+		resolver := &countingDenomResolver{rate: math.LegacyNewDec(2)}
+		s.feeMarketKeeper.SetDenomResolver(resolver)
+
+		ctx := s.ctx.WithBlockHeight(10)
+		for i := 0; i < 3; i++ {
+			_, err := s.feeMarketKeeper.ResolveToDenomCached(ctx, coin(5), "uatom")
+			s.Require().NoError(err)
+		}
+		s.Require().Equal(3, resolver.calls)
+	})
+
+	s.Run("errors are not cached", func() {
+		s.feeMarketKeeper.SetDenomResolver(&types.ErrorDenomResolver{})
+
+		ctx := s.ctx.WithBlockHeight(10)
+		_, err := s.feeMarketKeeper.ResolveToDenomCached(ctx, coin(5), "uatom")
+		s.Require().Error(err)
+		_, err = s.feeMarketKeeper.ResolveToDenomCached(ctx, coin(5), "uatom")
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestResolverCacheDisabledByDefault() {
+	ctx, tk, _ := testkeeper.NewTestSetup(s.T())
+	resolver := &countingDenomResolver{rate: math.LegacyNewDec(2)}
+	tk.FeeMarketKeeper.SetDenomResolver(resolver)
+
+	ctx = ctx.WithBlockHeight(10)
+	for i := 0; i < 2; i++ {
+		_, err := tk.FeeMarketKeeper.ResolveToDenomCached(ctx, sdk.NewInt64DecCoin(types.DefaultFeeDenom, 5), "uatom")
+		s.Require().NoError(err)
+	}
+	s.Require().Equal(2, resolver.calls)
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// RegisterDenomResolver registers a denom resolver under the given name, so that governance
+// can select it with MsgSetResolver. Resolvers must be registered on every node before the
+// app starts, e.g. in the app constructor, as the selection is resolved by name. An error is
+// returned if the name is empty or already registered.
+func (k *Keeper) RegisterDenomResolver(name string, resolver types.DenomResolver) error {
+	if name == "" {
+		return fmt.Errorf("denom resolver name cannot be empty")
+	}
+
+	if _, ok := k.denomResolvers[name]; ok {
+		return fmt.Errorf("denom resolver %s is already registered", name)
+	}
+
+	k.denomResolvers[name] = resolver
+	return nil
+}
+
+// SetDenomResolverByName selects the denom resolver registered under the given name with
+// RegisterDenomResolver and persists the selection, which binds the resolver for the rest of
+// the block and after restarts. The selection takes precedence over the resolver set with
+// SetDenomResolver. Any conversion rates cached from the previous resolver are dropped.
+func (k *Keeper) SetDenomResolverByName(ctx sdk.Context, name string) error {
+	if _, ok := k.denomResolvers[name]; !ok {
+		return types.ErrUnknownDenomResolver.Wrapf("unknown denom resolver %q", name)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.KeyDenomResolverName, []byte(name))
+	if k.resolverCache != nil {
+		k.resolverCache.clear()
+	}
+
+	return nil
+}
+
+// GetDenomResolverName returns the name of the denom resolver selected with
+// SetDenomResolverByName, or an empty name if none was ever selected.
+func (k *Keeper) GetDenomResolverName(ctx sdk.Context) string {
+	return string(k.getUnmetered(ctx, types.KeyDenomResolverName))
+}
+
+// denomResolver returns the denom resolver the fee market converts fees with: the resolver
+// selected with SetDenomResolverByName, bound from the store so that it is in effect from
+// startup, or the resolver set with SetDenomResolver if none was ever selected. An error is
+// returned if the selected resolver is not registered on this node, rather than converting
+// fees with a different resolver than the rest of the network.
+func (k *Keeper) denomResolver(ctx sdk.Context) (types.DenomResolver, error) {
+	name := k.GetDenomResolverName(ctx)
+	if name == "" {
+		return k.resolver, nil
+	}
+
+	resolver, ok := k.denomResolvers[name]
+	if !ok {
+		return nil, types.ErrUnknownDenomResolver.Wrapf("selected denom resolver %q is not registered on this node", name)
+	}
+
+	return resolver, nil
+}
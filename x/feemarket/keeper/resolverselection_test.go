@@ -0,0 +1,73 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestSetResolver() {
+	s.Require().NoError(s.feeMarketKeeper.RegisterDenomResolver("keeper-test-oracle", &rateDenomResolver{rates: map[string]math.LegacyDec{
+		"uatom": math.LegacyNewDec(2),
+	}}))
+
+	price, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, types.DefaultFeeDenom)
+	s.Require().NoError(err)
+
+	s.Run("uses the resolver set in code if none was ever selected", func() {
+		s.Require().Empty(s.feeMarketKeeper.GetDenomResolverName(s.ctx))
+
+		// The test resolver converts one to one.
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(price.Amount, gasPrice.Amount)
+	})
+
+	s.Run("rejects a message from another signer", func() {
+		msg := types.NewMsgSetResolver(sdk.AccAddress("invalid").String(), "keeper-test-oracle")
+		_, err := s.msgServer.SetResolver(s.ctx, &msg)
+		s.Require().Error(err)
+		s.Require().Empty(s.feeMarketKeeper.GetDenomResolverName(s.ctx))
+	})
+
+	s.Run("rejects an unknown resolver", func() {
+		msg := types.NewMsgSetResolver(s.authorityAccount.String(), "unknown")
+		_, err := s.msgServer.SetResolver(s.ctx, &msg)
+		s.Require().Error(err)
+		s.Require().Empty(s.feeMarketKeeper.GetDenomResolverName(s.ctx))
+	})
+
+	s.Run("binds the selected resolver", func() {
+		msg := types.NewMsgSetResolver(s.authorityAccount.String(), "keeper-test-oracle")
+		_, err := s.msgServer.SetResolver(s.ctx, &msg)
+		s.Require().NoError(err)
+		s.Require().Equal("keeper-test-oracle", s.feeMarketKeeper.GetDenomResolverName(s.ctx))
+
+		gasPrice, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, "uatom")
+		s.Require().NoError(err)
+		s.Require().Equal(price.Amount.MulInt64(2), gasPrice.Amount)
+	})
+
+	s.Run("the selection is bound from the store, e.g. after a restart", func() {
+		s.feeMarketKeeper.SetDenomResolver(nil)
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+		resp, err := s.queryServer.GasPrice(s.ctx, &types.GasPriceRequest{Denom: "uatom"})
+		s.Require().NoError(err)
+		s.Require().Equal(price.Amount.MulInt64(2), resp.Price.Amount)
+	})
+
+	s.Run("errors if the selected resolver is not registered on this node", func() {
+		s.feeMarketKeeper.UnregisterDenomResolver("keeper-test-oracle")
+
+		_, err := s.feeMarketKeeper.GetMinGasPrice(s.ctx, "uatom")
+		s.Require().ErrorIs(err, types.ErrUnknownDenomResolver)
+	})
+}
+
+func (s *KeeperTestSuite) TestRegisterDenomResolver() {
+	s.Require().Error(s.feeMarketKeeper.RegisterDenomResolver("", &types.TestDenomResolver{}))
+	s.Require().NoError(s.feeMarketKeeper.RegisterDenomResolver("static", &types.TestDenomResolver{}))
+	s.Require().Error(s.feeMarketKeeper.RegisterDenomResolver("static", &types.TestDenomResolver{}))
+}
@@ -0,0 +1,345 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// RecordRevenue adds the given fees to the revenue collected at the current height from
+// transactions whose primary message is of the given type.
+func (k *Keeper) RecordRevenue(ctx sdk.Context, msgTypeURL string, fees sdk.Coins) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.RevenueKey(ctx.BlockHeight(), msgTypeURL)
+
+	revenue := types.MsgTypeRevenue{MsgTypeUrl: msgTypeURL}
+	if bz := store.Get(key); bz != nil {
+		if err := revenue.Unmarshal(bz); err != nil {
+			return err
+		}
+	}
+
+	revenue.Fees = revenue.Fees.Add(fees...)
+
+	bz, err := revenue.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+
+	return nil
+}
+
+// PruneRevenue removes the revenue recorded at heights that have fallen out of the
+// window ending at the current height.
+func (k *Keeper) PruneRevenue(ctx sdk.Context, window uint64) {
+	k.pruneByHeight(ctx, types.KeyPrefixRevenue, types.RevenueHeightPrefix, window)
+}
+
+// GetRevenueByMsgType returns the fee revenue recorded over the window, attributed to
+// the primary message type of each transaction and sorted by message type URL, along
+// with the total revenue.
+func (k *Keeper) GetRevenueByMsgType(ctx sdk.Context) ([]types.MsgTypeRevenue, sdk.Coins, error) {
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixRevenue)
+	defer iterator.Close()
+
+	byType := make(map[string]sdk.Coins)
+	total := sdk.NewCoins()
+	for ; iterator.Valid(); iterator.Next() {
+		var revenue types.MsgTypeRevenue
+		if err := revenue.Unmarshal(iterator.Value()); err != nil {
+			return nil, nil, err
+		}
+
+		byType[revenue.MsgTypeUrl] = byType[revenue.MsgTypeUrl].Add(revenue.Fees...)
+		total = total.Add(revenue.Fees...)
+	}
+
+	msgTypes := make([]string, 0, len(byType))
+	for msgType := range byType {
+		msgTypes = append(msgTypes, msgType)
+	}
+	sort.Strings(msgTypes)
+
+	result := make([]types.MsgTypeRevenue, 0, len(msgTypes))
+	for _, msgType := range msgTypes {
+		result = append(result, types.MsgTypeRevenue{
+			MsgTypeUrl: msgType,
+			Fees:       byType[msgType],
+		})
+	}
+
+	return result, total, nil
+}
+
+// RecordAccountFeeSpend adds the given fees to the fees paid by the given account at the
+// current height. This is a no-op unless per-account fee tracking is enabled through a
+// non-zero AccountFeeSpendWindow.
+func (k *Keeper) RecordAccountFeeSpend(ctx sdk.Context, addr sdk.AccAddress, fees sdk.Coins) error {
+	if fees.IsZero() {
+		return nil
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.AccountFeeSpendWindow == 0 {
+		return nil
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.AccountFeeSpendKey(addr, ctx.BlockHeight())
+
+	var spend types.AccountFeeSpend
+	if bz := store.Get(key); bz != nil {
+		if err := spend.Unmarshal(bz); err != nil {
+			return err
+		}
+	}
+
+	spend.Fees = spend.Fees.Add(fees...)
+
+	bz, err := spend.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+	store.Set(types.AccountFeeSpendIndexKey(ctx.BlockHeight(), addr), []byte{})
+
+	return nil
+}
+
+// PruneAccountFeeSpend removes the account fee spend recorded at heights that have fallen
+// out of the window ending at the current height, using the height index to find it.
+func (k *Keeper) PruneAccountFeeSpend(ctx sdk.Context, window uint64) {
+	cutoff := ctx.BlockHeight() - int64(window)
+	if cutoff < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.KeyPrefixAccountFeeSpendByHeight, types.AccountFeeSpendHeightPrefix(cutoff+1))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		// Skip the prefix to get to the height, followed by the address.
+		height := int64(binary.BigEndian.Uint64(key[len(types.KeyPrefixAccountFeeSpendByHeight):]))
+		addr := key[len(types.AccountFeeSpendHeightPrefix(0)):]
+
+		store.Delete(types.AccountFeeSpendKey(addr, height))
+		store.Delete(key)
+	}
+}
+
+// GetAccountFeeSpend returns the total fees paid by the given account over the account
+// fee spend window ending at the current height. Only the account's own entries within
+// the window are read, so the cost is bounded by the window regardless of the number of
+// accounts.
+func (k *Keeper) GetAccountFeeSpend(ctx sdk.Context, addr sdk.AccAddress) (sdk.Coins, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return k.accountFeeSpend(ctx, addr, params.AccountFeeSpendWindow, ctx.BlockHeight())
+}
+
+// accountFeeSpend returns the total fees paid by the given account over the window blocks
+// ending at the given height.
+func (k *Keeper) accountFeeSpend(ctx sdk.Context, addr sdk.AccAddress, window uint64, end int64) (sdk.Coins, error) {
+	total := sdk.NewCoins()
+	if window == 0 || end < 0 {
+		return total, nil
+	}
+
+	start := end - int64(window) + 1
+	if start < 0 {
+		start = 0
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(types.AccountFeeSpendKey(addr, start), types.AccountFeeSpendKey(addr, end+1))
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var spend types.AccountFeeSpend
+		if err := spend.Unmarshal(iterator.Value()); err != nil {
+			return nil, err
+		}
+
+		total = total.Add(spend.Fees...)
+	}
+
+	return total, nil
+}
+
+// FeeConcentration returns the Gini coefficient of the fees paid, in the fee denom, by each
+// account over the account fee spend window. Zero means every account paid the same amount
+// and values approaching one mean a few accounts paid almost all fees. Only accounts that
+// paid fees in the fee denom over the window are included, so accounts that did not transact
+// do not dilute the coefficient. With the accounts' spend sorted in increasing order as
+// x_1, ..., x_n, the coefficient is 2 * sum(i * x_i) / (n * sum(x_i)) - (n + 1) / n.
+// ErrInsufficientData is returned if no account paid fees in the fee denom over the window,
+// e.g. because account fee tracking is disabled.
+func (k *Keeper) FeeConcentration(ctx sdk.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.KeyPrefixAccountFeeSpend)
+	defer iterator.Close()
+
+	byAccount := make(map[string]math.Int)
+	for ; iterator.Valid(); iterator.Next() {
+		var spend types.AccountFeeSpend
+		if err := spend.Unmarshal(iterator.Value()); err != nil {
+			return math.LegacyDec{}, err
+		}
+
+		amount := spend.Fees.AmountOf(params.FeeDenom)
+		if !amount.IsPositive() {
+			continue
+		}
+
+		// Strip the height to get to the length-prefixed address.
+		key := iterator.Key()
+		addr := string(key[len(types.KeyPrefixAccountFeeSpend) : len(key)-8])
+		if prev, ok := byAccount[addr]; ok {
+			amount = amount.Add(prev)
+		}
+		byAccount[addr] = amount
+	}
+
+	if len(byAccount) == 0 {
+		return math.LegacyDec{}, types.ErrInsufficientData.Wrap("no account paid fees in the fee denom over the window")
+	}
+
+	spends := make([]math.Int, 0, len(byAccount))
+	for _, amount := range byAccount {
+		spends = append(spends, amount)
+	}
+	sort.Slice(spends, func(i, j int) bool {
+		return spends[i].LT(spends[j])
+	})
+
+	total, weighted := math.ZeroInt(), math.ZeroInt()
+	for i, amount := range spends {
+		total = total.Add(amount)
+		weighted = weighted.Add(amount.MulRaw(int64(i + 1)))
+	}
+
+	n := int64(len(spends))
+	gini := math.LegacyNewDecFromInt(weighted.MulRaw(2)).Quo(math.LegacyNewDecFromInt(total.MulRaw(n))).
+		Sub(math.LegacyNewDec(n + 1).QuoInt64(n))
+
+	return gini, nil
+}
+
+// pruneByHeight removes all entries under the given height-indexed prefix that were
+// recorded at heights that have fallen out of the window ending at the current height.
+func (k *Keeper) pruneByHeight(ctx sdk.Context, prefix []byte, heightPrefix func(int64) []byte, window uint64) {
+	cutoff := ctx.BlockHeight() - int64(window)
+	if cutoff < 0 {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	iterator := store.Iterator(prefix, heightPrefix(cutoff+1))
+
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// RevenueToOffsetInflation returns the per-block fee revenue, denominated in the fee denom,
+// that must be collected (and burned) to neutralize the given annual inflation rate on the
+// given total supply. The result is rounded up so that collecting it fully offsets the
+// newly minted supply.
+func (k *Keeper) RevenueToOffsetInflation(
+	ctx sdk.Context,
+	annualInflation math.LegacyDec,
+	totalSupply math.Int,
+	blocksPerYear int64,
+) (sdk.Coin, error) {
+	if annualInflation.IsNil() || annualInflation.IsNegative() {
+		return sdk.Coin{}, fmt.Errorf("annual inflation must be non-negative")
+	}
+	if totalSupply.IsNil() || totalSupply.IsNegative() {
+		return sdk.Coin{}, fmt.Errorf("total supply must be non-negative")
+	}
+	if blocksPerYear <= 0 {
+		return sdk.Coin{}, fmt.Errorf("blocks per year must be positive; got %d", blocksPerYear)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	perBlock := annualInflation.MulInt(totalSupply).QuoInt64(blocksPerYear)
+	return sdk.NewCoin(params.FeeDenom, perBlock.Ceil().TruncateInt()), nil
+}
+
+// SteadyStateRevenue projects the annual fee revenue, in the fee denom, if the fee market
+// stays at its equilibrium for a year of blocksPerYear blocks. The projection assumes that:
+//
+//   - every block consumes the equilibrium utilization (see EquilibriumUtilization), i.e.
+//     the target block utilization;
+//   - the base gas price stays at its current value, floored at MinBaseGasPrice. Blocks at
+//     the target leave the price unchanged, so the current price is the equilibrium price
+//     once utilization settles at the target;
+//   - fees are paid at exactly the base gas price, so tips are excluded, as are txs exempt
+//     from fees.
+//
+// The result is baseGasPrice * targetBlockUtilization * blocksPerYear, rounded down.
+func (k *Keeper) SteadyStateRevenue(ctx sdk.Context, blocksPerYear int64) (sdk.Coin, error) {
+	if blocksPerYear <= 0 {
+		return sdk.Coin{}, fmt.Errorf("blocks per year must be positive; got %d", blocksPerYear)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	baseGasPrice, err := k.GetBaseGasPrice(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	baseGasPrice = math.LegacyMaxDec(baseGasPrice, params.MinBaseGasPrice)
+
+	// The equilibrium utilization times the max block utilization, without the rounding of
+	// the equilibrium fraction.
+	gasPerBlock := math.NewIntFromUint64(params.TargetBlockUtilization())
+	revenue := baseGasPrice.MulInt(gasPerBlock).MulInt64(blocksPerYear)
+
+	return sdk.NewCoin(params.FeeDenom, revenue.TruncateInt()), nil
+}
@@ -0,0 +1,326 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestRevenueByMsgType() {
+	const (
+		msgSend  = "/cosmos.bank.v1beta1.MsgSend"
+		msgVote  = "/cosmos.gov.v1.MsgVote"
+		msgStake = "/cosmos.staking.v1beta1.MsgDelegate"
+	)
+
+	s.Run("attributes revenue by message type and sums to the total", func() {
+		ctx := s.ctx.WithBlockHeight(10)
+		s.Require().NoError(s.feeMarketKeeper.RecordRevenue(ctx, msgVote, sdk.NewCoins(sdk.NewInt64Coin("stake", 5))))
+		s.Require().NoError(s.feeMarketKeeper.RecordRevenue(ctx, msgSend, sdk.NewCoins(sdk.NewInt64Coin("stake", 10))))
+		s.Require().NoError(s.feeMarketKeeper.RecordRevenue(ctx, msgSend, sdk.NewCoins(sdk.NewInt64Coin("atom", 3))))
+
+		ctx = ctx.WithBlockHeight(11)
+		s.Require().NoError(s.feeMarketKeeper.RecordRevenue(ctx, msgStake, sdk.NewCoins(sdk.NewInt64Coin("stake", 7))))
+		s.Require().NoError(s.feeMarketKeeper.RecordRevenue(ctx, msgSend, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+
+		resp, err := s.queryServer.RevenueByMsgType(ctx, &types.RevenueByMsgTypeRequest{})
+		s.Require().NoError(err)
+
+		expected := []types.MsgTypeRevenue{
+			{MsgTypeUrl: msgSend, Fees: sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin("stake", 11))},
+			{MsgTypeUrl: msgVote, Fees: sdk.NewCoins(sdk.NewInt64Coin("stake", 5))},
+			{MsgTypeUrl: msgStake, Fees: sdk.NewCoins(sdk.NewInt64Coin("stake", 7))},
+		}
+		s.Require().Equal(expected, resp.Revenue)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin("stake", 23)), resp.Total)
+
+		sum := sdk.NewCoins()
+		for _, revenue := range resp.Revenue {
+			sum = sum.Add(revenue.Fees...)
+		}
+		s.Require().Equal(resp.Total, sum)
+	})
+
+	s.Run("zero fees are not recorded", func() {
+		ctx := s.ctx.WithBlockHeight(20)
+		s.Require().NoError(s.feeMarketKeeper.RecordRevenue(ctx, "/zero", sdk.NewCoins()))
+
+		revenue, _, err := s.feeMarketKeeper.GetRevenueByMsgType(ctx)
+		s.Require().NoError(err)
+		for _, r := range revenue {
+			s.Require().NotEqual("/zero", r.MsgTypeUrl)
+		}
+	})
+
+	s.Run("revenue outside of the window is pruned", func() {
+		ctx := s.ctx.WithBlockHeight(100)
+		s.Require().NoError(s.feeMarketKeeper.RecordRevenue(ctx, msgSend, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+		ctx = ctx.WithBlockHeight(103)
+		s.Require().NoError(s.feeMarketKeeper.RecordRevenue(ctx, msgVote, sdk.NewCoins(sdk.NewInt64Coin("stake", 2))))
+
+		// A window of 3 blocks ending at height 103 retains heights 101 through 103.
+		s.feeMarketKeeper.PruneRevenue(ctx, 3)
+
+		revenue, total, err := s.feeMarketKeeper.GetRevenueByMsgType(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal([]types.MsgTypeRevenue{
+			{MsgTypeUrl: msgVote, Fees: sdk.NewCoins(sdk.NewInt64Coin("stake", 2))},
+		}, revenue)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 2)), total)
+	})
+}
+
+func (s *KeeperTestSuite) TestAccountFeeSpend() {
+	alice := sdk.AccAddress("alice_______________")
+	bob := sdk.AccAddress("bob_________________")
+
+	setWindow := func(window uint64) {
+		params, err := s.feeMarketKeeper.GetParams(s.ctx)
+		s.Require().NoError(err)
+		params.AccountFeeSpendWindow = window
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+	}
+
+	s.Run("nothing is recorded when tracking is disabled", func() {
+		setWindow(0)
+
+		ctx := s.ctx.WithBlockHeight(5)
+		s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 5))))
+
+		fees, err := s.feeMarketKeeper.GetAccountFeeSpend(ctx, alice)
+		s.Require().NoError(err)
+		s.Require().True(fees.IsZero())
+	})
+
+	s.Run("attributes fees to the paying account", func() {
+		setWindow(10)
+
+		ctx := s.ctx.WithBlockHeight(10)
+		s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 5))))
+		s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(ctx, bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 7))))
+		s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("atom", 3))))
+
+		ctx = ctx.WithBlockHeight(11)
+		s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(ctx, alice, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+
+		resp, err := s.queryServer.AccountFeeSpend(ctx, &types.AccountFeeSpendRequest{Address: alice.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("atom", 3), sdk.NewInt64Coin("stake", 6)), resp.Fees)
+
+		resp, err = s.queryServer.AccountFeeSpend(ctx, &types.AccountFeeSpendRequest{Address: bob.String()})
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 7)), resp.Fees)
+	})
+
+	s.Run("fees outside of the window are pruned", func() {
+		setWindow(3)
+
+		ctx := s.ctx.WithBlockHeight(100)
+		s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(ctx, bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+		ctx = ctx.WithBlockHeight(103)
+		s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(ctx, bob, sdk.NewCoins(sdk.NewInt64Coin("stake", 2))))
+
+		// A window of 3 blocks ending at height 103 retains heights 101 through 103.
+		s.feeMarketKeeper.PruneAccountFeeSpend(ctx, 3)
+
+		fees, err := s.feeMarketKeeper.GetAccountFeeSpend(ctx, bob)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 2)), fees)
+	})
+
+	s.Run("only fees within the window are read before pruning", func() {
+		setWindow(3)
+
+		carol := sdk.AccAddress("carol_______________")
+		ctx := s.ctx.WithBlockHeight(200)
+		s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(ctx, carol, sdk.NewCoins(sdk.NewInt64Coin("stake", 1))))
+		ctx = ctx.WithBlockHeight(203)
+		s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(ctx, carol, sdk.NewCoins(sdk.NewInt64Coin("stake", 2))))
+
+		fees, err := s.feeMarketKeeper.GetAccountFeeSpend(ctx, carol)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin("stake", 2)), fees)
+	})
+
+	s.Run("pruning removes the height index", func() {
+		setWindow(3)
+
+		s.Require().Positive(s.feeMarketKeeper.AccountFeeSpendIndexEntries(s.ctx))
+		s.feeMarketKeeper.PruneAccountFeeSpend(s.ctx.WithBlockHeight(1_000), 3)
+		s.Require().Zero(s.feeMarketKeeper.AccountFeeSpendIndexEntries(s.ctx))
+
+		_, err := s.feeMarketKeeper.FeeConcentration(s.ctx)
+		s.Require().ErrorIs(err, types.ErrInsufficientData)
+	})
+
+	s.Run("rejects an invalid address", func() {
+		_, err := s.queryServer.AccountFeeSpend(s.ctx, &types.AccountFeeSpendRequest{Address: "invalid"})
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestRevenueToOffsetInflation() {
+	params, err := s.feeMarketKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+
+	s.Run("computes the per-block revenue for known inflation and supply", func() {
+		// 7% of 1,000,000,000,000 minted over 5,256,000 blocks (6s blocks) is ~13,318.113 per block.
+		coin, err := s.feeMarketKeeper.RevenueToOffsetInflation(
+			s.ctx,
+			math.LegacyMustNewDecFromStr("0.07"),
+			math.NewInt(1_000_000_000_000),
+			5_256_000,
+		)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 13_319), coin)
+	})
+
+	s.Run("exact division is not rounded up", func() {
+		coin, err := s.feeMarketKeeper.RevenueToOffsetInflation(
+			s.ctx,
+			math.LegacyMustNewDecFromStr("0.10"),
+			math.NewInt(1_000_000),
+			100,
+		)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 1_000), coin)
+	})
+
+	s.Run("zero inflation requires no revenue", func() {
+		coin, err := s.feeMarketKeeper.RevenueToOffsetInflation(s.ctx, math.LegacyZeroDec(), math.NewInt(1_000_000), 100)
+		s.Require().NoError(err)
+		s.Require().True(coin.IsZero())
+	})
+
+	s.Run("rejects invalid inputs", func() {
+		_, err := s.feeMarketKeeper.RevenueToOffsetInflation(s.ctx, math.LegacyMustNewDecFromStr("-0.01"), math.NewInt(1), 100)
+		s.Require().Error(err)
+
+		_, err = s.feeMarketKeeper.RevenueToOffsetInflation(s.ctx, math.LegacyOneDec(), math.NewInt(-1), 100)
+		s.Require().Error(err)
+
+		_, err = s.feeMarketKeeper.RevenueToOffsetInflation(s.ctx, math.LegacyOneDec(), math.NewInt(1), 0)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestSteadyStateRevenue() {
+	params := types.DefaultAIMDParams()
+	params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.01")
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	setBaseGasPrice := func(price math.LegacyDec) {
+		state := types.DefaultAIMDState()
+		state.BaseGasPrice = price
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+	}
+
+	s.Run("projects the revenue at the equilibrium utilization and price", func() {
+		setBaseGasPrice(math.LegacyMustNewDecFromStr("0.025"))
+
+		// The equilibrium utilization is half of the 30,000,000 max block utilization, so
+		// each block pays 0.025 * 15,000,000 = 375,000 over 5,256,000 blocks.
+		equilibrium, err := s.feeMarketKeeper.EquilibriumUtilization(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.5"), equilibrium)
+
+		coin, err := s.feeMarketKeeper.SteadyStateRevenue(s.ctx, 5_256_000)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 1_971_000_000_000), coin)
+	})
+
+	s.Run("matches the equilibrium utilization for an odd max block utilization", func() {
+		odd := params
+		odd.MaxBlockUtilization = 3
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, odd))
+		defer func() {
+			s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+		}()
+		setBaseGasPrice(math.LegacyNewDec(2))
+
+		// The target of 3 / 2 rounds down to 1 gas per block.
+		coin, err := s.feeMarketKeeper.SteadyStateRevenue(s.ctx, 100)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(odd.FeeDenom, 200), coin)
+	})
+
+	s.Run("the price is floored at the min base gas price", func() {
+		setBaseGasPrice(math.LegacyMustNewDecFromStr("0.001"))
+
+		coin, err := s.feeMarketKeeper.SteadyStateRevenue(s.ctx, 10)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 1_500_000), coin)
+	})
+
+	s.Run("non-positive blocks per year returns an error", func() {
+		_, err := s.feeMarketKeeper.SteadyStateRevenue(s.ctx, 0)
+		s.Require().Error(err)
+	})
+}
+
+func (s *KeeperTestSuite) TestFeeConcentration() {
+	params, err := s.feeMarketKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+	params.AccountFeeSpendWindow = 100
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	accounts := []sdk.AccAddress{
+		sdk.AccAddress("other_denom_________"),
+		sdk.AccAddress("alice_______________"),
+		sdk.AccAddress("bob_________________"),
+		sdk.AccAddress("carol_______________"),
+		sdk.AccAddress("whale_______________"),
+	}
+	account := func(i int) sdk.AccAddress { return accounts[i] }
+	fee := func(denom string, amount int64) sdk.Coins {
+		return sdk.NewCoins(sdk.NewInt64Coin(denom, amount))
+	}
+
+	s.Run("no fee spend returns insufficient data", func() {
+		_, err := s.feeMarketKeeper.FeeConcentration(s.ctx)
+		s.Require().ErrorIs(err, types.ErrInsufficientData)
+	})
+
+	s.Run("spend in other denoms only returns insufficient data", func() {
+		ctx := s.ctx.WithBlockHeight(10)
+		s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(ctx, account(0), fee("uatom", 100)))
+
+		_, err := s.feeMarketKeeper.FeeConcentration(ctx)
+		s.Require().ErrorIs(err, types.ErrInsufficientData)
+	})
+
+	s.Run("a single account has no concentration", func() {
+		ctx := s.ctx.WithBlockHeight(11)
+		s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(ctx, account(1), fee(params.FeeDenom, 100)))
+
+		gini, err := s.feeMarketKeeper.FeeConcentration(ctx)
+		s.Require().NoError(err)
+		s.Require().True(gini.IsZero())
+	})
+
+	s.Run("equal spend has no concentration", func() {
+		ctx := s.ctx.WithBlockHeight(12)
+		for i := 2; i <= 4; i++ {
+			s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(ctx, account(i), fee(params.FeeDenom, 100)))
+		}
+
+		gini, err := s.feeMarketKeeper.FeeConcentration(ctx)
+		s.Require().NoError(err)
+		s.Require().True(gini.IsZero())
+	})
+
+	s.Run("a whale paying most fees is highly concentrated", func() {
+		// Accounts 1 through 4 paid 100 each. Topping account 4 up to 9,700 in later blocks
+		// gives the spends 100, 100, 100 and 9,700, whose Gini coefficient is
+		// sum(|x_i - x_j|) / (2 * n^2 * mean) = 6 * 9,600 / (2 * 16 * 2,500) = 0.72.
+		for height := int64(13); height < 16; height++ {
+			ctx := s.ctx.WithBlockHeight(height)
+			s.Require().NoError(s.feeMarketKeeper.RecordAccountFeeSpend(ctx, account(4), fee(params.FeeDenom, 3_200)))
+		}
+
+		gini, err := s.feeMarketKeeper.FeeConcentration(s.ctx.WithBlockHeight(15))
+		s.Require().NoError(err)
+		s.Require().Equal(math.LegacyMustNewDecFromStr("0.72"), gini)
+	})
+}
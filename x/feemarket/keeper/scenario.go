@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// PriceUnder returns the base gas price the EndBlock update would set for the next block
+// under the given params and state, if the current block consumed gasUsed out of a block
+// gas limit of gasLimit. The gas limit takes the place of params.MaxBlockUtilization and
+// gasUsed replaces the utilization recorded for the current block. The result depends only
+// on the arguments and not on the stored params or state, which are left untouched, so it
+// can be used to preview param changes, backtest recorded blocks and check conformance with
+// other implementations.
+//
+// The update is not dampened by the warmup, which depends on the height at which the fee
+// market was enabled, and the first block after enablement is not special-cased. The
+// state's base gas price is returned unchanged if the params disable the fee market.
+func (k *Keeper) PriceUnder(_ sdk.Context, params types.Params, state types.State, gasUsed, gasLimit uint64) (math.LegacyDec, error) {
+	if gasLimit == 0 {
+		return math.LegacyDec{}, fmt.Errorf("gas limit must be positive")
+	}
+	if gasUsed > gasLimit {
+		return math.LegacyDec{}, fmt.Errorf("gas used %d cannot exceed the gas limit %d", gasUsed, gasLimit)
+	}
+
+	params.MaxBlockUtilization = gasLimit
+	if err := params.ValidateBasic(); err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid params: %w", err)
+	}
+	if err := state.ValidateBasic(); err != nil {
+		return math.LegacyDec{}, fmt.Errorf("invalid state: %w", err)
+	}
+	if uint64(len(state.Window)) != params.Window {
+		return math.LegacyDec{}, fmt.Errorf("state window length %d does not match the params window %d", len(state.Window), params.Window)
+	}
+	if state.Index >= uint64(len(state.Window)) {
+		return math.LegacyDec{}, fmt.Errorf("state index %d is out of range of the window", state.Index)
+	}
+
+	if !params.Enabled {
+		return state.BaseGasPrice, nil
+	}
+
+	// Work on a copy of the window so that the caller's state is not modified.
+	state.Window = append([]uint64(nil), state.Window...)
+	state.ReconcileWindowSum()
+	state.SetCurrentUtilization(gasUsed)
+
+	state.UpdateLearningRate(params)
+	return state.UpdateBaseGasPrice(params), nil
+}
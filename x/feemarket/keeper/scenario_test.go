@@ -0,0 +1,132 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestPriceUnder() {
+	aimd := types.DefaultAIMDParams()
+	aimd.Window = 4
+	aimd.MinBaseGasPrice = math.LegacyOneDec()
+
+	scenario := func(params types.Params) types.State {
+		state := types.NewState(params.Window, math.LegacyMustNewDecFromStr("2.5"), params.MinLearningRate)
+		state.Window = []uint64{params.MaxBlockUtilization, params.TargetBlockUtilization(), 0, 0}[:params.Window]
+		state.Index = params.Window - 1
+		state.ReconcileWindowSum()
+		return state
+	}
+
+	base := types.DefaultParams()
+	base.MinBaseGasPrice = math.LegacyOneDec()
+	base.Window = 1
+
+	cases := []struct {
+		name    string
+		params  types.Params
+		gasUsed func(types.Params) uint64
+	}{
+		{"eip1559 full block", base, func(p types.Params) uint64 { return p.MaxBlockUtilization }},
+		{"eip1559 empty block", base, func(types.Params) uint64 { return 0 }},
+		{"aimd full block", aimd, func(p types.Params) uint64 { return p.MaxBlockUtilization }},
+		{"aimd target block", aimd, func(p types.Params) uint64 { return p.TargetBlockUtilization() }},
+		{"aimd empty block", aimd, func(types.Params) uint64 { return 0 }},
+	}
+
+	for _, tc := range cases {
+		s.Run(tc.name+" matches the end block update", func() {
+			state := scenario(tc.params)
+			gasUsed := tc.gasUsed(tc.params)
+
+			price, err := s.feeMarketKeeper.PriceUnder(s.ctx, tc.params, state, gasUsed, tc.params.MaxBlockUtilization)
+			s.Require().NoError(err)
+
+			// PriceUnder must not modify the given state.
+			s.Require().Equal(scenario(tc.params), state)
+
+			live := scenario(tc.params)
+			live.SetCurrentUtilization(gasUsed)
+			s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, tc.params))
+			s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, live))
+			s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+			got, err := s.feeMarketKeeper.GetState(s.ctx)
+			s.Require().NoError(err)
+			s.Require().Equal(got.BaseGasPrice, price)
+		})
+	}
+
+	s.Run("the stored params and state are not used", func() {
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, base))
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, scenario(base)))
+
+		state := scenario(aimd)
+		want, err := s.feeMarketKeeper.PriceUnder(s.ctx, aimd, state, aimd.MaxBlockUtilization, aimd.MaxBlockUtilization)
+		s.Require().NoError(err)
+
+		stored, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(scenario(base), stored)
+
+		s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, aimd))
+		got, err := s.feeMarketKeeper.PriceUnder(s.ctx, aimd, state, aimd.MaxBlockUtilization, aimd.MaxBlockUtilization)
+		s.Require().NoError(err)
+		s.Require().Equal(want, got)
+	})
+
+	s.Run("the gas limit replaces the max block utilization", func() {
+		state := scenario(aimd)
+		full, err := s.feeMarketKeeper.PriceUnder(s.ctx, aimd, state, aimd.MaxBlockUtilization, aimd.MaxBlockUtilization)
+		s.Require().NoError(err)
+
+		// The same gas is only a quarter of a block that is twice as large.
+		larger, err := s.feeMarketKeeper.PriceUnder(s.ctx, aimd, state, aimd.MaxBlockUtilization/2, aimd.MaxBlockUtilization*2)
+		s.Require().NoError(err)
+		s.Require().True(larger.LT(full))
+	})
+
+	s.Run("a disabled fee market keeps the price", func() {
+		disabled := aimd
+		disabled.Enabled = false
+		state := scenario(disabled)
+
+		price, err := s.feeMarketKeeper.PriceUnder(s.ctx, disabled, state, disabled.MaxBlockUtilization, disabled.MaxBlockUtilization)
+		s.Require().NoError(err)
+		s.Require().Equal(state.BaseGasPrice, price)
+	})
+
+	s.Run("invalid scenarios are rejected", func() {
+		invalidParams := aimd
+		invalidParams.Alpha = math.LegacyNewDec(-1)
+
+		mismatched := scenario(aimd)
+		mismatched.Window = mismatched.Window[:2]
+
+		outOfRange := scenario(aimd)
+		outOfRange.Index = aimd.Window
+
+		testCases := []struct {
+			name     string
+			params   types.Params
+			state    types.State
+			gasUsed  uint64
+			gasLimit uint64
+		}{
+			{"zero gas limit", aimd, scenario(aimd), 0, 0},
+			{"gas used above gas limit", aimd, scenario(aimd), 2, 1},
+			{"invalid params", invalidParams, scenario(aimd), 0, aimd.MaxBlockUtilization},
+			{"invalid state", aimd, types.State{Window: make([]uint64, aimd.Window)}, 0, aimd.MaxBlockUtilization},
+			{"window length mismatch", aimd, mismatched, 0, aimd.MaxBlockUtilization},
+			{"index out of range", aimd, outOfRange, 0, aimd.MaxBlockUtilization},
+		}
+
+		for _, tc := range testCases {
+			s.Run(tc.name, func() {
+				_, err := s.feeMarketKeeper.PriceUnder(s.ctx, tc.params, tc.state, tc.gasUsed, tc.gasLimit)
+				s.Require().Error(err)
+			})
+		}
+	})
+}
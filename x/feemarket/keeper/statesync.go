@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// ReconcileAfterStateSync reconciles the fee market window when the chain resumes at a
+// height that does not directly follow lastSyncedHeight, e.g. after a restart from a
+// snapshot at a later height. If there is a gap, the window is adjusted according to the
+// given mode so that pricing does not rely on utilization from blocks that were never
+// processed.
+//
+// The reconciliation writes consensus state, so every validator must call it at the same
+// height with the same arguments. It must only be called from consensus code such as an
+// upgrade handler, with the height and mode fixed in the upgrade, and never from a
+// node-local hook such as a state-sync restore.
+func (k *Keeper) ReconcileAfterStateSync(ctx sdk.Context, lastSyncedHeight int64, mode types.StateSyncReconcileMode) error {
+	gap := ctx.BlockHeight() - lastSyncedHeight - 1
+	if gap <= 0 || mode == types.StateSyncReconcileNone {
+		return nil
+	}
+
+	state, err := k.GetState(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(state.Window) == 0 {
+		return fmt.Errorf("fee market window is empty")
+	}
+
+	switch mode {
+	case types.StateSyncReconcileClearWindow:
+		for i := range state.Window {
+			state.Window[i] = 0
+		}
+		state.WindowSum = 0
+		state.Index = 0
+	case types.StateSyncReconcileAdvanceWindow:
+		// Advancing by more than the window length is equivalent to advancing by
+		// the window length, since every entry is cleared.
+		missed := uint64(gap)
+		if missed > uint64(len(state.Window)) {
+			missed = uint64(len(state.Window))
+		}
+
+		for i := uint64(0); i < missed; i++ {
+			state.IncrementHeight()
+		}
+	default:
+		return fmt.Errorf("unknown state sync reconcile mode: %d", mode)
+	}
+
+	k.Logger(ctx).Info(
+		"reconciled fee market window after state sync",
+		"mode", mode.String(),
+		"last_synced_height", lastSyncedHeight,
+		"gap", gap,
+	)
+
+	return k.SetState(ctx, state)
+}
@@ -0,0 +1,84 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestReconcileAfterStateSync() {
+	setup := func() types.State {
+		gs := types.DefaultAIMDGenesisState()
+		s.feeMarketKeeper.InitGenesis(s.ctx, *gs)
+
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+
+		for i := range state.Window {
+			state.Window[i] = uint64(i + 1)
+		}
+		state.Index = 2
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		return state
+	}
+
+	s.Run("no gap leaves the window untouched", func() {
+		expected := setup()
+		ctx := s.ctx.WithBlockHeight(101)
+
+		s.Require().NoError(s.feeMarketKeeper.ReconcileAfterStateSync(ctx, 100, types.StateSyncReconcileClearWindow))
+
+		state, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, state)
+	})
+
+	s.Run("gap with no reconcile mode leaves the window untouched", func() {
+		expected := setup()
+		ctx := s.ctx.WithBlockHeight(200)
+
+		s.Require().NoError(s.feeMarketKeeper.ReconcileAfterStateSync(ctx, 100, types.StateSyncReconcileNone))
+
+		state, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(expected, state)
+	})
+
+	s.Run("gap clears the window", func() {
+		expected := setup()
+		ctx := s.ctx.WithBlockHeight(105)
+
+		s.Require().NoError(s.feeMarketKeeper.ReconcileAfterStateSync(ctx, 100, types.StateSyncReconcileClearWindow))
+
+		state, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(0), state.Index)
+		s.Require().Equal(make([]uint64, len(expected.Window)), state.Window)
+		s.Require().Equal(expected.BaseGasPrice, state.BaseGasPrice)
+		s.Require().Equal(expected.LearningRate, state.LearningRate)
+	})
+
+	s.Run("gap advances the window by the missed blocks", func() {
+		setup()
+		ctx := s.ctx.WithBlockHeight(104)
+
+		// Blocks 101, 102 and 103 were missed.
+		s.Require().NoError(s.feeMarketKeeper.ReconcileAfterStateSync(ctx, 100, types.StateSyncReconcileAdvanceWindow))
+
+		state, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(5), state.Index)
+		s.Require().Equal([]uint64{1, 2, 3, 0, 0, 0, 7, 8}, state.Window)
+	})
+
+	s.Run("gap larger than the window clears every entry", func() {
+		setup()
+		ctx := s.ctx.WithBlockHeight(1000)
+
+		s.Require().NoError(s.feeMarketKeeper.ReconcileAfterStateSync(ctx, 100, types.StateSyncReconcileAdvanceWindow))
+
+		state, err := s.feeMarketKeeper.GetState(ctx)
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(2), state.Index)
+		s.Require().Equal(make([]uint64, len(state.Window)), state.Window)
+	})
+}
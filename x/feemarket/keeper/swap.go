@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// CrossChainSwapFee returns the fees of a multi-leg cross-chain operation, such as an
+// atomic swap, priced in the given settlement denom. The local fee is the dynamic fee for
+// the combined gas of all legs at the current gas price. The relay fee is the sum of the
+// estimated fees for relaying each leg that has an IBC channel, as given by the configured
+// relay fee estimator.
+func (k *Keeper) CrossChainSwapFee(ctx sdk.Context, legs []types.SwapLeg, denom string) (types.SwapFeeResult, error) {
+	if len(legs) == 0 {
+		return types.SwapFeeResult{}, fmt.Errorf("at least one leg must be provided")
+	}
+
+	gasPrice, err := k.GetMinGasPrice(ctx, denom)
+	if err != nil {
+		return types.SwapFeeResult{}, err
+	}
+
+	var gas uint64
+	relayFee := sdk.NewCoin(denom, math.ZeroInt())
+	for i, leg := range legs {
+		gas += leg.Gas
+
+		if leg.Channel == "" {
+			continue
+		}
+
+		if k.relayFeeEstimator == nil {
+			return types.SwapFeeResult{}, types.ErrRelayEstimatorNotSet
+		}
+
+		fee, err := k.relayFeeEstimator.EstimateRelayFee(ctx, leg.Channel, denom)
+		if err != nil {
+			return types.SwapFeeResult{}, fmt.Errorf("unable to estimate relay fee for leg %d: %w", i, err)
+		}
+		if fee.Denom != denom {
+			return types.SwapFeeResult{}, fmt.Errorf("relay fee for leg %d is in %s, expected %s", i, fee.Denom, denom)
+		}
+
+		relayFee = relayFee.Add(fee)
+	}
+
+	localFee := computeFee(gasPrice, gas)
+
+	return types.SwapFeeResult{
+		LocalFee: localFee,
+		RelayFee: relayFee,
+		Total:    localFee.Add(relayFee),
+	}, nil
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// fixedRelayFeeEstimator returns a fixed relay fee per channel.
+type fixedRelayFeeEstimator map[string]int64
+
+func (e fixedRelayFeeEstimator) EstimateRelayFee(_ sdk.Context, channel string, denom string) (sdk.Coin, error) {
+	amount, ok := e[channel]
+	if !ok {
+		return sdk.Coin{}, fmt.Errorf("unknown channel %s", channel)
+	}
+
+	return sdk.NewInt64Coin(denom, amount), nil
+}
+
+func (s *KeeperTestSuite) TestCrossChainSwapFee() {
+	params := types.DefaultParams()
+	params.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.5")
+	s.setGenesisState(params, types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate))
+
+	defer s.feeMarketKeeper.SetRelayFeeEstimator(nil)
+
+	legs := []types.SwapLeg{
+		{Gas: 100_000},
+		{Gas: 50_000, Channel: "channel-0"},
+		{Gas: 25_001, Channel: "channel-1"},
+	}
+
+	s.Run("errors without a relay fee estimator", func() {
+		s.feeMarketKeeper.SetRelayFeeEstimator(nil)
+
+		_, err := s.feeMarketKeeper.CrossChainSwapFee(s.ctx, legs, params.FeeDenom)
+		s.Require().ErrorIs(err, types.ErrRelayEstimatorNotSet)
+	})
+
+	s.Run("sums the local and relay fees", func() {
+		s.feeMarketKeeper.SetRelayFeeEstimator(fixedRelayFeeEstimator{"channel-0": 1000, "channel-1": 2500})
+
+		result, err := s.feeMarketKeeper.CrossChainSwapFee(s.ctx, legs, params.FeeDenom)
+		s.Require().NoError(err)
+
+		// ceil(0.5 * 175001)
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 87501), result.LocalFee)
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 3500), result.RelayFee)
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 91001), result.Total)
+	})
+
+	s.Run("prices in the settlement denom", func() {
+		s.feeMarketKeeper.SetRelayFeeEstimator(fixedRelayFeeEstimator{"channel-0": 1000, "channel-1": 2500})
+		s.feeMarketKeeper.SetDenomResolver(&skewedDenomResolver{})
+		defer s.feeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+		result, err := s.feeMarketKeeper.CrossChainSwapFee(s.ctx, legs, "atom")
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewInt64Coin("atom", 175001), result.LocalFee)
+		s.Require().Equal(sdk.NewInt64Coin("atom", 178501), result.Total)
+	})
+
+	s.Run("local only legs do not need an estimator", func() {
+		s.feeMarketKeeper.SetRelayFeeEstimator(nil)
+
+		result, err := s.feeMarketKeeper.CrossChainSwapFee(s.ctx, legs[:1], params.FeeDenom)
+		s.Require().NoError(err)
+		s.Require().True(result.RelayFee.IsZero())
+		s.Require().Equal(result.LocalFee, result.Total)
+	})
+
+	s.Run("propagates relay estimation errors", func() {
+		s.feeMarketKeeper.SetRelayFeeEstimator(fixedRelayFeeEstimator{})
+
+		_, err := s.feeMarketKeeper.CrossChainSwapFee(s.ctx, legs, params.FeeDenom)
+		s.Require().Error(err)
+	})
+}
@@ -0,0 +1,48 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// emitStateTelemetry reports the base gas price, the learning rate and the utilization of
+// the window of the given state, from its running window sum, as gauges labeled with the
+// fee denom. It does nothing unless telemetry is enabled, and reads the params without
+// charging gas so that metrics never affect the gas cost of the state write.
+func (k *Keeper) emitStateTelemetry(ctx sdk.Context, state types.State) {
+	if !telemetry.IsTelemetryEnabled() {
+		return
+	}
+
+	var params types.Params
+	if err := params.Unmarshal(k.getUnmetered(ctx, types.KeyParams)); err != nil {
+		return
+	}
+
+	labels := []metrics.Label{telemetry.NewLabel(types.MetricLabelDenom, params.FeeDenom)}
+	setGauge := func(key string, value math.LegacyDec) {
+		if value.IsNil() {
+			return
+		}
+
+		f, err := value.Float64()
+		if err != nil {
+			return
+		}
+
+		telemetry.SetGaugeWithLabels([]string{types.ModuleName, key}, float32(f), labels)
+	}
+
+	setGauge(types.MetricKeyBaseGasPrice, state.BaseGasPrice)
+	setGauge(types.MetricKeyLearningRate, state.LearningRate)
+
+	capacity := math.NewIntFromUint64(params.MaxBlockUtilization).Mul(math.NewInt(int64(len(state.Window))))
+	if capacity.IsPositive() {
+		used := math.LegacyNewDecFromInt(math.NewIntFromUint64(state.WindowSum))
+		setGauge(types.MetricKeyBlockUtilization, used.QuoInt(capacity))
+	}
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	"github.com/hashicorp/go-metrics"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestStateTelemetry() {
+	gauges := func(m *telemetry.Metrics) map[string]metrics.GaugeValue {
+		resp, err := m.Gather(telemetry.FormatDefault)
+		s.Require().NoError(err)
+
+		var summary metrics.MetricsSummary
+		s.Require().NoError(json.Unmarshal(resp.Metrics, &summary))
+
+		values := make(map[string]metrics.GaugeValue)
+		for _, gauge := range summary.Gauges {
+			values[gauge.Name] = gauge
+		}
+		return values
+	}
+
+	params, err := s.feeMarketKeeper.GetParams(s.ctx)
+	s.Require().NoError(err)
+
+	state := types.NewState(4, math.LegacyMustNewDecFromStr("0.5"), math.LegacyMustNewDecFromStr("0.125"))
+	state.Window = []uint64{params.MaxBlockUtilization, params.MaxBlockUtilization, 0, 0}
+	state.ReconcileWindowSum()
+
+	s.Run("no gauges are reported while telemetry is disabled", func() {
+		m, err := telemetry.New(telemetry.Config{Enabled: false})
+		s.Require().NoError(err)
+		s.Require().Nil(m)
+
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+	})
+
+	s.Run("every state write reports the gauges labeled with the fee denom", func() {
+		m, err := telemetry.New(telemetry.Config{ServiceName: "test", Enabled: true})
+		s.Require().NoError(err)
+		defer func() {
+			_, err := telemetry.New(telemetry.Config{Enabled: false})
+			s.Require().NoError(err)
+		}()
+
+		s.Require().NoError(s.feeMarketKeeper.SetState(s.ctx, state))
+
+		values := gauges(m)
+		for key, expected := range map[string]float32{
+			types.MetricKeyBaseGasPrice:     0.5,
+			types.MetricKeyLearningRate:     0.125,
+			types.MetricKeyBlockUtilization: 0.5,
+		} {
+			gauge, ok := values["test."+types.ModuleName+"."+key]
+			s.Require().True(ok, "missing gauge %s", key)
+			s.Require().Equal(expected, gauge.Value)
+			s.Require().Equal(map[string]string{types.MetricLabelDenom: params.FeeDenom}, gauge.DisplayLabels)
+		}
+	})
+}
@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// TipForInclusionProbability estimates the tip, in the fee denom, that a tx consuming the
+// given gas must pay on top of the base fee to be included in the next block with the given
+// probability. The estimate follows a contention model over the observations recorded in
+// the window:
+//
+//   - The contention of a block is how far its utilization got from the target toward a
+//     full block, in [0, 1]. A block at or below the target had room for every tx paying the
+//     base fee, while a full block had to leave txs out by priority.
+//   - The contention level c, the mean contention over the window, is the probability that
+//     the next block is contended.
+//   - In a contended block, the tip per gas of the marginal included tx is uniformly
+//     distributed between zero and the rise d of the base gas price after a full block,
+//     since a tx tipping more than d would rather wait a block and pay the higher base fee.
+//
+// A tip of t per gas is thus included with probability (1 - c) + c * min(t/d, 1), and the
+// tip returned is t = d * (probability - (1 - c)) / c, or zero if the probability does not
+// exceed 1 - c. ErrInsufficientData is returned if no blocks were observed.
+func (k *Keeper) TipForInclusionProbability(ctx sdk.Context, gas uint64, probability math.LegacyDec) (sdk.Coin, error) {
+	if probability.IsNil() || probability.IsNegative() || probability.GT(math.LegacyOneDec()) {
+		return sdk.Coin{}, fmt.Errorf("probability must be between [0, 1]")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	observations, err := k.GetObservations(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if len(observations) == 0 {
+		return sdk.Coin{}, types.ErrInsufficientData.Wrap("no blocks observed")
+	}
+
+	contention := blockContention(observations, params)
+
+	// The probability a tx paying only the base fee already reaches.
+	uncontended := math.LegacyOneDec().Sub(contention)
+	if probability.LTE(uncontended) {
+		return sdk.NewCoin(params.FeeDenom, math.ZeroInt()), nil
+	}
+
+	var current, next math.LegacyDec
+	err = k.projectBaseGasPrice(ctx, ctx.BlockHeight()+1, math.LegacyOneDec(), func(block int64, price math.LegacyDec) {
+		if block == ctx.BlockHeight() {
+			current = price
+		} else {
+			next = price
+		}
+	})
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	rise := math.LegacyMaxDec(next.Sub(current), math.LegacyZeroDec())
+	tip := rise.Mul(probability.Sub(uncontended)).Quo(contention)
+
+	return computeFee(sdk.NewDecCoinFromDec(params.FeeDenom, tip), gas), nil
+}
+
+// blockContention returns the mean contention of the given blocks, where the contention of a
+// block is how far its utilization got from the target toward a full block, in [0, 1].
+func blockContention(observations []types.BlockObservation, params types.Params) math.LegacyDec {
+	target := params.TargetBlockUtilization()
+	if params.MaxBlockUtilization <= target {
+		return math.LegacyZeroDec()
+	}
+
+	span := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.MaxBlockUtilization - target))
+
+	sum := math.LegacyZeroDec()
+	for _, observation := range observations {
+		if observation.Utilization <= target {
+			continue
+		}
+
+		excess := math.LegacyNewDecFromInt(math.NewIntFromUint64(observation.Utilization - target))
+		sum = sum.Add(math.LegacyMinDec(excess.Quo(span), math.LegacyOneDec()))
+	}
+
+	return sum.QuoInt64(int64(len(observations)))
+}
@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestTipForInclusionProbability() {
+	// The default eip-1559 params raise the base gas price by 1/8 after a full block, so a
+	// base gas price of 1 rises by 0.125 and the most a contended block costs in tips is
+	// 0.125 * 1,000,000 = 125,000.
+	params := types.DefaultParams()
+	state := types.NewState(params.Window, math.LegacyOneDec(), params.MinLearningRate)
+	s.setGenesisState(params, state)
+
+	const gas = 1_000_000
+	tipFor := func(probability string) sdk.Coin {
+		tip, err := s.feeMarketKeeper.TipForInclusionProbability(s.ctx, gas, math.LegacyMustNewDecFromStr(probability))
+		s.Require().NoError(err)
+		return tip
+	}
+
+	// observe records the given block utilizations over the window.
+	observe := func(utilizations ...uint64) {
+		s.feeMarketKeeper.PruneObservations(s.ctx.WithBlockHeight(1_000_000), 0)
+		for i, utilization := range utilizations {
+			ctx := s.ctx.WithBlockHeight(int64(i + 1))
+			s.Require().NoError(s.feeMarketKeeper.RecordObservation(ctx, math.LegacyOneDec(), utilization))
+		}
+	}
+
+	full, target := params.MaxBlockUtilization, params.TargetBlockUtilization()
+
+	s.Run("errors without observations", func() {
+		observe()
+
+		_, err := s.feeMarketKeeper.TipForInclusionProbability(s.ctx, gas, math.LegacyOneDec())
+		s.Require().ErrorIs(err, types.ErrInsufficientData)
+	})
+
+	s.Run("errors for a probability out of range", func() {
+		observe(full)
+
+		for _, probability := range []math.LegacyDec{{}, math.LegacyNewDec(-1), math.LegacyMustNewDecFromStr("1.01")} {
+			_, err := s.feeMarketKeeper.TipForInclusionProbability(s.ctx, gas, probability)
+			s.Require().Error(err)
+		}
+	})
+
+	s.Run("no tip is needed without contention", func() {
+		observe(0, target, target/2)
+
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 0), tipFor("1"))
+	})
+
+	s.Run("half of the blocks contended", func() {
+		observe(full, target, full, 0)
+
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 0), tipFor("0.5"))
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 62_500), tipFor("0.75"))
+		s.Require().Equal(sdk.NewInt64Coin(params.FeeDenom, 125_000), tipFor("1"))
+	})
+
+	s.Run("a higher probability requires a higher tip at every contention level", func() {
+		// Blocks halfway between the target and a full block are half contended.
+		halfway := target + (full-target)/2
+
+		for _, utilizations := range [][]uint64{
+			{halfway, 0, 0, 0},
+			{halfway, target},
+			{full, halfway},
+			{full, full},
+		} {
+			observe(utilizations...)
+
+			prev := tipFor("0.9")
+			for _, probability := range []string{"0.95", "0.99", "1"} {
+				tip := tipFor(probability)
+				s.Require().True(tip.Amount.GT(prev.Amount), "%v: %s at %s is not above %s", utilizations, tip, probability, prev)
+				prev = tip
+			}
+		}
+	})
+
+	s.Run("a higher contention requires a higher tip", func() {
+		var prev sdk.Coin
+		for i, utilizations := range [][]uint64{
+			{full, 0, 0, 0},
+			{full, full, 0, 0},
+			{full, full, full, 0},
+			{full, full, full, full},
+		} {
+			observe(utilizations...)
+
+			tip := tipFor("0.99")
+			if i > 0 {
+				s.Require().True(tip.Amount.GT(prev.Amount), "%v: %s is not above %s", utilizations, tip, prev)
+			}
+			prev = tip
+		}
+	})
+}
@@ -0,0 +1,45 @@
+package keeper_test
+
+import (
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestUpdateFeeMarketTracing() {
+	s.Run("no spans are recorded without a tracer", func() {
+		s.setGenesisState(types.DefaultAIMDParams(), types.DefaultAIMDState())
+		s.feeMarketKeeper.SetTracer(nil)
+
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+	})
+
+	s.Run("span is recorded with the expected attributes", func() {
+		recorder := tracetest.NewSpanRecorder()
+		provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+		s.feeMarketKeeper.SetTracer(provider.Tracer(types.ModuleName))
+		defer s.feeMarketKeeper.SetTracer(nil)
+
+		s.setGenesisState(types.DefaultAIMDParams(), types.DefaultAIMDState())
+		s.Require().NoError(s.feeMarketKeeper.UpdateFeeMarket(s.ctx))
+
+		spans := recorder.Ended()
+		s.Require().Len(spans, 1)
+		s.Require().Equal(types.SpanUpdateFeeMarket, spans[0].Name())
+
+		state, err := s.feeMarketKeeper.GetState(s.ctx)
+		s.Require().NoError(err)
+
+		attrs := make(map[string]string)
+		for _, attr := range spans[0].Attributes() {
+			attrs[string(attr.Key)] = attr.Value.Emit()
+		}
+
+		s.Require().Equal(state.BaseGasPrice.String(), attrs[types.SpanAttributeKeyBaseGasPrice])
+		s.Require().Equal(state.LearningRate.String(), attrs[types.SpanAttributeKeyLearningRate])
+		s.Require().Contains(attrs, types.SpanAttributeKeyAverageUtilization)
+		s.Require().Contains(attrs, types.SpanAttributeKeyHeight)
+		s.Require().False(spans[0].EndTime().Before(spans[0].StartTime()))
+	})
+}
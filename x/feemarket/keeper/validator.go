@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// ValidatorFeeShare estimates the fee revenue earned by the given validator for producing
+// the given number of blocks. The estimate assumes each block collects the average
+// per-block fee revenue recorded over the window, i.e. the revenue total divided by the
+// window (or the current height, if fewer blocks have been produced), and that the
+// revenue remaining after the community tax is attributed to the block's producer. Tips
+// are paid to the proposer directly and are excluded. The result is the total earned by
+// the validator before it is split between commission and delegators.
+func (k *Keeper) ValidatorFeeShare(ctx sdk.Context, valAddr sdk.ValAddress, blocksProduced int64) (sdk.Coins, error) {
+	if k.staking == nil {
+		return nil, types.ErrStakingNotSet
+	}
+	if k.distribution == nil {
+		return nil, types.ErrDistributionNotSet
+	}
+	if blocksProduced < 0 {
+		return nil, fmt.Errorf("blocks produced must be non-negative; got %d", blocksProduced)
+	}
+
+	if _, err := k.staking.GetValidator(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
+	distrParams, err := k.distribution.Params(ctx, &distrtypes.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, revenue, err := k.GetRevenueByMsgType(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	blocks := int64(params.Window)
+	if height := ctx.BlockHeight(); height < blocks {
+		blocks = height
+	}
+	if blocks <= 0 || revenue.IsZero() {
+		return sdk.NewCoins(), nil
+	}
+
+	producerShare := math.LegacyOneDec().Sub(distrParams.Params.CommunityTax)
+	share, _ := sdk.NewDecCoinsFromCoins(revenue...).
+		MulDec(producerShare).
+		MulDec(math.LegacyNewDec(blocksProduced)).
+		QuoDec(math.LegacyNewDec(blocks)).
+		TruncateDecimal()
+
+	return share, nil
+}
@@ -0,0 +1,86 @@
+package keeper_test
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/skip-mev/feemarket/x/feemarket/types/mocks"
+)
+
+func (s *KeeperTestSuite) TestValidatorFeeShare() {
+	valAddr := sdk.ValAddress("validator")
+	unknownAddr := sdk.ValAddress("unknown")
+
+	staking := mocks.NewStakingKeeper(s.T())
+	staking.On("GetValidator", mock.Anything, valAddr).Return(stakingtypes.Validator{}, nil).Maybe()
+	staking.On("GetValidator", mock.Anything, unknownAddr).Return(stakingtypes.Validator{}, stakingtypes.ErrNoValidatorFound).Maybe()
+
+	distribution := mocks.NewDistributionKeeper(s.T())
+	distrParams := distrtypes.DefaultParams()
+	distrParams.CommunityTax = math.LegacyMustNewDecFromStr("0.02")
+	distribution.On("Params", mock.Anything, mock.Anything).
+		Return(&distrtypes.QueryParamsResponse{Params: distrParams}, nil).Maybe()
+
+	defer s.feeMarketKeeper.SetStakingKeeper(nil)
+	defer s.feeMarketKeeper.SetDistributionKeeper(nil)
+
+	params := types.DefaultAIMDParams()
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	// Record 8,000 stake of revenue over the 8 block window, an average of 1,000 per block.
+	ctx := s.ctx.WithBlockHeight(100)
+	for i := int64(0); i < int64(params.Window); i++ {
+		blockCtx := ctx.WithBlockHeight(ctx.BlockHeight() - i)
+		s.Require().NoError(s.feeMarketKeeper.RecordRevenue(blockCtx, "/cosmos.bank.v1beta1.MsgSend", sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 1_000))))
+	}
+
+	s.Run("errors without a staking or distribution keeper", func() {
+		_, err := s.feeMarketKeeper.ValidatorFeeShare(ctx, valAddr, 1)
+		s.Require().ErrorIs(err, types.ErrStakingNotSet)
+
+		s.feeMarketKeeper.SetStakingKeeper(staking)
+		_, err = s.feeMarketKeeper.ValidatorFeeShare(ctx, valAddr, 1)
+		s.Require().ErrorIs(err, types.ErrDistributionNotSet)
+	})
+
+	s.feeMarketKeeper.SetStakingKeeper(staking)
+	s.feeMarketKeeper.SetDistributionKeeper(distribution)
+
+	s.Run("computes the share for known block production", func() {
+		// 5 blocks * 1,000 per block * (1 - 0.02) community tax = 4,900.
+		share, err := s.feeMarketKeeper.ValidatorFeeShare(ctx, valAddr, 5)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 4_900)), share)
+	})
+
+	s.Run("no blocks produced earns nothing", func() {
+		share, err := s.feeMarketKeeper.ValidatorFeeShare(ctx, valAddr, 0)
+		s.Require().NoError(err)
+		s.Require().True(share.IsZero())
+	})
+
+	s.Run("early chains average over the blocks produced so far", func() {
+		// Only the revenue at heights 1 through 4 is recorded, also 1,000 per block.
+		early := s.ctx.WithBlockHeight(4)
+		s.feeMarketKeeper.PruneRevenue(ctx.WithBlockHeight(1_000), 0)
+		for height := int64(1); height <= 4; height++ {
+			s.Require().NoError(s.feeMarketKeeper.RecordRevenue(early.WithBlockHeight(height), "/cosmos.bank.v1beta1.MsgSend", sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 1_000))))
+		}
+
+		share, err := s.feeMarketKeeper.ValidatorFeeShare(early, valAddr, 2)
+		s.Require().NoError(err)
+		s.Require().Equal(sdk.NewCoins(sdk.NewInt64Coin(params.FeeDenom, 1_960)), share)
+	})
+
+	s.Run("unknown validator and negative blocks return an error", func() {
+		_, err := s.feeMarketKeeper.ValidatorFeeShare(ctx, unknownAddr, 1)
+		s.Require().ErrorIs(err, stakingtypes.ErrNoValidatorFound)
+
+		_, err = s.feeMarketKeeper.ValidatorFeeShare(ctx, valAddr, -1)
+		s.Require().Error(err)
+	})
+}
@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+var (
+	// windowSizeTolerance is the standard error, as a fraction of the target block
+	// utilization, that SuggestWindowSize allows for the window's average utilization.
+	windowSizeTolerance = math.LegacyMustNewDecFromStr("0.1")
+	// maxWindowAutocorrelation caps the lag-1 autocorrelation used by SuggestWindowSize,
+	// which would otherwise make the suggested window arbitrarily large as it nears 1.
+	maxWindowAutocorrelation = math.LegacyMustNewDecFromStr("0.9")
+)
+
+// SuggestWindowSize recommends a window size for the given block utilization history. A
+// longer window smooths the average utilization the learning rate is adjusted from, while a
+// shorter one reacts faster to changes in demand, so the suggestion is the shortest window
+// whose average is precise enough given how noisy the history is.
+//
+// The heuristic treats the window average as an estimate of the underlying utilization and
+// picks the smallest window whose standard error is at most 10% of the target block
+// utilization. For a history with variance v, the standard error over n independent blocks
+// is sqrt(v / n). Utilization is usually autocorrelated, e.g. bursts of demand span several
+// blocks, so n blocks carry the information of only n * (1 - r) / (1 + r) independent ones,
+// where r is the lag-1 autocorrelation of the history. Negative autocorrelation is treated
+// as none and r is capped at 0.9. Solving for n gives:
+//
+//	n = ceil(v * (1 + r) / (1 - r) / (0.1 * target)^2)
+//
+// The suggestion is clamped to [1, len(history)], as the history cannot justify a window
+// longer than itself. ErrInsufficientData is returned if the history has fewer than two
+// blocks.
+func (k *Keeper) SuggestWindowSize(ctx sdk.Context, history []uint64) (uint64, error) {
+	if len(history) < 2 {
+		return 0, types.ErrInsufficientData.Wrapf("got %d blocks of history, need at least 2", len(history))
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	n := int64(len(history))
+	values := make([]math.LegacyDec, len(history))
+	sum := math.LegacyZeroDec()
+	for i, utilization := range history {
+		values[i] = math.LegacyNewDecFromInt(math.NewIntFromUint64(utilization))
+		sum = sum.Add(values[i])
+	}
+	mean := sum.QuoInt64(n)
+
+	sumSquares, sumLagged := math.LegacyZeroDec(), math.LegacyZeroDec()
+	for i := range values {
+		deviation := values[i].Sub(mean)
+		sumSquares = sumSquares.Add(deviation.Mul(deviation))
+
+		if i > 0 {
+			sumLagged = sumLagged.Add(deviation.Mul(values[i-1].Sub(mean)))
+		}
+	}
+
+	if sumSquares.IsZero() {
+		return 1, nil
+	}
+
+	variance := sumSquares.QuoInt64(n)
+
+	autocorrelation := math.LegacyMaxDec(sumLagged.Quo(sumSquares), math.LegacyZeroDec())
+	autocorrelation = math.LegacyMinDec(autocorrelation, maxWindowAutocorrelation)
+
+	one := math.LegacyOneDec()
+	effectiveVariance := variance.Mul(one.Add(autocorrelation)).Quo(one.Sub(autocorrelation))
+
+	target := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.TargetBlockUtilization()))
+	tolerance := target.Mul(windowSizeTolerance)
+
+	size := effectiveVariance.Quo(tolerance.Mul(tolerance)).Ceil().TruncateInt64()
+	return uint64(max(1, min(size, n))), nil
+}
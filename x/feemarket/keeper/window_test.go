@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func (s *KeeperTestSuite) TestSuggestWindowSize() {
+	params := types.DefaultAIMDParams()
+	s.Require().NoError(s.feeMarketKeeper.SetParams(s.ctx, params))
+
+	target := params.TargetBlockUtilization()
+
+	// alternating returns a history alternating between target - spread and
+	// target + spread, which has a variance of spread^2 and no positive autocorrelation.
+	alternating := func(spread uint64, blocks int) []uint64 {
+		history := make([]uint64, blocks)
+		for i := range history {
+			if i%2 == 0 {
+				history[i] = target - spread
+			} else {
+				history[i] = target + spread
+			}
+		}
+		return history
+	}
+
+	s.Run("higher variance yields a larger window", func() {
+		tolerance := target / 10
+
+		low, err := s.feeMarketKeeper.SuggestWindowSize(s.ctx, alternating(tolerance, 100))
+		s.Require().NoError(err)
+
+		high, err := s.feeMarketKeeper.SuggestWindowSize(s.ctx, alternating(tolerance*3, 100))
+		s.Require().NoError(err)
+
+		// A standard deviation of one and three times the tolerance needs 1 and 9 blocks.
+		s.Require().Equal(uint64(1), low)
+		s.Require().Equal(uint64(9), high)
+		s.Require().Greater(high, low)
+	})
+
+	s.Run("autocorrelated history yields a larger window", func() {
+		spread := target / 10 * 3
+
+		// Bursts of 10 blocks above and below the target have the same variance as the
+		// alternating history, but each block carries less information.
+		bursty := make([]uint64, 100)
+		for i := range bursty {
+			if (i/10)%2 == 0 {
+				bursty[i] = target - spread
+			} else {
+				bursty[i] = target + spread
+			}
+		}
+
+		independent, err := s.feeMarketKeeper.SuggestWindowSize(s.ctx, alternating(spread, 100))
+		s.Require().NoError(err)
+
+		correlated, err := s.feeMarketKeeper.SuggestWindowSize(s.ctx, bursty)
+		s.Require().NoError(err)
+		s.Require().Greater(correlated, independent)
+	})
+
+	s.Run("a constant history yields a window of one", func() {
+		size, err := s.feeMarketKeeper.SuggestWindowSize(s.ctx, []uint64{target, target, target})
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(1), size)
+	})
+
+	s.Run("the window is capped at the history length", func() {
+		size, err := s.feeMarketKeeper.SuggestWindowSize(s.ctx, alternating(target, 4))
+		s.Require().NoError(err)
+		s.Require().Equal(uint64(4), size)
+	})
+
+	s.Run("insufficient history returns an error", func() {
+		_, err := s.feeMarketKeeper.SuggestWindowSize(s.ctx, []uint64{target})
+		s.Require().ErrorIs(err, types.ErrInsufficientData)
+	})
+}
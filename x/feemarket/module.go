@@ -3,6 +3,7 @@ package feemarket
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 
 	"cosmossdk.io/core/appmodule"
 	"cosmossdk.io/depinject"
@@ -12,6 +13,7 @@ import (
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -21,17 +23,21 @@ import (
 	modulev1 "github.com/skip-mev/feemarket/api/feemarket/feemarket/module/v1"
 	"github.com/skip-mev/feemarket/x/feemarket/client/cli"
 	"github.com/skip-mev/feemarket/x/feemarket/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/simulation"
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
 // ConsensusVersion is the x/feemarket module's consensus version identifier.
-const ConsensusVersion = 1
+const ConsensusVersion = 2
 
 var (
 	_ module.HasName        = AppModule{}
 	_ module.HasGenesis     = AppModule{}
 	_ module.AppModuleBasic = AppModule{}
 	_ module.HasServices    = AppModule{}
+	_ module.HasInvariants  = AppModule{}
+
+	_ module.AppModuleSimulation = AppModule{}
 
 	_ appmodule.AppModule       = AppModule{}
 	_ appmodule.HasBeginBlocker = AppModule{}
@@ -118,6 +124,11 @@ func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
 func (am AppModule) RegisterServices(cfc module.Configurator) {
 	types.RegisterMsgServer(cfc.MsgServer(), keeper.NewMsgServer(&am.k))
 	types.RegisterQueryServer(cfc.QueryServer(), keeper.NewQueryServer(am.k))
+
+	m := keeper.NewMigrator(&am.k)
+	if err := cfc.RegisterMigration(types.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", types.ModuleName, err))
+	}
 }
 
 // DefaultGenesis returns default genesis state as raw bytes for the feemarket
@@ -152,6 +163,26 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(gs)
 }
 
+// RegisterInvariants registers the feemarket module invariants.
+func (am AppModule) RegisterInvariants(ir sdk.InvariantRegistry) {
+	keeper.RegisterInvariants(ir, &am.k)
+}
+
+// GenerateGenesisState creates a randomized GenState of the feemarket module.
+func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
+	simulation.RandomizedGenState(simState)
+}
+
+// RegisterStoreDecoder registers a decoder for feemarket module's types.
+func (AppModule) RegisterStoreDecoder(sdr simtypes.StoreDecoderRegistry) {
+	sdr[types.StoreKey] = simulation.NewDecodeStore()
+}
+
+// WeightedOperations returns the feemarket module operations with their respective weights.
+func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
+	return simulation.WeightedOperations(simState.AppParams, &am.k)
+}
+
 func init() {
 	appmodule.Register(
 		&modulev1.Module{},
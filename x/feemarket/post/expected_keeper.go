@@ -3,6 +3,7 @@ package post
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
@@ -31,6 +32,7 @@ type BankKeeper interface {
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 }
 
 // FeeMarketKeeper defines the expected feemarket keeper.
@@ -43,5 +45,13 @@ type FeeMarketKeeper interface {
 	SetState(ctx sdk.Context, state feemarkettypes.State) error
 	ResolveToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error)
 	GetMinGasPrice(ctx sdk.Context, denom string) (sdk.DecCoin, error)
+	MaxMsgTypeMultiplier(ctx sdk.Context, msgs []sdk.Msg) (math.LegacyDec, error)
 	GetEnabledHeight(ctx sdk.Context) (int64, error)
+	GetFrozenHeight(ctx sdk.Context) (int64, error)
+	IsFeeExempt(ctx sdk.Context, addr sdk.AccAddress) bool
+	AccountFeeDiscount(ctx sdk.Context, params feemarkettypes.Params, addr sdk.AccAddress) (math.LegacyDec, error)
+	RecordRevenue(ctx sdk.Context, msgTypeURL string, fees sdk.Coins) error
+	AccumulateFees(ctx sdk.Context, fees sdk.Coins) error
+	CanAccumulateFees() bool
+	RecordDistributedFees(ctx sdk.Context, fees sdk.Coins) error
 }
@@ -11,7 +11,6 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
-	"github.com/skip-mev/feemarket/x/feemarket/ante"
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
@@ -62,18 +61,26 @@ func (dfd FeeMarketDeductDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, errorsmod.Wrapf(err, "unable to get fee market params")
 	}
 
-	// return if disabled
-	if !params.Enabled {
-		return next(ctx, tx, simulate, success)
-	}
-
 	enabledHeight, err := dfd.feemarketKeeper.GetEnabledHeight(ctx)
 	if err != nil {
 		return ctx, errorsmod.Wrapf(err, "unable to get fee market enabled height")
 	}
 
+	// a fee market disabled after being enabled is frozen: it charges its last base gas price,
+	// but no longer records block utilization. Return if disabled and not frozen.
+	frozen := !params.Enabled
+	if frozen {
+		frozenHeight, err := dfd.feemarketKeeper.GetFrozenHeight(ctx)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "unable to get fee market frozen height")
+		}
+		if frozenHeight < 0 {
+			return next(ctx, tx, simulate, success)
+		}
+	}
+
 	// if the current height is that which enabled the feemarket or lower, skip deduction
-	if ctx.BlockHeight() <= enabledHeight {
+	if !frozen && ctx.BlockHeight() <= enabledHeight {
 		return next(ctx, tx, simulate, success)
 	}
 
@@ -83,14 +90,45 @@ func (dfd FeeMarketDeductDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simul
 		return ctx, errorsmod.Wrapf(err, "unable to get fee market state")
 	}
 
-	feeCoins := feeTx.GetFee()
 	gas := ctx.GasMeter().GasConsumed() // use context gas consumed
 
+	// allowlisted module accounts pay no fees, but their gas still counts towards block utilization
+	if !dfd.feemarketKeeper.IsFeeExempt(ctx, feeTx.FeePayer()) {
+		if err := dfd.deductFees(ctx, tx, feeTx, params, simulate); err != nil {
+			return ctx, err
+		}
+	}
+
+	if !frozen {
+		err = state.Update(gas, params)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "unable to update fee market state")
+		}
+
+		err = dfd.feemarketKeeper.SetState(ctx, state)
+		if err != nil {
+			return ctx, errorsmod.Wrapf(err, "unable to set fee market state")
+		}
+	}
+
+	if simulate {
+		// consume the gas that would be consumed during normal execution
+		ctx.GasMeter().ConsumeGas(BankSendGasConsumption, "simulation send gas consumption")
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// deductFees checks the fee provided by the tx against the gas consumed and pays out the
+// resulting fee and tip.
+func (dfd FeeMarketDeductDecorator) deductFees(ctx sdk.Context, tx sdk.Tx, feeTx sdk.FeeTx, params feemarkettypes.Params, simulate bool) error {
+	feeCoins := feeTx.GetFee()
+
 	if len(feeCoins) == 0 && !simulate {
-		return ctx, errorsmod.Wrapf(feemarkettypes.ErrNoFeeCoins, "got length %d", len(feeCoins))
+		return errorsmod.Wrapf(feemarkettypes.ErrNoFeeCoins, "got length %d", len(feeCoins))
 	}
 	if len(feeCoins) > 1 {
-		return ctx, errorsmod.Wrapf(feemarkettypes.ErrTooManyFeeCoins, "got length %d", len(feeCoins))
+		return errorsmod.Wrapf(feemarkettypes.ErrTooManyFeeCoins, "got length %d", len(feeCoins))
 	}
 
 	// if simulating and user did not provider a fee - create a dummy value for them
@@ -106,18 +144,32 @@ func (dfd FeeMarketDeductDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simul
 
 	minGasPrice, err := dfd.feemarketKeeper.GetMinGasPrice(ctx, payCoin.GetDenom())
 	if err != nil {
-		return ctx, errorsmod.Wrapf(err, "unable to get min gas price for denom %s", payCoin.GetDenom())
+		return errorsmod.Wrapf(err, "unable to get min gas price for denom %s", payCoin.GetDenom())
+	}
+
+	// the tx pays the multiplier of its most expensive message type, as in the ante handler
+	multiplier, err := dfd.feemarketKeeper.MaxMsgTypeMultiplier(ctx, tx.GetMsgs())
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to get message type multiplier")
 	}
+	minGasPrice.Amount = minGasPrice.Amount.Mul(multiplier)
+
+	// the loyalty discount is that of the ante handler, which does not change within a block
+	discount, err := dfd.feemarketKeeper.AccountFeeDiscount(ctx, params, feemarkettypes.FeeDeductionAddress(feeTx))
+	if err != nil {
+		return errorsmod.Wrapf(err, "unable to get fee discount")
+	}
+	minGasPrice.Amount = minGasPrice.Amount.Mul(math.LegacyOneDec().Sub(discount))
 
 	ctx.Logger().Debug("fee deduct post handle",
 		"min gas prices", minGasPrice,
-		"gas consumed", gas,
+		"gas consumed", ctx.GasMeter().GasConsumed(),
 	)
 
 	if !simulate {
-		payCoin, tip, err = ante.CheckTxFee(ctx, minGasPrice, payCoin, feeGas, false)
+		payCoin, tip, err = feemarkettypes.CheckTxFee(ctx, minGasPrice, payCoin, feeGas, false)
 		if err != nil {
-			return ctx, err
+			return err
 		}
 	}
 
@@ -127,25 +179,18 @@ func (dfd FeeMarketDeductDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simul
 	)
 
 	if err := dfd.PayOutFeeAndTip(ctx, payCoin, tip); err != nil {
-		return ctx, err
-	}
-
-	err = state.Update(gas, params)
-	if err != nil {
-		return ctx, errorsmod.Wrapf(err, "unable to update fee market state")
-	}
-
-	err = dfd.feemarketKeeper.SetState(ctx, state)
-	if err != nil {
-		return ctx, errorsmod.Wrapf(err, "unable to set fee market state")
+		return err
 	}
 
-	if simulate {
-		// consume the gas that would be consumed during normal execution
-		ctx.GasMeter().ConsumeGas(BankSendGasConsumption, "simulation send gas consumption")
+	if !simulate && params.RecordAnalytics {
+		// revenue attribution is opt-in analytics, so the tx pays for the write
+		err = dfd.feemarketKeeper.RecordRevenue(ctx, feemarkettypes.PrimaryMsgTypeURL(tx), sdk.NewCoins(payCoin))
+		if err != nil {
+			return errorsmod.Wrapf(err, "unable to record fee revenue")
+		}
 	}
 
-	return next(ctx, tx, simulate, success)
+	return nil
 }
 
 // PayOutFeeAndTip deducts the provided fee and tip from the fee payer.
@@ -160,15 +205,41 @@ func (dfd FeeMarketDeductDecorator) PayOutFeeAndTip(ctx sdk.Context, fee, tip sd
 
 	// deduct the fees and tip
 	if !fee.IsNil() {
-		err := DeductCoins(dfd.bankKeeper, ctx, sdk.NewCoins(fee), params.DistributeFees)
+		burn, keep := splitFee(sdk.NewCoins(fee), params.BurnFraction)
+		if !burn.IsZero() {
+			if err := dfd.bankKeeper.BurnCoins(ctx, feemarkettypes.FeeCollectorName, burn); err != nil {
+				return err
+			}
+
+			events = append(events, sdk.NewEvent(
+				feemarkettypes.EventTypeFeeBurn,
+				sdk.NewAttribute(sdk.AttributeKeyAmount, burn.String()),
+			))
+		}
+
+		// without a bank keeper to distribute them, fees are distributed in every transaction
+		if params.AccumulatesFees() && dfd.feemarketKeeper.CanAccumulateFees() {
+			// the fee stays in the escrow until the end of the distribution epoch
+			err = dfd.feemarketKeeper.AccumulateFees(ctx, keep)
+		} else {
+			err = DeductCoins(dfd.bankKeeper, ctx, keep, params.DistributeFees)
+			if err == nil && params.DistributeFees {
+				// the community pool's share of the fees distributed over the block is
+				// recorded in EndBlock, so the tx only pays for adding to them
+				err = dfd.feemarketKeeper.RecordDistributedFees(ctx, keep)
+			}
+		}
 		if err != nil {
 			return err
 		}
 
-		events = append(events, sdk.NewEvent(
-			feemarkettypes.EventTypeFeePay,
-			sdk.NewAttribute(sdk.AttributeKeyFee, fee.String()),
-		))
+		// the burned part is reported by the fee_burn event
+		if !keep.IsZero() {
+			events = append(events, sdk.NewEvent(
+				feemarkettypes.EventTypeFeePay,
+				sdk.NewAttribute(sdk.AttributeKeyFee, keep.String()),
+			))
+		}
 	}
 
 	proposer := sdk.AccAddress(ctx.BlockHeader().ProposerAddress)
@@ -189,6 +260,72 @@ func (dfd FeeMarketDeductDecorator) PayOutFeeAndTip(ctx sdk.Context, fee, tip sd
 	return nil
 }
 
+// CalculateRefund returns the part of the paid fee in the given denom that exceeds the cost
+// of the gas used at the current min gas price, i.e. max(BaseGasPrice, MinBaseGasPrice)
+// resolved into the denom through the DenomResolver like GetMinGasPrice. The cost is always
+// rounded up, so that the refund is rounded in the chain's favor and never drains the fee
+// escrow. Paid coins in other denoms are not refunded. An empty refund is returned if the
+// paid fee does not exceed the cost.
+func (dfd FeeMarketDeductDecorator) CalculateRefund(ctx sdk.Context, paid sdk.Coins, gasUsed uint64, denom string) (sdk.Coins, error) {
+	minGasPrice, err := dfd.feemarketKeeper.GetMinGasPrice(ctx, denom)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "unable to get min gas price for denom %s", denom)
+	}
+
+	cost := feemarkettypes.ComputeFee(minGasPrice, gasUsed, feemarkettypes.RoundingModeCeil)
+
+	paidAmount := paid.AmountOf(denom)
+	if !paidAmount.GT(cost.Amount) {
+		return sdk.NewCoins(), nil
+	}
+
+	return sdk.NewCoins(sdk.NewCoin(denom, paidAmount.Sub(cost.Amount))), nil
+}
+
+// RefundFee sends the given refund from the escrow back to the fee payer.
+func (dfd FeeMarketDeductDecorator) RefundFee(ctx sdk.Context, payer sdk.AccAddress, refund sdk.Coin) error {
+	err := dfd.bankKeeper.SendCoinsFromModuleToAccount(ctx, feemarkettypes.FeeCollectorName, payer, sdk.NewCoins(refund))
+	if err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		feemarkettypes.EventTypeFeeRefund,
+		sdk.NewAttribute(feemarkettypes.AttributeKeyRefund, refund.String()),
+		sdk.NewAttribute(feemarkettypes.AttributeKeyRefundee, payer.String()),
+	))
+
+	return nil
+}
+
+// SplitFee splits the given base fee into the part that is burned and the part that is
+// kept and forwarded to the fee collector, according to the BurnFraction param. The burned
+// part is rounded down, so that burn + keep is exactly the base fee. With a zero
+// BurnFraction, the entire base fee is kept.
+func (dfd FeeMarketDeductDecorator) SplitFee(ctx sdk.Context, baseFee sdk.Coins) (burn, keep sdk.Coins, err error) {
+	params, err := dfd.feemarketKeeper.GetParams(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting feemarket params: %v", err)
+	}
+
+	burn, keep = splitFee(baseFee, params.BurnFraction)
+	return burn, keep, nil
+}
+
+func splitFee(baseFee sdk.Coins, fraction math.LegacyDec) (burn, keep sdk.Coins) {
+	if fraction.IsNil() || !fraction.IsPositive() {
+		return sdk.NewCoins(), baseFee
+	}
+
+	burn = sdk.NewCoins()
+	for _, coin := range baseFee {
+		amount := coin.Amount.ToLegacyDec().Mul(fraction).TruncateInt()
+		burn = burn.Add(sdk.NewCoin(coin.Denom, amount))
+	}
+
+	return burn, baseFee.Sub(burn...)
+}
+
 // DeductCoins deducts coins from the given account.
 // Coins can be sent to the default fee collector (
 // causes coins to be distributed to stakers) or kept in the fee collector account (soft burn).
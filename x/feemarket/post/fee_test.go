@@ -5,12 +5,15 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/testutil/testdata"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	antesuite "github.com/skip-mev/feemarket/x/feemarket/ante/suite"
 	"github.com/skip-mev/feemarket/x/feemarket/post"
@@ -153,7 +156,7 @@ func TestPostHandleMock(t *testing.T) {
 	const (
 		baseDenom              = "stake"
 		resolvableDenom        = "atom"
-		expectedConsumedGas    = 10631
+		expectedConsumedGas    = 10856
 		expectedConsumedSimGas = expectedConsumedGas + post.BankSendGasConsumption
 		gasLimit               = expectedConsumedSimGas
 	)
@@ -334,11 +337,12 @@ func TestPostHandleMock(t *testing.T) {
 				}
 			},
 			StateUpdate: func(s *antesuite.TestSuite) {
-				// enable the fee market
+				// enable the fee market, starting from a clean state
 				enabledParams := types.DefaultParams()
 				req := &types.MsgParams{
-					Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-					Params:    enabledParams,
+					Authority:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Params:     enabledParams,
+					ResetState: true,
 				}
 
 				_, err := s.MsgServer.Params(s.Ctx, req)
@@ -353,7 +357,7 @@ func TestPostHandleMock(t *testing.T) {
 			Simulate:          false,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: 15340, // extra gas consumed because msg server is run, but deduction is skipped
+			ExpectConsumedGas: 15880, // extra gas consumed because msg server is run, but deduction is skipped
 			Mock:              true,
 		},
 		{
@@ -539,9 +543,9 @@ func TestPostHandle(t *testing.T) {
 	const (
 		baseDenom           = "stake"
 		resolvableDenom     = "atom"
-		expectedConsumedGas = 36650
+		expectedConsumedGas = 36875
 
-		expectedConsumedGasResolve = 36524 // slight difference due to denom resolver
+		expectedConsumedGasResolve = 36749 // slight difference due to denom resolver
 
 		gasLimit = 100000
 	)
@@ -650,7 +654,7 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          false,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: 36650,
+			ExpectConsumedGas: 36875,
 			Mock:              false,
 		},
 		{
@@ -699,7 +703,7 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          false,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: 36650,
+			ExpectConsumedGas: 36875,
 			Mock:              false,
 		},
 		{
@@ -746,11 +750,12 @@ func TestPostHandle(t *testing.T) {
 				}
 			},
 			StateUpdate: func(s *antesuite.TestSuite) {
-				// enable the fee market
+				// enable the fee market, starting from a clean state
 				enabledParams := types.DefaultParams()
 				req := &types.MsgParams{
-					Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
-					Params:    enabledParams,
+					Authority:  authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+					Params:     enabledParams,
+					ResetState: true,
 				}
 
 				_, err := s.MsgServer.Params(s.Ctx, req)
@@ -765,7 +770,7 @@ func TestPostHandle(t *testing.T) {
 			Simulate:          false,
 			ExpPass:           true,
 			ExpErr:            nil,
-			ExpectConsumedGas: 15340, // extra gas consumed because msg server is run, but bank keepers are skipped
+			ExpectConsumedGas: 15880, // extra gas consumed because msg server is run, but bank keepers are skipped
 			Mock:              false,
 		},
 		{
@@ -991,3 +996,578 @@ func TestPostHandle(t *testing.T) {
 		})
 	}
 }
+
+func TestPostHandleRevenueAttribution(t *testing.T) {
+	const gasLimit = 100000
+
+	s := antesuite.SetupTestSuite(t, false)
+	params, err := s.FeeMarketKeeper.GetParams(s.Ctx)
+	require.NoError(t, err)
+	params.RecordAnalytics = true
+	require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+	accs := s.CreateTestAccounts(2)
+
+	feeAmount := types.DefaultMinBaseGasPrice.MulInt64(int64(gasLimit))
+	fee := sdk.NewCoins(sdk.NewCoin("stake", feeAmount.TruncateInt()))
+	s.SetAccountBalances([]antesuite.TestAccountBalance{
+		{TestAccount: accs[0], Coins: fee},
+		{TestAccount: accs[1], Coins: fee},
+	})
+
+	txMsgs := [][]sdk.Msg{
+		{testdata.NewTestMsg(accs[0].Account.GetAddress())},
+		{banktypes.NewMsgSend(accs[1].Account.GetAddress(), accs[0].Account.GetAddress(), sdk.NewCoins())},
+	}
+
+	for _, msgs := range txMsgs {
+		s.TxBuilder = s.ClientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, s.TxBuilder.SetMsgs(msgs...))
+		s.TxBuilder.SetFeeAmount(fee)
+		s.TxBuilder.SetGasLimit(gasLimit)
+
+		tx, err := s.CreateTestTx(nil, nil, nil, "")
+		require.NoError(t, err)
+
+		ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(antesuite.NewTestGasLimit()))
+		ctx, err = s.AnteHandler(ctx, tx, false)
+		require.NoError(t, err)
+
+		_, err = s.PostHandler(ctx, tx, false, true)
+		require.NoError(t, err)
+	}
+
+	revenue, total, err := s.FeeMarketKeeper.GetRevenueByMsgType(s.Ctx)
+	require.NoError(t, err)
+	require.Len(t, revenue, 2)
+	require.True(t, total.IsAllPositive())
+
+	// Attribution is sorted by message type URL and sums to the total revenue.
+	require.Equal(t, sdk.MsgTypeURL(&banktypes.MsgSend{}), revenue[0].MsgTypeUrl)
+	require.Equal(t, sdk.MsgTypeURL(&testdata.TestMsg{}), revenue[1].MsgTypeUrl)
+
+	sum := sdk.NewCoins()
+	for _, r := range revenue {
+		require.True(t, r.Fees.IsAllPositive())
+		sum = sum.Add(r.Fees...)
+	}
+	require.Equal(t, total, sum)
+}
+
+func TestPostHandleRevenueAttributionDisabled(t *testing.T) {
+	const gasLimit = 100000
+
+	s := antesuite.SetupTestSuite(t, false)
+	accs := s.CreateTestAccounts(1)
+
+	feeAmount := types.DefaultMinBaseGasPrice.MulInt64(int64(gasLimit))
+	fee := sdk.NewCoins(sdk.NewCoin("stake", feeAmount.TruncateInt()))
+	s.SetAccountBalances([]antesuite.TestAccountBalance{{TestAccount: accs[0], Coins: fee}})
+
+	s.TxBuilder = s.ClientCtx.TxConfig.NewTxBuilder()
+	require.NoError(t, s.TxBuilder.SetMsgs(testdata.NewTestMsg(accs[0].Account.GetAddress())))
+	s.TxBuilder.SetFeeAmount(fee)
+	s.TxBuilder.SetGasLimit(gasLimit)
+
+	tx, err := s.CreateTestTx(nil, nil, nil, "")
+	require.NoError(t, err)
+
+	ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(antesuite.NewTestGasLimit()))
+	ctx, err = s.AnteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	_, err = s.PostHandler(ctx, tx, false, true)
+	require.NoError(t, err)
+
+	// analytics are off by default, so the fee is not attributed
+	revenue, total, err := s.FeeMarketKeeper.GetRevenueByMsgType(s.Ctx)
+	require.NoError(t, err)
+	require.Empty(t, revenue)
+	require.True(t, total.IsZero())
+}
+
+func TestPostHandleMsgTypeMultiplier(t *testing.T) {
+	const gasLimit = 100000
+
+	// chargedFee runs a tx through the ante and post handlers with the given multiplier
+	// set for its message type and returns the fee it was charged.
+	chargedFee := func(multiplier math.LegacyDec) sdk.Coins {
+		s := antesuite.SetupTestSuite(t, false)
+		require.NoError(t, s.FeeMarketKeeper.SetMsgTypeMultiplier(s.Ctx, sdk.MsgTypeURL(&testdata.TestMsg{}), multiplier))
+
+		params, err := s.FeeMarketKeeper.GetParams(s.Ctx)
+		require.NoError(t, err)
+		params.RecordAnalytics = true
+		require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+		accs := s.CreateTestAccounts(1)
+		feeAmount := types.DefaultMinBaseGasPrice.MulInt64(2 * gasLimit)
+		fee := sdk.NewCoins(sdk.NewCoin("stake", feeAmount.TruncateInt()))
+		s.SetAccountBalances([]antesuite.TestAccountBalance{{TestAccount: accs[0], Coins: fee}})
+
+		s.TxBuilder = s.ClientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, s.TxBuilder.SetMsgs(testdata.NewTestMsg(accs[0].Account.GetAddress())))
+		s.TxBuilder.SetFeeAmount(fee)
+		s.TxBuilder.SetGasLimit(gasLimit)
+
+		tx, err := s.CreateTestTx(nil, nil, nil, "")
+		require.NoError(t, err)
+
+		ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(antesuite.NewTestGasLimit()))
+		ctx, err = s.AnteHandler(ctx, tx, false)
+		require.NoError(t, err)
+
+		_, err = s.PostHandler(ctx, tx, false, true)
+		require.NoError(t, err)
+
+		_, total, err := s.FeeMarketKeeper.GetRevenueByMsgType(s.Ctx)
+		require.NoError(t, err)
+		return total
+	}
+
+	base := chargedFee(math.LegacyOneDec())
+	require.True(t, base.IsAllPositive())
+
+	// the tx pays twice the base fee for the gas it used; the rest is a tip
+	doubled := chargedFee(math.LegacyNewDec(2))
+	require.Equal(t, base.MulInt(math.NewInt(2)), doubled)
+}
+
+func TestPostHandleFeeDiscount(t *testing.T) {
+	const gasLimit = 100000
+
+	// chargedFee runs a tx through the ante and post handlers from an account that paid a
+	// fee of 5 in the previous block, with a single discount tier of one half at the given
+	// min spend, and returns the fee it was charged.
+	chargedFee := func(minSpend int64) sdk.Coins {
+		s := antesuite.SetupTestSuite(t, false)
+
+		params, err := s.FeeMarketKeeper.GetParams(s.Ctx)
+		require.NoError(t, err)
+		params.RecordAnalytics = true
+		params.AccountFeeSpendWindow = 10
+		params.FeeDiscountTiers = []types.FeeDiscountTier{
+			{MinSpend: math.NewInt(minSpend), Discount: math.LegacyMustNewDecFromStr("0.5")},
+		}
+		require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+		accs := s.CreateTestAccounts(1)
+		feeAmount := types.DefaultMinBaseGasPrice.MulInt64(gasLimit)
+		fee := sdk.NewCoins(sdk.NewCoin("stake", feeAmount.TruncateInt()))
+		s.SetAccountBalances([]antesuite.TestAccountBalance{{TestAccount: accs[0], Coins: fee}})
+
+		previous := s.Ctx.WithBlockHeight(s.Ctx.BlockHeight() - 1)
+		spend := sdk.NewCoins(sdk.NewInt64Coin("stake", 5))
+		require.NoError(t, s.FeeMarketKeeper.RecordAccountFeeSpend(previous, accs[0].Account.GetAddress(), spend))
+
+		s.TxBuilder = s.ClientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, s.TxBuilder.SetMsgs(testdata.NewTestMsg(accs[0].Account.GetAddress())))
+		s.TxBuilder.SetFeeAmount(fee)
+		s.TxBuilder.SetGasLimit(gasLimit)
+
+		tx, err := s.CreateTestTx(nil, nil, nil, "")
+		require.NoError(t, err)
+
+		ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(antesuite.NewTestGasLimit()))
+		ctx, err = s.AnteHandler(ctx, tx, false)
+		require.NoError(t, err)
+
+		_, err = s.PostHandler(ctx, tx, false, true)
+		require.NoError(t, err)
+
+		_, total, err := s.FeeMarketKeeper.GetRevenueByMsgType(s.Ctx)
+		require.NoError(t, err)
+		return total
+	}
+
+	// the account's spend does not meet the tier
+	base := chargedFee(9)
+	require.True(t, base.IsAllPositive())
+
+	// the tx pays half the base fee for the gas it used, rounded up; the rest is a tip
+	halved := chargedFee(1)
+	require.Equal(t, base.AmountOf("stake").AddRaw(1).QuoRaw(2), halved.AmountOf("stake"))
+}
+
+func TestPostHandleFrozen(t *testing.T) {
+	const gasLimit = 100000
+
+	s := antesuite.SetupTestSuite(t, false)
+	accs := s.CreateTestAccounts(2)
+
+	frozenPrice := types.DefaultMinBaseGasPrice.MulInt64(3)
+	floorFee := sdk.NewCoins(sdk.NewCoin("stake", types.DefaultMinBaseGasPrice.MulInt64(gasLimit).TruncateInt()))
+	frozenFee := sdk.NewCoins(sdk.NewCoin("stake", frozenPrice.MulInt64(gasLimit).TruncateInt()))
+	s.SetAccountBalances([]antesuite.TestAccountBalance{
+		{TestAccount: accs[0], Coins: floorFee},
+		{TestAccount: accs[1], Coins: frozenFee},
+	})
+
+	// the fee market was frozen at height 5 with its price above the floor
+	s.Ctx = s.Ctx.WithBlockHeight(10)
+	s.FeeMarketKeeper.SetFrozenHeight(s.Ctx, 5)
+	params := types.DefaultParams()
+	params.Enabled = false
+	require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+	state, err := s.FeeMarketKeeper.GetState(s.Ctx)
+	require.NoError(t, err)
+	state.BaseGasPrice = frozenPrice
+	require.NoError(t, s.FeeMarketKeeper.SetState(s.Ctx, state))
+
+	newTx := func(acc antesuite.TestAccount, fee sdk.Coins) sdk.Tx {
+		s.TxBuilder = s.ClientCtx.TxConfig.NewTxBuilder()
+		require.NoError(t, s.TxBuilder.SetMsgs(testdata.NewTestMsg(acc.Account.GetAddress())))
+		s.TxBuilder.SetFeeAmount(fee)
+		s.TxBuilder.SetGasLimit(gasLimit)
+
+		tx, err := s.CreateTestTx(nil, nil, nil, "")
+		require.NoError(t, err)
+		return tx
+	}
+
+	t.Run("a fee below the frozen price is rejected", func(t *testing.T) {
+		ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(antesuite.NewTestGasLimit()))
+		_, err := s.AnteHandler(ctx, newTx(accs[0], floorFee), false)
+		require.ErrorIs(t, err, sdkerrors.ErrInsufficientFee)
+	})
+
+	t.Run("the frozen price is charged without updating the state", func(t *testing.T) {
+		ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(antesuite.NewTestGasLimit()))
+		tx := newTx(accs[1], frozenFee)
+
+		ctx, err := s.AnteHandler(ctx, tx, false)
+		require.NoError(t, err)
+
+		_, err = s.PostHandler(ctx, tx, false, true)
+		require.NoError(t, err)
+
+		balance := s.BankKeeper.GetBalance(ctx, accs[1].Account.GetAddress(), "stake")
+		require.True(t, balance.Amount.LT(frozenFee.AmountOf("stake")))
+
+		got, err := s.FeeMarketKeeper.GetState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, state, got)
+	})
+}
+
+func TestCalculateRefund(t *testing.T) {
+	s := antesuite.SetupTestSuite(t, false)
+	dfd := post.NewFeeMarketDeductDecorator(s.AccountKeeper, s.BankKeeper, s.FeeMarketKeeper)
+
+	state, err := s.FeeMarketKeeper.GetState(s.Ctx)
+	require.NoError(t, err)
+	state.BaseGasPrice = math.LegacyMustNewDecFromStr("1.5")
+	require.NoError(t, s.FeeMarketKeeper.SetState(s.Ctx, state))
+
+	// 1,001 gas at 1.5 costs 1,501.5, which is rounded up to 1,502.
+	const gasUsed = 1_001
+
+	t.Run("refunds an overpayment", func(t *testing.T) {
+		refund, err := dfd.CalculateRefund(s.Ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 2_000)), gasUsed, "stake")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("stake", 498)), refund)
+	})
+
+	t.Run("an exact payment refunds nothing", func(t *testing.T) {
+		refund, err := dfd.CalculateRefund(s.Ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 1_502)), gasUsed, "stake")
+		require.NoError(t, err)
+		require.True(t, refund.IsZero())
+	})
+
+	t.Run("an underpayment refunds nothing", func(t *testing.T) {
+		refund, err := dfd.CalculateRefund(s.Ctx, sdk.NewCoins(sdk.NewInt64Coin("stake", 1_000)), gasUsed, "stake")
+		require.NoError(t, err)
+		require.True(t, refund.IsZero())
+	})
+
+	t.Run("paid coins in other denoms are not refunded", func(t *testing.T) {
+		refund, err := dfd.CalculateRefund(s.Ctx, sdk.NewCoins(sdk.NewInt64Coin("foo", 2_000)), gasUsed, "stake")
+		require.NoError(t, err)
+		require.True(t, refund.IsZero())
+	})
+
+	t.Run("resolves a non-native denom through the denom resolver", func(t *testing.T) {
+		// The test resolver converts at a rate of one.
+		refund, err := dfd.CalculateRefund(s.Ctx, sdk.NewCoins(sdk.NewInt64Coin("foo", 2_000)), gasUsed, "foo")
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 498)), refund)
+	})
+
+	t.Run("errors if the denom cannot be resolved", func(t *testing.T) {
+		s.FeeMarketKeeper.SetDenomResolver(&types.ErrorDenomResolver{})
+		defer s.FeeMarketKeeper.SetDenomResolver(&types.TestDenomResolver{})
+
+		_, err := dfd.CalculateRefund(s.Ctx, sdk.NewCoins(sdk.NewInt64Coin("foo", 2_000)), gasUsed, "foo")
+		require.Error(t, err)
+	})
+}
+
+func TestPostHandleAccumulatesFeesOverEpoch(t *testing.T) {
+	const gasLimit = 100000
+
+	s := antesuite.SetupTestSuite(t, false)
+	s.FeeMarketKeeper.SetBankKeeper(s.BankKeeper)
+	accs := s.CreateTestAccounts(1)
+
+	params, err := s.FeeMarketKeeper.GetParams(s.Ctx)
+	require.NoError(t, err)
+	params.DistributeFees = true
+	params.DistributionEpochBlocks = 10
+	require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+	feeAmount := types.DefaultMinBaseGasPrice.MulInt64(int64(gasLimit))
+	fee := sdk.NewCoins(sdk.NewCoin("stake", feeAmount.TruncateInt()))
+	s.SetAccountBalances([]antesuite.TestAccountBalance{{TestAccount: accs[0], Coins: fee}})
+
+	require.NoError(t, s.TxBuilder.SetMsgs(testdata.NewTestMsg(accs[0].Account.GetAddress())))
+	s.TxBuilder.SetFeeAmount(fee)
+	s.TxBuilder.SetGasLimit(gasLimit)
+
+	tx, err := s.CreateTestTx(nil, nil, nil, "")
+	require.NoError(t, err)
+
+	ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(antesuite.NewTestGasLimit()))
+	ctx, err = s.AnteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	_, err = s.PostHandler(ctx, tx, false, true)
+	require.NoError(t, err)
+
+	// The fee is held in the fee market's fee collector rather than distributed.
+	accumulated, err := s.FeeMarketKeeper.GetAccumulatedFees(s.Ctx)
+	require.NoError(t, err)
+	require.True(t, accumulated.IsAllPositive())
+
+	feeCollector := s.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.True(t, s.BankKeeper.GetAllBalances(s.Ctx, feeCollector).IsZero())
+
+	escrow := s.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	require.True(t, s.BankKeeper.GetAllBalances(s.Ctx, escrow).IsAllGTE(accumulated))
+
+	// At the end of the epoch the accumulated fees are distributed.
+	epochEnd := s.Ctx.WithBlockHeight(int64(params.DistributionEpochBlocks))
+	require.NoError(t, s.FeeMarketKeeper.DistributeAtEpochEnd(epochEnd))
+	require.Equal(t, accumulated, s.BankKeeper.GetAllBalances(s.Ctx, feeCollector))
+
+	accumulated, err = s.FeeMarketKeeper.GetAccumulatedFees(s.Ctx)
+	require.NoError(t, err)
+	require.True(t, accumulated.IsZero())
+}
+
+func TestPostHandleDistributesFeesWithoutBankKeeper(t *testing.T) {
+	const gasLimit = 100000
+
+	// the keeper is not given a bank keeper to distribute accumulated fees with
+	s := antesuite.SetupTestSuite(t, false)
+	accs := s.CreateTestAccounts(1)
+
+	params, err := s.FeeMarketKeeper.GetParams(s.Ctx)
+	require.NoError(t, err)
+	params.DistributeFees = true
+	params.DistributionEpochBlocks = 10
+	require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+	feeAmount := types.DefaultMinBaseGasPrice.MulInt64(int64(gasLimit))
+	fee := sdk.NewCoins(sdk.NewCoin("stake", feeAmount.TruncateInt()))
+	s.SetAccountBalances([]antesuite.TestAccountBalance{{TestAccount: accs[0], Coins: fee}})
+
+	require.NoError(t, s.TxBuilder.SetMsgs(testdata.NewTestMsg(accs[0].Account.GetAddress())))
+	s.TxBuilder.SetFeeAmount(fee)
+	s.TxBuilder.SetGasLimit(gasLimit)
+
+	tx, err := s.CreateTestTx(nil, nil, nil, "")
+	require.NoError(t, err)
+
+	ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(antesuite.NewTestGasLimit()))
+	ctx, err = s.AnteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	// distributing the fee in the transaction costs more gas than accumulating it
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+	_, err = s.PostHandler(ctx, tx, false, true)
+	require.NoError(t, err)
+
+	// The fee is distributed in the transaction rather than accumulated.
+	accumulated, err := s.FeeMarketKeeper.GetAccumulatedFees(s.Ctx)
+	require.NoError(t, err)
+	require.True(t, accumulated.IsZero())
+
+	paid, ok := attribute(t, ctx.EventManager().Events(), types.EventTypeFeePay, sdk.AttributeKeyFee)
+	require.True(t, ok)
+	feeCollector := s.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, sdk.NewCoins(paid), s.BankKeeper.GetAllBalances(s.Ctx, feeCollector))
+}
+
+func TestSplitFee(t *testing.T) {
+	s := antesuite.SetupTestSuite(t, false)
+	dfd := post.NewFeeMarketDeductDecorator(s.AccountKeeper, s.BankKeeper, s.FeeMarketKeeper)
+
+	setBurnFraction := func(fraction string) {
+		params, err := s.FeeMarketKeeper.GetParams(s.Ctx)
+		require.NoError(t, err)
+		params.BurnFraction = math.LegacyMustNewDecFromStr(fraction)
+		require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+	}
+
+	baseFee := sdk.NewCoins(sdk.NewInt64Coin("foo", 7), sdk.NewInt64Coin("stake", 1_001))
+
+	t.Run("a zero fraction keeps the entire base fee", func(t *testing.T) {
+		setBurnFraction("0")
+
+		burn, keep, err := dfd.SplitFee(s.Ctx, baseFee)
+		require.NoError(t, err)
+		require.True(t, burn.IsZero())
+		require.Equal(t, baseFee, keep)
+	})
+
+	t.Run("the remainder of the burned part is kept", func(t *testing.T) {
+		setBurnFraction("0.5")
+
+		// Half of 7 is 3.5 and half of 1,001 is 500.5, which are rounded down.
+		burn, keep, err := dfd.SplitFee(s.Ctx, baseFee)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 3), sdk.NewInt64Coin("stake", 500)), burn)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("foo", 4), sdk.NewInt64Coin("stake", 501)), keep)
+		require.Equal(t, baseFee, burn.Add(keep...))
+	})
+
+	t.Run("a fraction of one burns the entire base fee", func(t *testing.T) {
+		setBurnFraction("1")
+
+		burn, keep, err := dfd.SplitFee(s.Ctx, baseFee)
+		require.NoError(t, err)
+		require.Equal(t, baseFee, burn)
+		require.True(t, keep.IsZero())
+	})
+}
+
+func TestPostHandleBurnsFees(t *testing.T) {
+	const gasLimit = 200000
+
+	s := antesuite.SetupTestSuite(t, false)
+	accs := s.CreateTestAccounts(1)
+
+	params, err := s.FeeMarketKeeper.GetParams(s.Ctx)
+	require.NoError(t, err)
+	params.DistributeFees = true
+	params.BurnFraction = math.LegacyMustNewDecFromStr("0.3")
+	require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+	feeAmount := types.DefaultMinBaseGasPrice.MulInt64(int64(gasLimit))
+	fee := sdk.NewCoins(sdk.NewCoin("stake", feeAmount.TruncateInt()))
+	s.SetAccountBalances([]antesuite.TestAccountBalance{{TestAccount: accs[0], Coins: fee}})
+
+	require.NoError(t, s.TxBuilder.SetMsgs(testdata.NewTestMsg(accs[0].Account.GetAddress())))
+	s.TxBuilder.SetFeeAmount(fee)
+	s.TxBuilder.SetGasLimit(gasLimit)
+
+	tx, err := s.CreateTestTx(nil, nil, nil, "")
+	require.NoError(t, err)
+
+	supply := s.BankKeeper.GetSupply(s.Ctx, "stake")
+
+	ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(antesuite.NewTestGasLimit()))
+	ctx, err = s.AnteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.PostHandler(ctx, tx, false, true)
+	require.NoError(t, err)
+
+	// fee_pay reports the kept part of the base fee and fee_burn the burned part.
+	events := ctx.EventManager().Events()
+	kept, ok := attribute(t, events, types.EventTypeFeePay, sdk.AttributeKeyFee)
+	require.True(t, ok)
+	require.True(t, kept.IsPositive())
+
+	// The burned part of the base fee leaves the supply and the rest reaches the fee collector.
+	burned := supply.Sub(s.BankKeeper.GetSupply(s.Ctx, "stake"))
+	burnedEvent, ok := attribute(t, events, types.EventTypeFeeBurn, sdk.AttributeKeyAmount)
+	require.True(t, ok)
+	require.Equal(t, burned, burnedEvent)
+
+	baseFee := kept.Add(burned)
+	require.Equal(t, baseFee.Amount.ToLegacyDec().Mul(params.BurnFraction).TruncateInt(), burned.Amount)
+
+	feeCollector := s.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, sdk.NewCoins(kept), s.BankKeeper.GetAllBalances(s.Ctx, feeCollector))
+}
+
+func TestPostHandleBurnsAccumulatedFees(t *testing.T) {
+	const gasLimit = 200000
+
+	s := antesuite.SetupTestSuite(t, false)
+	s.FeeMarketKeeper.SetBankKeeper(s.BankKeeper)
+	accs := s.CreateTestAccounts(1)
+
+	params, err := s.FeeMarketKeeper.GetParams(s.Ctx)
+	require.NoError(t, err)
+	params.DistributeFees = true
+	params.DistributionEpochBlocks = 10
+	params.BurnFraction = math.LegacyMustNewDecFromStr("0.3")
+	require.NoError(t, s.FeeMarketKeeper.SetParams(s.Ctx, params))
+
+	feeAmount := types.DefaultMinBaseGasPrice.MulInt64(int64(gasLimit))
+	fee := sdk.NewCoins(sdk.NewCoin("stake", feeAmount.TruncateInt()))
+	s.SetAccountBalances([]antesuite.TestAccountBalance{{TestAccount: accs[0], Coins: fee}})
+
+	require.NoError(t, s.TxBuilder.SetMsgs(testdata.NewTestMsg(accs[0].Account.GetAddress())))
+	s.TxBuilder.SetFeeAmount(fee)
+	s.TxBuilder.SetGasLimit(gasLimit)
+
+	tx, err := s.CreateTestTx(nil, nil, nil, "")
+	require.NoError(t, err)
+
+	supply := s.BankKeeper.GetSupply(s.Ctx, "stake")
+
+	ctx := s.Ctx.WithGasMeter(storetypes.NewGasMeter(antesuite.NewTestGasLimit()))
+	ctx, err = s.AnteHandler(ctx, tx, false)
+	require.NoError(t, err)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = s.PostHandler(ctx, tx, false, true)
+	require.NoError(t, err)
+
+	events := ctx.EventManager().Events()
+	kept, ok := attribute(t, events, types.EventTypeFeePay, sdk.AttributeKeyFee)
+	require.True(t, ok)
+	burned := supply.Sub(s.BankKeeper.GetSupply(s.Ctx, "stake"))
+	require.True(t, burned.IsPositive())
+	require.Equal(t, kept.Add(burned).Amount.ToLegacyDec().Mul(params.BurnFraction).TruncateInt(), burned.Amount)
+
+	// Only the kept part of the base fee is accumulated for the epoch.
+	accumulated, err := s.FeeMarketKeeper.GetAccumulatedFees(s.Ctx)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(kept), accumulated)
+
+	// The escrow holds exactly the accumulated fees once the burn and the tip left it.
+	escrow := s.AccountKeeper.GetModuleAddress(types.FeeCollectorName)
+	require.Equal(t, accumulated, s.BankKeeper.GetAllBalances(s.Ctx, escrow))
+
+	// Distributing at the end of the epoch empties the escrow.
+	epochEnd := s.Ctx.WithBlockHeight(int64(params.DistributionEpochBlocks))
+	require.NoError(t, s.FeeMarketKeeper.DistributeAtEpochEnd(epochEnd))
+	require.True(t, s.BankKeeper.GetAllBalances(s.Ctx, escrow).IsZero())
+
+	feeCollector := s.AccountKeeper.GetModuleAddress(authtypes.FeeCollectorName)
+	require.Equal(t, accumulated, s.BankKeeper.GetAllBalances(s.Ctx, feeCollector))
+}
+
+// attribute returns the coin in the given attribute of the first event of the given type.
+func attribute(t *testing.T, events sdk.Events, eventType, key string) (sdk.Coin, bool) {
+	t.Helper()
+
+	for _, event := range events {
+		if event.Type != eventType {
+			continue
+		}
+		for _, attr := range event.Attributes {
+			if attr.Key == key {
+				coin, err := sdk.ParseCoinNormalized(attr.Value)
+				require.NoError(t, err)
+				return coin, true
+			}
+		}
+	}
+	return sdk.Coin{}, false
+}
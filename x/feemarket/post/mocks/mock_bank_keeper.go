@@ -15,6 +15,24 @@ type BankKeeper struct {
 	mock.Mock
 }
 
+// BurnCoins provides a mock function with given fields: ctx, moduleName, amt
+func (_m *BankKeeper) BurnCoins(ctx context.Context, moduleName string, amt types.Coins) error {
+	ret := _m.Called(ctx, moduleName, amt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BurnCoins")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, types.Coins) error); ok {
+		r0 = rf(ctx, moduleName, amt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // IsSendEnabledCoins provides a mock function with given fields: ctx, coins
 func (_m *BankKeeper) IsSendEnabledCoins(ctx context.Context, coins ...types.Coin) error {
 	_va := make([]interface{}, len(coins))
@@ -3,6 +3,7 @@
 package mocks
 
 import (
+	math "cosmossdk.io/math"
 	mock "github.com/stretchr/testify/mock"
 
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
@@ -15,6 +16,70 @@ type FeeMarketKeeper struct {
 	mock.Mock
 }
 
+// AccountFeeDiscount provides a mock function with given fields: ctx, params, addr
+func (_m *FeeMarketKeeper) AccountFeeDiscount(ctx types.Context, params feemarkettypes.Params, addr types.AccAddress) (math.LegacyDec, error) {
+	ret := _m.Called(ctx, params, addr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AccountFeeDiscount")
+	}
+
+	var r0 math.LegacyDec
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, feemarkettypes.Params, types.AccAddress) (math.LegacyDec, error)); ok {
+		return rf(ctx, params, addr)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, feemarkettypes.Params, types.AccAddress) math.LegacyDec); ok {
+		r0 = rf(ctx, params, addr)
+	} else {
+		r0 = ret.Get(0).(math.LegacyDec)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, feemarkettypes.Params, types.AccAddress) error); ok {
+		r1 = rf(ctx, params, addr)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// AccumulateFees provides a mock function with given fields: ctx, fees
+func (_m *FeeMarketKeeper) AccumulateFees(ctx types.Context, fees types.Coins) error {
+	ret := _m.Called(ctx, fees)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AccumulateFees")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, types.Coins) error); ok {
+		r0 = rf(ctx, fees)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CanAccumulateFees provides a mock function with given fields:
+func (_m *FeeMarketKeeper) CanAccumulateFees() bool {
+	ret := _m.Called()
+
+	if len(ret) == 0 {
+		panic("no return value specified for CanAccumulateFees")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // GetEnabledHeight provides a mock function with given fields: ctx
 func (_m *FeeMarketKeeper) GetEnabledHeight(ctx types.Context) (int64, error) {
 	ret := _m.Called(ctx)
@@ -43,6 +108,34 @@ func (_m *FeeMarketKeeper) GetEnabledHeight(ctx types.Context) (int64, error) {
 	return r0, r1
 }
 
+// GetFrozenHeight provides a mock function with given fields: ctx
+func (_m *FeeMarketKeeper) GetFrozenHeight(ctx types.Context) (int64, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetFrozenHeight")
+	}
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context) (int64, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context) int64); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMinGasPrice provides a mock function with given fields: ctx, denom
 func (_m *FeeMarketKeeper) GetMinGasPrice(ctx types.Context, denom string) (types.DecCoin, error) {
 	ret := _m.Called(ctx, denom)
@@ -127,6 +220,88 @@ func (_m *FeeMarketKeeper) GetState(ctx types.Context) (feemarkettypes.State, er
 	return r0, r1
 }
 
+// IsFeeExempt provides a mock function with given fields: ctx, addr
+func (_m *FeeMarketKeeper) IsFeeExempt(ctx types.Context, addr types.AccAddress) bool {
+	ret := _m.Called(ctx, addr)
+
+	if len(ret) == 0 {
+		panic("no return value specified for IsFeeExempt")
+	}
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(types.Context, types.AccAddress) bool); ok {
+		r0 = rf(ctx, addr)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// MaxMsgTypeMultiplier provides a mock function with given fields: ctx, msgs
+func (_m *FeeMarketKeeper) MaxMsgTypeMultiplier(ctx types.Context, msgs []types.Msg) (math.LegacyDec, error) {
+	ret := _m.Called(ctx, msgs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MaxMsgTypeMultiplier")
+	}
+
+	var r0 math.LegacyDec
+	var r1 error
+	if rf, ok := ret.Get(0).(func(types.Context, []types.Msg) (math.LegacyDec, error)); ok {
+		return rf(ctx, msgs)
+	}
+	if rf, ok := ret.Get(0).(func(types.Context, []types.Msg) math.LegacyDec); ok {
+		r0 = rf(ctx, msgs)
+	} else {
+		r0 = ret.Get(0).(math.LegacyDec)
+	}
+
+	if rf, ok := ret.Get(1).(func(types.Context, []types.Msg) error); ok {
+		r1 = rf(ctx, msgs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RecordDistributedFees provides a mock function with given fields: ctx, fees
+func (_m *FeeMarketKeeper) RecordDistributedFees(ctx types.Context, fees types.Coins) error {
+	ret := _m.Called(ctx, fees)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordDistributedFees")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, types.Coins) error); ok {
+		r0 = rf(ctx, fees)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RecordRevenue provides a mock function with given fields: ctx, msgTypeURL, fees
+func (_m *FeeMarketKeeper) RecordRevenue(ctx types.Context, msgTypeURL string, fees types.Coins) error {
+	ret := _m.Called(ctx, msgTypeURL, fees)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RecordRevenue")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(types.Context, string, types.Coins) error); ok {
+		r0 = rf(ctx, msgTypeURL, fees)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ResolveToDenom provides a mock function with given fields: ctx, coin, denom
 func (_m *FeeMarketKeeper) ResolveToDenom(ctx types.Context, coin types.DecCoin, denom string) (types.DecCoin, error) {
 	ret := _m.Called(ctx, coin, denom)
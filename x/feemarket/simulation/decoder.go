@@ -0,0 +1,96 @@
+package simulation
+
+import (
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding feemarket type.
+func NewDecodeStore() func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch kvA.Key[0] {
+		case types.KeyParams[0]:
+			return decodeProto(kvA.Value, kvB.Value, &types.Params{}, &types.Params{})
+
+		case types.KeyState[0]:
+			return decodeProto(kvA.Value, kvB.Value, &types.State{}, &types.State{})
+
+		case types.KeyEnabledHeight[0], types.KeyFrozenHeight[0]:
+			heightA, errA := strconv.ParseInt(string(kvA.Value), 10, 64)
+			heightB, errB := strconv.ParseInt(string(kvB.Value), 10, 64)
+			if errA != nil || errB != nil {
+				panic(fmt.Sprintf("invalid height %q, %q", kvA.Value, kvB.Value))
+			}
+			return fmt.Sprintf("%d\n%d", heightA, heightB)
+
+		case types.KeyPrefixRevenue[0]:
+			return decodeProto(kvA.Value, kvB.Value, &types.MsgTypeRevenue{}, &types.MsgTypeRevenue{})
+
+		case types.KeyPrefixAccountFeeSpend[0]:
+			return decodeProto(kvA.Value, kvB.Value, &types.AccountFeeSpend{}, &types.AccountFeeSpend{})
+
+		case types.KeyPrefixAccountFeeSpendByHeight[0]:
+			// The index has no value, so print the account it indexes.
+			addrA := sdk.AccAddress(kvA.Key[len(types.AccountFeeSpendHeightPrefix(0)):])
+			addrB := sdk.AccAddress(kvB.Key[len(types.AccountFeeSpendHeightPrefix(0)):])
+			return fmt.Sprintf("%s\n%s", addrA, addrB)
+
+		case types.KeyPrefixObservation[0]:
+			return decodeProto(kvA.Value, kvB.Value, &types.BlockObservation{}, &types.BlockObservation{})
+
+		case types.KeyAccumulatedFees[0], types.KeyPendingDistributedFees[0]:
+			return decodeProto(kvA.Value, kvB.Value, &types.AccumulatedFees{}, &types.AccumulatedFees{})
+
+		case types.KeyPrefixResolverRate[0], types.KeyPrefixDenomMinGasPrice[0], types.KeyPrefixMsgTypeMultiplier[0]:
+			var rateA, rateB math.LegacyDec
+			if err := rateA.Unmarshal(kvA.Value); err != nil {
+				panic(err)
+			}
+			if err := rateB.Unmarshal(kvB.Value); err != nil {
+				panic(err)
+			}
+			return fmt.Sprintf("%v\n%v", rateA, rateB)
+
+		case types.KeyPrefixBaseGasPriceHistory[0]:
+			return decodeProto(kvA.Value, kvB.Value, &types.BaseGasPriceRecord{}, &types.BaseGasPriceRecord{})
+
+		case types.KeyCommunityPoolContributions[0]:
+			return decodeProto(kvA.Value, kvB.Value, &types.CommunityPoolContributions{}, &types.CommunityPoolContributions{})
+
+		case types.KeyPrefixBlockCommunityPoolContribution[0]:
+			return decodeProto(kvA.Value, kvB.Value, &types.BlockCommunityPoolContribution{}, &types.BlockCommunityPoolContribution{})
+
+		case types.KeyPrefixCompactBaseGasPriceHistory[0]:
+			return decodeProto(kvA.Value, kvB.Value, &types.BaseGasPriceChange{}, &types.BaseGasPriceChange{})
+
+		case types.KeyCompactBaseGasPriceHistoryTip[0]:
+			return decodeProto(kvA.Value, kvB.Value, &types.BaseGasPriceRecord{}, &types.BaseGasPriceRecord{})
+
+		case types.KeyDenomResolverName[0]:
+			return fmt.Sprintf("%s\n%s", kvA.Value, kvB.Value)
+
+		default:
+			panic(fmt.Sprintf("invalid feemarket key prefix %X", kvA.Key[:1]))
+		}
+	}
+}
+
+// decodeProto unmarshals the given values into a and b and prints them one per line.
+func decodeProto(valueA, valueB []byte, a, b proto.Message) string {
+	if err := proto.Unmarshal(valueA, a); err != nil {
+		panic(err)
+	}
+	if err := proto.Unmarshal(valueB, b); err != nil {
+		panic(err)
+	}
+
+	return fmt.Sprintf("%v\n%v", a, b)
+}
@@ -0,0 +1,79 @@
+package simulation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/skip-mev/feemarket/x/feemarket/simulation"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestDecodeStore(t *testing.T) {
+	dec := simulation.NewDecodeStore()
+
+	params := types.DefaultParams()
+	paramsBz, err := params.Marshal()
+	require.NoError(t, err)
+
+	state := types.DefaultState()
+	stateBz, err := state.Marshal()
+	require.NoError(t, err)
+
+	record := types.BaseGasPriceRecord{Height: 10, BaseGasPrice: math.LegacyOneDec()}
+	recordBz, err := record.Marshal()
+	require.NoError(t, err)
+
+	rate := math.LegacyMustNewDecFromStr("2.5")
+	rateBz, err := rate.Marshal()
+	require.NoError(t, err)
+
+	contributions := types.CommunityPoolContributions{
+		Amount:      sdk.NewDecCoins(sdk.NewInt64DecCoin("stake", 5)),
+		SinceHeight: 7,
+	}
+	contributionsBz, err := contributions.Marshal()
+	require.NoError(t, err)
+
+	change := types.BaseGasPriceChange{Delta: math.LegacyMustNewDecFromStr("-0.5")}
+	changeBz, err := change.Marshal()
+	require.NoError(t, err)
+
+	addr := sdk.AccAddress("addr________________")
+
+	testCases := []struct {
+		name     string
+		pair     kv.Pair
+		expected string
+	}{
+		{"params", kv.Pair{Key: types.KeyParams, Value: paramsBz}, fmt.Sprintf("%v\n%v", &params, &params)},
+		{"state", kv.Pair{Key: types.KeyState, Value: stateBz}, fmt.Sprintf("%v\n%v", &state, &state)},
+		{"enabled height", kv.Pair{Key: types.KeyEnabledHeight, Value: []byte("42")}, "42\n42"},
+		{"frozen height", kv.Pair{Key: types.KeyFrozenHeight, Value: []byte("43")}, "43\n43"},
+		{"base gas price history", kv.Pair{Key: types.BaseGasPriceHistoryKey(0), Value: recordBz}, fmt.Sprintf("%v\n%v", &record, &record)},
+		{"resolver rate", kv.Pair{Key: types.ResolverRateKey("uatom"), Value: rateBz}, fmt.Sprintf("%v\n%v", rate, rate)},
+		{"denom min gas price", kv.Pair{Key: types.DenomMinGasPriceKey("uatom"), Value: rateBz}, fmt.Sprintf("%v\n%v", rate, rate)},
+		{"community pool contributions", kv.Pair{Key: types.KeyCommunityPoolContributions, Value: contributionsBz}, fmt.Sprintf("%v\n%v", &contributions, &contributions)},
+		{"compact base gas price history", kv.Pair{Key: types.CompactBaseGasPriceHistoryKey(10), Value: changeBz}, fmt.Sprintf("%v\n%v", &change, &change)},
+		{"account fee spend index", kv.Pair{Key: types.AccountFeeSpendIndexKey(10, addr), Value: []byte{}}, fmt.Sprintf("%s\n%s", addr, addr)},
+		{"compact base gas price history tip", kv.Pair{Key: types.KeyCompactBaseGasPriceHistoryTip, Value: recordBz}, fmt.Sprintf("%v\n%v", &record, &record)},
+		{"denom resolver name", kv.Pair{Key: types.KeyDenomResolverName, Value: []byte("oracle")}, "oracle\noracle"},
+		{"msg type multiplier", kv.Pair{Key: types.MsgTypeMultiplierKey("/cosmos.bank.v1beta1.MsgSend"), Value: rateBz}, fmt.Sprintf("%v\n%v", rate, rate)},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, dec(tc.pair, tc.pair))
+		})
+	}
+
+	t.Run("unknown prefix", func(t *testing.T) {
+		pair := kv.Pair{Key: []byte{0xff}, Value: []byte{0x01}}
+		require.Panics(t, func() { dec(pair, pair) })
+	})
+}
@@ -0,0 +1,169 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// Simulation parameter constants
+const (
+	Window              = "window"
+	Alpha               = "alpha"
+	Beta                = "beta"
+	Gamma               = "gamma"
+	Delta               = "delta"
+	MaxBlockUtilization = "max_block_utilization"
+	MinBaseGasPrice     = "min_base_gas_price"
+	MinLearningRate     = "min_learning_rate"
+	MaxLearningRate     = "max_learning_rate"
+)
+
+// GenWindow randomized Window
+func GenWindow(r *rand.Rand) uint64 {
+	return uint64(r.Intn(64) + 1)
+}
+
+// GenAlpha randomized Alpha, in [0, 0.1)
+func GenAlpha(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(100)), 3)
+}
+
+// GenBeta randomized Beta, in [0.8, 1)
+func GenBeta(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(800+r.Intn(200)), 3)
+}
+
+// GenGamma randomized Gamma, in [0, 0.5]
+func GenGamma(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(501)), 3)
+}
+
+// GenDelta randomized Delta, in [0, 0.1)
+func GenDelta(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(100)), 3)
+}
+
+// GenMaxBlockUtilization randomized MaxBlockUtilization, in [1,000,000, 100,000,000]
+func GenMaxBlockUtilization(r *rand.Rand) uint64 {
+	return uint64(1_000_000 + r.Int63n(99_000_001))
+}
+
+// GenMinBaseGasPrice randomized MinBaseGasPrice, in (0, 0.01]. The floor is kept low so
+// that the randomly generated fees of other modules' operations usually cover it.
+func GenMinBaseGasPrice(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(r.Intn(10_000)+1), 6)
+}
+
+// GenMinLearningRate randomized MinLearningRate, in [0.01, 0.125]
+func GenMinLearningRate(r *rand.Rand) math.LegacyDec {
+	return math.LegacyNewDecWithPrec(int64(10+r.Intn(116)), 3)
+}
+
+// GenMaxLearningRate randomized MaxLearningRate, in [minLearningRate, minLearningRate + 0.5)
+func GenMaxLearningRate(r *rand.Rand, minLearningRate math.LegacyDec) math.LegacyDec {
+	return minLearningRate.Add(math.LegacyNewDecWithPrec(int64(r.Intn(500)), 3))
+}
+
+// RandomParams returns random valid params with the given fee denom. The min learning rate
+// never exceeds the max learning rate and the controller coefficients are non-negative.
+func RandomParams(r *rand.Rand, feeDenom string) types.Params {
+	minLearningRate := GenMinLearningRate(r)
+
+	return types.NewParams(
+		GenWindow(r),
+		GenAlpha(r),
+		GenBeta(r),
+		GenGamma(r),
+		GenDelta(r),
+		GenMaxBlockUtilization(r),
+		GenMinBaseGasPrice(r),
+		minLearningRate,
+		GenMaxLearningRate(r, minLearningRate),
+		feeDenom,
+		true,
+	)
+}
+
+// RandomState returns a random state that is consistent with the given params: the window
+// holds params.Window blocks, each within the max block utilization, the base gas price is
+// at or above the floor and the learning rate is within the learning rate bounds.
+func RandomState(r *rand.Rand, params types.Params) types.State {
+	baseGasPrice := params.MinBaseGasPrice.Mul(math.LegacyNewDecWithPrec(int64(100+r.Intn(900)), 2))
+
+	learningRate := params.MinLearningRate
+	if spread := params.MaxLearningRate.Sub(params.MinLearningRate); spread.IsPositive() {
+		learningRate = learningRate.Add(spread.MulInt64(int64(r.Intn(101))).QuoInt64(100))
+	}
+
+	state := types.NewState(params.Window, baseGasPrice, learningRate)
+	for i := range state.Window {
+		state.Window[i] = uint64(r.Int63n(int64(params.MaxBlockUtilization) + 1))
+	}
+	state.Index = uint64(r.Int63n(int64(params.Window)))
+	state.ReconcileWindowSum()
+
+	return state
+}
+
+// RandomizedGenState generates a random GenesisState for feemarket
+func RandomizedGenState(simState *module.SimulationState) {
+	var window uint64
+	simState.AppParams.GetOrGenerate(Window, &window, simState.Rand, func(r *rand.Rand) { window = GenWindow(r) })
+
+	var alpha math.LegacyDec
+	simState.AppParams.GetOrGenerate(Alpha, &alpha, simState.Rand, func(r *rand.Rand) { alpha = GenAlpha(r) })
+
+	var beta math.LegacyDec
+	simState.AppParams.GetOrGenerate(Beta, &beta, simState.Rand, func(r *rand.Rand) { beta = GenBeta(r) })
+
+	var gamma math.LegacyDec
+	simState.AppParams.GetOrGenerate(Gamma, &gamma, simState.Rand, func(r *rand.Rand) { gamma = GenGamma(r) })
+
+	var delta math.LegacyDec
+	simState.AppParams.GetOrGenerate(Delta, &delta, simState.Rand, func(r *rand.Rand) { delta = GenDelta(r) })
+
+	var maxBlockUtilization uint64
+	simState.AppParams.GetOrGenerate(MaxBlockUtilization, &maxBlockUtilization, simState.Rand, func(r *rand.Rand) {
+		maxBlockUtilization = GenMaxBlockUtilization(r)
+	})
+
+	var minBaseGasPrice math.LegacyDec
+	simState.AppParams.GetOrGenerate(MinBaseGasPrice, &minBaseGasPrice, simState.Rand, func(r *rand.Rand) {
+		minBaseGasPrice = GenMinBaseGasPrice(r)
+	})
+
+	var minLearningRate math.LegacyDec
+	simState.AppParams.GetOrGenerate(MinLearningRate, &minLearningRate, simState.Rand, func(r *rand.Rand) {
+		minLearningRate = GenMinLearningRate(r)
+	})
+
+	var maxLearningRate math.LegacyDec
+	simState.AppParams.GetOrGenerate(MaxLearningRate, &maxLearningRate, simState.Rand, func(r *rand.Rand) {
+		maxLearningRate = GenMaxLearningRate(r, minLearningRate)
+	})
+
+	params := types.NewParams(
+		window,
+		alpha,
+		beta,
+		gamma,
+		delta,
+		maxBlockUtilization,
+		minBaseGasPrice,
+		minLearningRate,
+		maxLearningRate,
+		simState.BondDenom,
+		true,
+	)
+
+	genesis := types.NewGenesisState(params, RandomState(simState.Rand, params))
+	if err := genesis.ValidateBasic(); err != nil {
+		panic(err)
+	}
+
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(genesis)
+}
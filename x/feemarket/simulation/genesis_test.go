@@ -0,0 +1,82 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/skip-mev/feemarket/x/feemarket/simulation"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func newSimulationState(seed int64, appParams simtypes.AppParams) *module.SimulationState {
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+	return &module.SimulationState{
+		AppParams:    appParams,
+		Cdc:          cdc,
+		Rand:         rand.New(rand.NewSource(seed)),
+		GenState:     make(map[string]json.RawMessage),
+		InitialStake: math.NewInt(1_000),
+		BondDenom:    "stake",
+	}
+}
+
+func TestRandomizedGenState(t *testing.T) {
+	for seed := int64(0); seed < 200; seed++ {
+		simState := newSimulationState(seed, make(simtypes.AppParams))
+		simulation.RandomizedGenState(simState)
+
+		var gs types.GenesisState
+		simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &gs)
+
+		require.NoError(t, gs.ValidateBasic(), "seed %d", seed)
+		require.True(t, gs.Params.Enabled)
+		require.Equal(t, "stake", gs.Params.FeeDenom)
+		require.True(t, gs.Params.MinLearningRate.LTE(gs.Params.MaxLearningRate))
+		require.True(t, gs.Params.MinBaseGasPrice.IsPositive())
+
+		require.Less(t, gs.State.Index, uint64(len(gs.State.Window)))
+		require.Equal(t, gs.State.SumWindow(), gs.State.WindowSum)
+		for _, utilization := range gs.State.Window {
+			require.LessOrEqual(t, utilization, gs.Params.MaxBlockUtilization)
+		}
+		require.True(t, gs.State.LearningRate.GTE(gs.Params.MinLearningRate))
+		require.True(t, gs.State.LearningRate.LTE(gs.Params.MaxLearningRate))
+	}
+}
+
+func TestRandomizedGenStateAppParams(t *testing.T) {
+	appParams := simtypes.AppParams{
+		simulation.Window:          json.RawMessage(`16`),
+		simulation.MinLearningRate: json.RawMessage(`"0.050000000000000000"`),
+	}
+
+	simState := newSimulationState(1, appParams)
+	simulation.RandomizedGenState(simState)
+
+	var gs types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &gs)
+
+	require.NoError(t, gs.ValidateBasic())
+	require.Equal(t, uint64(16), gs.Params.Window)
+	require.Len(t, gs.State.Window, 16)
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.05"), gs.Params.MinLearningRate)
+}
+
+func TestRandomParams(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 200; i++ {
+		params := simulation.RandomParams(r, "stake")
+		require.NoError(t, params.ValidateBasic())
+		require.NoError(t, types.NewGenesisState(params, simulation.RandomState(r, params)).ValidateBasic())
+	}
+}
@@ -0,0 +1,63 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/skip-mev/feemarket/x/feemarket/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+// Simulation operation weights constants
+const (
+	// DefaultWeightMsgParams is low so that the controller runs under each param set for a
+	// while before the params change again.
+	DefaultWeightMsgParams int = 5
+
+	OpWeightMsgParams = "op_weight_msg_params"
+)
+
+// WeightedOperations returns all the operations from the module with their respective weights
+func WeightedOperations(appParams simtypes.AppParams, k *keeper.Keeper) simulation.WeightedOperations {
+	var weightMsgParams int
+	appParams.GetOrGenerate(OpWeightMsgParams, &weightMsgParams, nil, func(_ *rand.Rand) {
+		weightMsgParams = DefaultWeightMsgParams
+	})
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgParams,
+			SimulateMsgParams(k),
+		),
+	}
+}
+
+// SimulateMsgParams generates a MsgParams with random params, keeping the fee denom, and
+// executes it as the authority. The authority, typically the governance module account,
+// cannot sign txs, so the message is delivered to the msg server directly.
+func SimulateMsgParams(k *keeper.Keeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, _ *baseapp.BaseApp, ctx sdk.Context, _ []simtypes.Account, _ string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		current, err := k.GetParams(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(&types.MsgParams{}), "unable to get params"), nil, err
+		}
+
+		msg := &types.MsgParams{
+			Authority:  k.GetAuthority(),
+			Params:     RandomParams(r, current.FeeDenom),
+			ResetState: r.Intn(2) == 0,
+		}
+
+		if _, err := keeper.NewMsgServer(k).Params(ctx, msg); err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "unable to update params"), nil, err
+		}
+
+		return simtypes.NewOperationMsg(msg, true, ""), nil, nil
+	}
+}
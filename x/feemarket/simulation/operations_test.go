@@ -0,0 +1,47 @@
+package simulation_test
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	testkeeper "github.com/skip-mev/feemarket/testutils/keeper"
+	"github.com/skip-mev/feemarket/x/feemarket/simulation"
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestWeightedOperations(t *testing.T) {
+	ctx, tk, _ := testkeeper.NewTestSetup(t)
+	k := tk.FeeMarketKeeper
+
+	ops := simulation.WeightedOperations(make(simtypes.AppParams), k)
+	require.Len(t, ops, 1)
+	require.Equal(t, simulation.DefaultWeightMsgParams, ops[0].Weight())
+
+	r := rand.New(rand.NewSource(1))
+	for i := 0; i < 20; i++ {
+		ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1)
+
+		opMsg, futureOps, err := ops[0].Op()(r, nil, ctx, nil, "")
+		require.NoError(t, err)
+		require.True(t, opMsg.OK)
+		require.Equal(t, sdk.MsgTypeURL(&types.MsgParams{}), opMsg.Name)
+		require.Empty(t, futureOps)
+
+		params, err := k.GetParams(ctx)
+		require.NoError(t, err)
+		require.NoError(t, params.ValidateBasic())
+		require.Equal(t, types.DefaultFeeDenom, params.FeeDenom)
+
+		// The controller keeps running under the new params.
+		require.NoError(t, k.UpdateFeeMarket(ctx))
+		state, err := k.GetState(ctx)
+		require.NoError(t, err)
+		require.Len(t, state.Window, int(params.Window))
+		require.True(t, state.BaseGasPrice.GTE(params.MinBaseGasPrice))
+	}
+}
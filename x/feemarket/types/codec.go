@@ -12,6 +12,10 @@ import (
 // provided LegacyAmino codec.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgParams{}, "feemarket/MsgParams")
+	legacy.RegisterAminoMsg(cdc, &MsgResetCommunityPoolContributions{}, "feemarket/MsgResetCPContributions")
+	legacy.RegisterAminoMsg(cdc, &MsgSetResolver{}, "feemarket/MsgSetResolver")
+	legacy.RegisterAminoMsg(cdc, &MsgSetMsgTypeMultiplier{}, "feemarket/MsgSetMsgTypeMultiplier")
+	legacy.RegisterAminoMsg(cdc, &MsgSetDenomMinGasPrice{}, "feemarket/MsgSetDenomMinGasPrice")
 }
 
 // RegisterInterfaces registers the x/feemarket interfaces (messages + msg server) on the
@@ -19,6 +23,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgParams{},
+		&MsgResetCommunityPoolContributions{},
+		&MsgSetResolver{},
+		&MsgSetMsgTypeMultiplier{},
+		&MsgSetDenomMinGasPrice{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -0,0 +1,25 @@
+package types
+
+// CorruptStatePolicy determines how the keeper handles fee market state that cannot be
+// decoded from the store.
+type CorruptStatePolicy uint8
+
+const (
+	// CorruptStatePolicyStrict returns an error when the state cannot be decoded.
+	CorruptStatePolicyStrict CorruptStatePolicy = iota
+	// CorruptStatePolicyReset falls back to a freshly-initialized state derived from the
+	// params, favoring availability over strictness.
+	CorruptStatePolicyReset
+)
+
+// String implements fmt.Stringer.
+func (p CorruptStatePolicy) String() string {
+	switch p {
+	case CorruptStatePolicyStrict:
+		return "strict"
+	case CorruptStatePolicyReset:
+		return "reset"
+	default:
+		return "unknown"
+	}
+}
@@ -79,3 +79,55 @@ func DefaultState() State {
 func DefaultGenesisState() *GenesisState {
 	return NewGenesisState(DefaultParams(), DefaultState())
 }
+
+// EIP1559Equivalent translates the params into their EIP-1559 equivalents. The base fee
+// change denominator corresponds to 1 / MaxLearningRate and the elasticity multiplier to
+// MaxBlockUtilization / TargetBlockUtilization. Features with no EIP-1559 counterpart make
+// the mapping approximate; each of them is described in the returned approximations.
+func (p *Params) EIP1559Equivalent() *EIP1559EquivalentResponse {
+	resp := &EIP1559EquivalentResponse{
+		BaseFeeChangeDenominator: math.LegacyZeroDec(),
+		ElasticityMultiplier:     math.LegacyZeroDec(),
+	}
+
+	if p.MaxLearningRate.IsPositive() {
+		resp.BaseFeeChangeDenominator = math.LegacyOneDec().Quo(p.MaxLearningRate)
+	} else {
+		resp.Approximations = append(resp.Approximations, "max_learning_rate: the base gas price never changes")
+	}
+
+	if target := p.TargetBlockUtilization(); target > 0 {
+		resp.ElasticityMultiplier = math.LegacyNewDec(int64(p.MaxBlockUtilization)).QuoInt64(int64(target))
+	} else {
+		resp.Approximations = append(resp.Approximations, "max_block_utilization: the target block utilization is zero")
+	}
+
+	if !p.MinLearningRate.Equal(p.MaxLearningRate) {
+		resp.Approximations = append(resp.Approximations,
+			"min_learning_rate: the learning rate is adjusted between the minimum and maximum learning rate, "+
+				"so the denominator is only reached at the maximum learning rate")
+	}
+	if !p.Delta.IsNil() && !p.Delta.IsZero() {
+		resp.Approximations = append(resp.Approximations,
+			"delta: the base gas price is additionally adjusted by the net utilization of the window")
+	}
+	if !p.EffectiveMinLearningRate.IsNil() && p.EffectiveMinLearningRate.GT(p.MinLearningRate) {
+		resp.Approximations = append(resp.Approximations,
+			"effective_min_learning_rate: the learning rate is raised for blocks that are nearly empty or full")
+	}
+	if !p.TargetDeadBand.IsNil() && p.TargetDeadBand.IsPositive() {
+		resp.Approximations = append(resp.Approximations,
+			"target_dead_band: the base gas price is held steady near the target utilization")
+	}
+	if p.WarmupBlocks > 0 {
+		resp.Approximations = append(resp.Approximations,
+			"warmup_blocks: price adjustments are dampened after the fee market is enabled")
+	}
+	if p.IsEMA() {
+		resp.Approximations = append(resp.Approximations,
+			"mode: the base gas price is moved by a moving average of the window utilization, not by the utilization of the last block")
+	}
+
+	resp.Exact = len(resp.Approximations) == 0
+	return resp
+}
@@ -5,7 +5,17 @@ import (
 )
 
 var (
-	ErrNoFeeCoins      = sdkerrors.New(ModuleName, 1, "no fee coin provided. Must provide one.")
-	ErrTooManyFeeCoins = sdkerrors.New(ModuleName, 2, "too many fee coins provided.  Only one fee coin may be provided")
-	ErrResolverNotSet  = sdkerrors.New(ModuleName, 3, "denom resolver interface not set.  Only the feemarket base fee denomination can be used")
+	ErrNoFeeCoins           = sdkerrors.New(ModuleName, 1, "no fee coin provided. Must provide one.")
+	ErrTooManyFeeCoins      = sdkerrors.New(ModuleName, 2, "too many fee coins provided.  Only one fee coin may be provided")
+	ErrResolverNotSet       = sdkerrors.New(ModuleName, 3, "denom resolver interface not set.  Only the feemarket base fee denomination can be used")
+	ErrZeroMinBaseGasPrice  = sdkerrors.New(ModuleName, 4, "min base gas price is zero")
+	ErrGasExceedsBlockLimit = sdkerrors.New(ModuleName, 5, "tx gas limit exceeds the block gas limit")
+	ErrNotModuleAccount     = sdkerrors.New(ModuleName, 6, "not a module account")
+	ErrDistributionNotSet   = sdkerrors.New(ModuleName, 7, "distribution keeper not set")
+	ErrRelayEstimatorNotSet = sdkerrors.New(ModuleName, 8, "relay fee estimator not set")
+	ErrInsufficientData     = sdkerrors.New(ModuleName, 9, "insufficient observations")
+	ErrStakingNotSet        = sdkerrors.New(ModuleName, 10, "staking keeper not set")
+	ErrZeroRequiredFee      = sdkerrors.New(ModuleName, 11, "required fee is zero while the fee market is enabled")
+	ErrBankNotSet           = sdkerrors.New(ModuleName, 12, "bank keeper not set")
+	ErrUnknownDenomResolver = sdkerrors.New(ModuleName, 13, "denom resolver not registered")
 )
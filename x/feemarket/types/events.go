@@ -0,0 +1,24 @@
+package types
+
+import (
+	"fmt"
+)
+
+// ApplyParamEvent returns the params that result from applying the given param update
+// event to the current params. This allows off-chain clients that follow events rather
+// than querying state to reconstruct the current params. Since the event carries the
+// full new params, they replace the current params entirely. If the event's params are
+// invalid, the current params are returned along with an error.
+func ApplyParamEvent(current Params, event EventUpdateParams) (Params, error) {
+	if err := event.Params.ValidateBasic(); err != nil {
+		return current, fmt.Errorf("invalid params in update event: %w", err)
+	}
+
+	// Events are JSON encoded, which decodes an empty list of tiers as an empty slice rather
+	// than the nil slice read from the store.
+	if len(event.Params.FeeDiscountTiers) == 0 {
+		event.Params.FeeDiscountTiers = nil
+	}
+
+	return event.Params, nil
+}
@@ -0,0 +1,645 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: feemarket/feemarket/v1/events.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// EventUpdateParams is emitted when the module's parameters are updated. It
+// carries the full new parameters so that the current parameters can be
+// reconstructed from the sequence of events alone.
+type EventUpdateParams struct {
+	// Authority is the account that updated the parameters.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Params are the new parameters.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *EventUpdateParams) Reset()         { *m = EventUpdateParams{} }
+func (m *EventUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateParams) ProtoMessage()    {}
+func (*EventUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6126c7940c606c05, []int{0}
+}
+func (m *EventUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateParams.Merge(m, src)
+}
+func (m *EventUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateParams proto.InternalMessageInfo
+
+func (m *EventUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *EventUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// EventUpdateState is emitted when the base gas price or the learning rate of
+// the module's state changes.
+type EventUpdateState struct {
+	// BaseGasPrice is the new base gas price.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// LearningRate is the new learning rate.
+	LearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=learning_rate,json=learningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"learning_rate"`
+	// WindowUtilization is the total gas consumed over the blocks in the window.
+	WindowUtilization uint64 `protobuf:"varint,3,opt,name=window_utilization,json=windowUtilization,proto3" json:"window_utilization,omitempty"`
+}
+
+func (m *EventUpdateState) Reset()         { *m = EventUpdateState{} }
+func (m *EventUpdateState) String() string { return proto.CompactTextString(m) }
+func (*EventUpdateState) ProtoMessage()    {}
+func (*EventUpdateState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6126c7940c606c05, []int{1}
+}
+func (m *EventUpdateState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventUpdateState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventUpdateState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventUpdateState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventUpdateState.Merge(m, src)
+}
+func (m *EventUpdateState) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventUpdateState) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventUpdateState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventUpdateState proto.InternalMessageInfo
+
+func (m *EventUpdateState) GetWindowUtilization() uint64 {
+	if m != nil {
+		return m.WindowUtilization
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*EventUpdateParams)(nil), "feemarket.feemarket.v1.EventUpdateParams")
+	proto.RegisterType((*EventUpdateState)(nil), "feemarket.feemarket.v1.EventUpdateState")
+}
+
+func init() {
+	proto.RegisterFile("feemarket/feemarket/v1/events.proto", fileDescriptor_6126c7940c606c05)
+}
+
+var fileDescriptor_6126c7940c606c05 = []byte{
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x4f, 0x6b, 0xe2, 0x40,
+	0x18, 0xc6, 0x33, 0xae, 0x08, 0xce, 0xfe, 0x61, 0x0d, 0xb2, 0x64, 0x5d, 0x88, 0xe2, 0x5e, 0xbc,
+	0x24, 0xc1, 0x5d, 0xd8, 0xd3, 0x5e, 0x2a, 0x96, 0x52, 0xe8, 0x41, 0x22, 0xb6, 0xd0, 0x4b, 0x18,
+	0x93, 0xb7, 0x71, 0xd0, 0x64, 0xc2, 0xcc, 0x18, 0x6b, 0xbf, 0x41, 0x6f, 0xfd, 0x30, 0x7e, 0x08,
+	0x8f, 0xe2, 0xa9, 0xf4, 0x20, 0x45, 0xbf, 0x41, 0x3f, 0x41, 0x89, 0x49, 0x1b, 0x0f, 0xed, 0xa5,
+	0xb7, 0x67, 0x78, 0x9e, 0xf9, 0xbd, 0xcf, 0xcb, 0x8b, 0x7f, 0x5f, 0x01, 0x04, 0x84, 0x8f, 0x41,
+	0x5a, 0xb9, 0x8a, 0xdb, 0x16, 0xc4, 0x10, 0x4a, 0x61, 0x46, 0x9c, 0x49, 0xa6, 0xfe, 0x78, 0xb5,
+	0xcc, 0x5c, 0xc5, 0xed, 0x5a, 0xd5, 0x67, 0x3e, 0xdb, 0x47, 0xac, 0x44, 0xa5, 0xe9, 0xda, 0x4f,
+	0x97, 0x89, 0x80, 0x09, 0x27, 0x35, 0xd2, 0x47, 0x66, 0xbd, 0x37, 0x2d, 0x22, 0x9c, 0x04, 0x59,
+	0xa8, 0x79, 0x8b, 0x70, 0xe5, 0x38, 0x19, 0x3f, 0x88, 0x3c, 0x22, 0xa1, 0xb7, 0xf7, 0xd4, 0x7f,
+	0xb8, 0x4c, 0xa6, 0x72, 0xc4, 0x38, 0x95, 0x73, 0x0d, 0x35, 0x50, 0xab, 0xdc, 0xd1, 0xd6, 0x0b,
+	0xa3, 0x9a, 0xf1, 0x8f, 0x3c, 0x8f, 0x83, 0x10, 0x7d, 0xc9, 0x69, 0xe8, 0xdb, 0x79, 0x54, 0xfd,
+	0x8f, 0x4b, 0x29, 0x5d, 0x2b, 0x34, 0x50, 0xeb, 0xf3, 0x1f, 0xdd, 0x7c, 0x7b, 0x19, 0x33, 0x9d,
+	0xd3, 0x29, 0x2e, 0x37, 0x75, 0xc5, 0xce, 0xfe, 0x34, 0x9f, 0x10, 0xfe, 0x7e, 0xd0, 0xa5, 0x2f,
+	0x89, 0x04, 0xf5, 0x02, 0x7f, 0x1b, 0x12, 0x01, 0x8e, 0x4f, 0x92, 0x25, 0xa9, 0x0b, 0x59, 0x9f,
+	0x76, 0xf2, 0xf5, 0x61, 0x53, 0xff, 0x95, 0x76, 0x12, 0xde, 0xd8, 0xa4, 0xcc, 0x0a, 0x88, 0x1c,
+	0x99, 0x67, 0xe0, 0x13, 0x77, 0xde, 0x05, 0x77, 0xbd, 0x30, 0x70, 0x56, 0xb9, 0x0b, 0xae, 0xfd,
+	0x25, 0x01, 0x9d, 0x10, 0xd1, 0x4b, 0x30, 0xea, 0x39, 0xfe, 0x3a, 0x01, 0xc2, 0x43, 0x1a, 0xfa,
+	0x0e, 0x27, 0x12, 0xb4, 0xc2, 0x87, 0xb9, 0x2f, 0x1c, 0x3b, 0x29, 0x6c, 0x60, 0x75, 0x46, 0x43,
+	0x8f, 0xcd, 0x9c, 0xa9, 0xa4, 0x13, 0x7a, 0x43, 0x24, 0x65, 0xa1, 0xf6, 0xa9, 0x81, 0x5a, 0x45,
+	0xbb, 0x92, 0x3a, 0x83, 0xdc, 0xe8, 0x9c, 0x2e, 0xb7, 0x3a, 0x5a, 0x6d, 0x75, 0xf4, 0xb8, 0xd5,
+	0xd1, 0xdd, 0x4e, 0x57, 0x56, 0x3b, 0x5d, 0xb9, 0xdf, 0xe9, 0xca, 0xa5, 0xe5, 0x53, 0x39, 0x9a,
+	0x0e, 0x4d, 0x97, 0x05, 0x96, 0x18, 0xd3, 0xc8, 0x08, 0x20, 0x3e, 0xb8, 0xe4, 0xf5, 0x81, 0x96,
+	0xf3, 0x08, 0xc4, 0xb0, 0xb4, 0x3f, 0xe9, 0xdf, 0xe7, 0x01, 0x00, 0x43, 0xce, 0x81, 0x31, 0x67,
+	0x02, 0x00, 0x00,
+}
+
+func (m *EventUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventUpdateState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventUpdateState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventUpdateState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowUtilization != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.WindowUtilization))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.LearningRate.Size()
+		i -= size
+		if _, err := m.LearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventUpdateState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.LearningRate.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	if m.WindowUtilization != 0 {
+		n += 1 + sovEvents(uint64(m.WindowUtilization))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventUpdateState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventUpdateState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventUpdateState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowUtilization", wireType)
+			}
+			m.WindowUtilization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowUtilization |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvents(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvents
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvents
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvents
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvents        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvents          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvents = fmt.Errorf("proto: unexpected end of group")
+)
@@ -4,6 +4,9 @@ import (
 	"context"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 // AccountKeeper defines the expected account keeper (noalias)
@@ -14,3 +17,33 @@ type AccountKeeper interface {
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx context.Context, name string) sdk.ModuleAccountI
 }
+
+// BankKeeper defines the expected keeper used to distribute accumulated fees (noalias)
+//
+//go:generate mockery --name BankKeeper --filename mock_bank_keeper.go
+type BankKeeper interface {
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
+// DenomMetadataKeeper defines the expected keeper used to look up denom metadata (noalias)
+//
+//go:generate mockery --name DenomMetadataKeeper --filename mock_denom_metadata_keeper.go
+type DenomMetadataKeeper interface {
+	GetDenomMetaData(ctx context.Context, denom string) (banktypes.Metadata, bool)
+}
+
+// DistributionKeeper defines the expected keeper used to look up the community pool and the
+// distribution params (noalias)
+//
+//go:generate mockery --name DistributionKeeper --filename mock_distribution_keeper.go
+type DistributionKeeper interface {
+	CommunityPool(ctx context.Context, req *distrtypes.QueryCommunityPoolRequest) (*distrtypes.QueryCommunityPoolResponse, error)
+	Params(ctx context.Context, req *distrtypes.QueryParamsRequest) (*distrtypes.QueryParamsResponse, error)
+}
+
+// StakingKeeper defines the expected keeper used to look up validators (noalias)
+//
+//go:generate mockery --name StakingKeeper --filename mock_staking_keeper.go
+type StakingKeeper interface {
+	GetValidator(ctx context.Context, addr sdk.ValAddress) (stakingtypes.Validator, error)
+}
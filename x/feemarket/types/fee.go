@@ -0,0 +1,85 @@
+package types
+
+import (
+	"strings"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// RoundingMode determines how a fractional fee amount is rounded to an integer amount.
+type RoundingMode uint8
+
+const (
+	// RoundingModeCeil rounds fractional fees up, so that the fee always pays for the
+	// gas at the given price.
+	RoundingModeCeil RoundingMode = iota
+	// RoundingModeTruncate rounds fractional fees down.
+	RoundingModeTruncate
+	// RoundingModeHalfEven rounds fractional fees to the nearest integer, with halves
+	// rounded to the nearest even integer.
+	RoundingModeHalfEven
+)
+
+// FeeRoundingMode is the rounding mode used both when checking fees in the ante handler
+// and when estimating fees, so that an estimated fee is always accepted. It is fixed and
+// cannot be configured by a chain: the other modes are only available to callers of
+// ComputeFee.
+const FeeRoundingMode = RoundingModeCeil
+
+// ComputeFee returns the fee required to pay for the given amount of gas at the given gas
+// price, where fee = gasPrice * gas rounded to an integer with the given mode.
+func ComputeFee(gasPrice sdk.DecCoin, gas uint64, mode RoundingMode) sdk.Coin {
+	amount := gasPrice.Amount.Mul(math.LegacyNewDecFromInt(math.NewIntFromUint64(gas)))
+
+	switch mode {
+	case RoundingModeTruncate:
+		return sdk.NewCoin(gasPrice.Denom, amount.TruncateInt())
+	case RoundingModeHalfEven:
+		return sdk.NewCoin(gasPrice.Denom, amount.RoundInt())
+	default:
+		return sdk.NewCoin(gasPrice.Denom, amount.Ceil().TruncateInt())
+	}
+}
+
+// FeeRangeResult contains the fee required at each point of a gas estimate
+// distribution. Wallets can use this to display a range of expected fees rather
+// than a single point estimate.
+type FeeRangeResult struct {
+	// Min is the fee required for the minimum gas estimate.
+	Min sdk.Coin
+	// Likely is the fee required for the most likely gas estimate.
+	Likely sdk.Coin
+	// Max is the fee required for the maximum gas estimate.
+	Max sdk.Coin
+}
+
+// DefaultDenomPrecision is the precision, in decimal places, used for denoms without
+// registered metadata. This is the full precision of a LegacyDec.
+const DefaultDenomPrecision uint32 = math.LegacyPrecision
+
+// RoundUpToPrecision rounds the gas price up to the given number of decimal places, so that
+// paying the rounded price always meets the unrounded price. Precisions at or above
+// DefaultDenomPrecision leave the price unchanged.
+func RoundUpToPrecision(price sdk.DecCoin, precision uint32) sdk.DecCoin {
+	if precision >= DefaultDenomPrecision {
+		return price
+	}
+
+	scale := math.NewIntWithDecimal(1, int(precision))
+	amount := price.Amount.MulInt(scale).Ceil().QuoInt(scale)
+	return sdk.NewDecCoinFromDec(price.Denom, amount)
+}
+
+// FormatGasPrice formats the gas price as a cosmos-sdk gas-price string (e.g.
+// 0.025uatom) with trailing zeros trimmed. The result can be parsed by
+// sdk.ParseDecCoins.
+func FormatGasPrice(price sdk.DecCoin) string {
+	amount := price.Amount.String()
+	if strings.Contains(amount, ".") {
+		amount = strings.TrimRight(amount, "0")
+		amount = strings.TrimSuffix(amount, ".")
+	}
+
+	return amount + price.Denom
+}
@@ -0,0 +1,87 @@
+package types_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestFormatGasPrice(t *testing.T) {
+	testCases := []struct {
+		name     string
+		price    sdk.DecCoin
+		expected string
+	}{
+		{
+			name:     "fractional price",
+			price:    sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("0.025")),
+			expected: "0.025uatom",
+		},
+		{
+			name:     "integer price",
+			price:    sdk.NewDecCoinFromDec("uatom", math.LegacyNewDec(10)),
+			expected: "10uatom",
+		},
+		{
+			name:     "zero price",
+			price:    sdk.NewDecCoinFromDec("uatom", math.LegacyZeroDec()),
+			expected: "0uatom",
+		},
+		{
+			name:     "full precision price",
+			price:    sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr("0.000000000000000001")),
+			expected: "0.000000000000000001stake",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			formatted := types.FormatGasPrice(tc.price)
+			require.Equal(t, tc.expected, formatted)
+
+			parsed, err := sdk.ParseDecCoins(formatted)
+			require.NoError(t, err)
+			require.True(t, parsed.AmountOf(tc.price.Denom).Equal(tc.price.Amount))
+		})
+	}
+}
+
+func TestComputeFee(t *testing.T) {
+	price := func(amount string) sdk.DecCoin {
+		return sdk.NewDecCoinFromDec("stake", math.LegacyMustNewDecFromStr(amount))
+	}
+
+	testCases := []struct {
+		name     string
+		price    sdk.DecCoin
+		gas      uint64
+		mode     types.RoundingMode
+		expected int64
+	}{
+		{name: "integral fee is unchanged by ceil", price: price("1.5"), gas: 100, mode: types.RoundingModeCeil, expected: 150},
+		{name: "integral fee is unchanged by truncate", price: price("1.5"), gas: 100, mode: types.RoundingModeTruncate, expected: 150},
+		{name: "integral fee is unchanged by half even", price: price("1.5"), gas: 100, mode: types.RoundingModeHalfEven, expected: 150},
+		{name: "ceil rounds a fraction up", price: price("1.5"), gas: 151, mode: types.RoundingModeCeil, expected: 227},
+		{name: "truncate rounds a fraction down", price: price("1.5"), gas: 151, mode: types.RoundingModeTruncate, expected: 226},
+		{name: "half even rounds a half to even", price: price("1.5"), gas: 151, mode: types.RoundingModeHalfEven, expected: 226},
+		{name: "half even rounds a half to even upwards", price: price("1.5"), gas: 153, mode: types.RoundingModeHalfEven, expected: 230},
+		{name: "ceil rounds the smallest fraction up", price: price("1.000000000000000001"), gas: 1, mode: types.RoundingModeCeil, expected: 2},
+		{name: "truncate drops the smallest fraction", price: price("1.000000000000000001"), gas: 1, mode: types.RoundingModeTruncate, expected: 1},
+		{name: "half even drops the smallest fraction", price: price("1.000000000000000001"), gas: 1, mode: types.RoundingModeHalfEven, expected: 1},
+		{name: "ceil rounds just below an integer up", price: price("0.999999999999999999"), gas: 1, mode: types.RoundingModeCeil, expected: 1},
+		{name: "truncate rounds just below an integer down", price: price("0.999999999999999999"), gas: 1, mode: types.RoundingModeTruncate, expected: 0},
+		{name: "zero gas is free", price: price("0.025"), gas: 0, mode: types.RoundingModeCeil, expected: 0},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fee := types.ComputeFee(tc.price, tc.gas, tc.mode)
+			require.Equal(t, "stake", fee.Denom)
+			require.Equal(t, tc.expected, fee.Amount.Int64())
+		})
+	}
+}
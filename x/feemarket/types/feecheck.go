@@ -0,0 +1,172 @@
+package types
+
+import (
+	"math"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// FeeCheck is the outcome of the keeper's CheckFee for a tx that the fee market charges.
+type FeeCheck struct {
+	// PayCoin is the fee provided by the tx, or a zero coin in the fee denom when
+	// simulating.
+	PayCoin sdk.Coin
+	// Gas is the gas limit of the tx.
+	Gas uint64
+	// MinGasPrice is the gas price the fee was checked against, in the denom of the fee.
+	MinGasPrice sdk.DecCoin
+	// Priority is the mempool priority of the tx.
+	Priority int64
+}
+
+// CheckBlockGasLimit returns an error if the given gas limit exceeds the gas a single block
+// can hold, i.e. the lower of the max block utilization and the consensus block gas limit.
+// Such a transaction can never be included in a block.
+func CheckBlockGasLimit(ctx sdk.Context, params Params, gas uint64) error {
+	limit := params.MaxBlockUtilization
+	if block := ctx.ConsensusParams().Block; block != nil && block.MaxGas > 0 && uint64(block.MaxGas) < limit {
+		limit = uint64(block.MaxGas)
+	}
+
+	if gas > limit {
+		return errorsmod.Wrapf(
+			ErrGasExceedsBlockLimit,
+			"tx gas limit %d exceeds block gas limit %d and can never be included in a block", gas, limit,
+		)
+	}
+
+	return nil
+}
+
+// CheckRequiredFeeNonZero returns an error if the fee required for the given gas limit at the
+// given gas price is zero. The fee market checks this while it is enabled with a positive
+// MinBaseGasPrice, where a zero required fee can only result from a bug, e.g. a denom resolver
+// that converts the price to zero, and would otherwise open a window in which transactions
+// can be spammed for free. A debug message is logged when the guard is triggered, since it
+// may be triggered by every tx checked.
+func CheckRequiredFeeNonZero(ctx sdk.Context, gasPrice sdk.DecCoin, gas uint64) error {
+	if gas == 0 {
+		return nil
+	}
+
+	if !ComputeFee(gasPrice, gas, FeeRoundingMode).IsZero() {
+		return nil
+	}
+
+	ctx.Logger().Debug(
+		"rejected tx with a zero required fee while the fee market is enabled",
+		"gas_price", gasPrice,
+		"gas_limit", gas,
+	)
+
+	return errorsmod.Wrapf(ErrZeroRequiredFee, "gas price %s, gas limit %d", gasPrice, gas)
+}
+
+// EmitRequiredFee emits an event reporting the fee required for the given gas limit at the
+// given gas price. It is emitted when simulating a tx, which skips the fee sufficiency check,
+// so that the required fee is returned in the simulation result's events. Clients that
+// simulate with a zero gas limit can multiply the gas price by the estimated gas instead.
+func EmitRequiredFee(ctx sdk.Context, gasPrice sdk.DecCoin, gas uint64) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		EventTypeRequiredFee,
+		sdk.NewAttribute(AttributeKeyGasPrice, gasPrice.String()),
+		sdk.NewAttribute(AttributeKeyGasLimit, strconv.FormatUint(gas, 10)),
+		sdk.NewAttribute(
+			AttributeKeyRequiredFee,
+			ComputeFee(gasPrice, gas, FeeRoundingMode).String(),
+		),
+	))
+}
+
+// FeeDeductionAddress returns the address fees are deducted from: the fee granter if one
+// is set, otherwise the fee payer.
+func FeeDeductionAddress(feeTx sdk.FeeTx) sdk.AccAddress {
+	if granter := feeTx.FeeGranter(); granter != nil {
+		return granter
+	}
+
+	return feeTx.FeePayer()
+}
+
+// CheckTxFee implements the logic for the fee market to check if a Tx has provided sufficient
+// fees given the current state of the fee market. Returns an error if insufficient fees.
+func CheckTxFee(ctx sdk.Context, gasPrice sdk.DecCoin, feeCoin sdk.Coin, feeGas int64, isAnte bool) (payCoin sdk.Coin, tip sdk.Coin, err error) {
+	payCoin = feeCoin
+
+	// Ensure that the provided fees meet the minimum
+	if !gasPrice.IsZero() {
+		var (
+			requiredFee sdk.Coin
+			consumedFee sdk.Coin
+		)
+
+		// Determine the required fees by multiplying each required minimum gas
+		// price by the gas, rounded with the same mode used by fee estimation.
+		gasConsumed := int64(ctx.GasMeter().GasConsumed())
+
+		consumedFee = ComputeFee(gasPrice, uint64(gasConsumed), FeeRoundingMode)
+		requiredFee = ComputeFee(gasPrice, uint64(feeGas), FeeRoundingMode)
+
+		if !payCoin.IsGTE(requiredFee) {
+			return sdk.Coin{}, sdk.Coin{}, sdkerrors.ErrInsufficientFee.Wrapf(
+				"got: %s required: %s, minGasPrice: %s, gas: %d",
+				payCoin,
+				requiredFee,
+				gasPrice,
+				gasConsumed,
+			)
+		}
+
+		if isAnte {
+			tip = payCoin.Sub(requiredFee)
+			payCoin = requiredFee
+		} else {
+			tip = payCoin.Sub(consumedFee)
+			payCoin = consumedFee
+		}
+	}
+
+	return payCoin, tip, nil
+}
+
+const (
+	// gasPricePrecision is the amount of digit precision to scale the gas prices to.
+	gasPricePrecision = 6
+)
+
+// GetTxPriority returns a naive tx priority based on the amount of gas price provided in a transaction.
+//
+// The fee amount is divided by the gasLimit to calculate "Effective Gas Price".
+// This value is then normalized and scaled into an integer, so it can be used as a priority.
+//
+//	effectiveGasPrice = feeAmount / gas limit (denominated in fee per gas)
+//	normalizedGasPrice = effectiveGasPrice / currentGasPrice (floor is 1.  The minimum effective gas price can ever be is current gas price)
+//	scaledGasPrice = normalizedGasPrice * 10 ^ gasPricePrecision (amount of decimal places in the normalized gas price to consider when converting to int64).
+func GetTxPriority(fee sdk.Coin, gasLimit int64, currentGasPrice sdk.DecCoin) int64 {
+	// protections from dividing by 0
+	if gasLimit == 0 {
+		return 0
+	}
+
+	// if the gas price is 0, just use a raw amount
+	if currentGasPrice.IsZero() {
+		return fee.Amount.Int64()
+	}
+
+	effectiveGasPrice := fee.Amount.ToLegacyDec().QuoInt64(gasLimit)
+	normalizedGasPrice := effectiveGasPrice.Quo(currentGasPrice.Amount)
+	scaledGasPrice := normalizedGasPrice.MulInt64(int64(math.Pow10(gasPricePrecision)))
+
+	// overflow panic protection
+	if scaledGasPrice.GTE(sdkmath.LegacyNewDec(math.MaxInt64)) {
+		return math.MaxInt64
+	} else if scaledGasPrice.LTE(sdkmath.LegacyOneDec()) {
+		return 0
+	}
+
+	return scaledGasPrice.TruncateInt64()
+}
@@ -0,0 +1,74 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"sort"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// AlgorithmVersion identifies the fee market pricing algorithm compiled into the
+// binary. It must be bumped whenever the learning rate or base gas price update
+// rules change so that nodes running different algorithms produce different
+// configuration fingerprints. The algorithm selected by the params, e.g. the Mode, is
+// covered by the params themselves; v2 added the EMA mode.
+const AlgorithmVersion = "aimd-eip1559/v2"
+
+// ConfigFlags are the keeper options that change how fees are priced or charged. They
+// are set while wiring the app rather than stored in the params, so nodes only agree on
+// them if they are built alike.
+type ConfigFlags struct {
+	CorruptStatePolicy CorruptStatePolicy
+	FirstBlockAtFloor  bool
+	ResolverCache      bool
+
+	// FeeExemptModuleAccounts are the addresses of the fee exempt module accounts, in any
+	// order.
+	FeeExemptModuleAccounts []sdk.AccAddress
+}
+
+// ComputeConfigFingerprint returns a SHA-256 hash over the algorithm version, the
+// params and the keeper's config flags. Each input is length-prefixed so that
+// distinct inputs cannot produce the same preimage.
+func ComputeConfigFingerprint(algorithmVersion string, params Params, flags ConfigFlags) ([]byte, error) {
+	bz, err := params.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	parts := [][]byte{
+		[]byte(algorithmVersion),
+		bz,
+		{
+			byte(flags.CorruptStatePolicy),
+			boolByte(flags.FirstBlockAtFloor),
+			boolByte(flags.ResolverCache),
+		},
+	}
+
+	exempt := make([][]byte, len(flags.FeeExemptModuleAccounts))
+	for i, addr := range flags.FeeExemptModuleAccounts {
+		exempt[i] = addr
+	}
+	sort.Slice(exempt, func(i, j int) bool { return bytes.Compare(exempt[i], exempt[j]) < 0 })
+	parts = append(parts, binary.BigEndian.AppendUint64(nil, uint64(len(exempt))))
+	parts = append(parts, exempt...)
+
+	h := sha256.New()
+	for _, part := range parts {
+		h.Write(binary.BigEndian.AppendUint64(nil, uint64(len(part))))
+		h.Write(part)
+	}
+
+	return h.Sum(nil), nil
+}
+
+func boolByte(b bool) byte {
+	if b {
+		return 1
+	}
+
+	return 0
+}
@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestComputeConfigFingerprint(t *testing.T) {
+	params := types.DefaultParams()
+
+	base, err := types.ComputeConfigFingerprint(types.AlgorithmVersion, params, types.ConfigFlags{})
+	require.NoError(t, err)
+	require.Len(t, base, 32)
+
+	t.Run("is deterministic", func(t *testing.T) {
+		fingerprint, err := types.ComputeConfigFingerprint(types.AlgorithmVersion, params, types.ConfigFlags{})
+		require.NoError(t, err)
+		require.Equal(t, base, fingerprint)
+	})
+
+	t.Run("changes with the algorithm version", func(t *testing.T) {
+		fingerprint, err := types.ComputeConfigFingerprint("aimd-eip1559/v1", params, types.ConfigFlags{})
+		require.NoError(t, err)
+		require.NotEqual(t, base, fingerprint)
+	})
+
+	t.Run("changes with the params", func(t *testing.T) {
+		changed := types.DefaultParams()
+		changed.Window++
+
+		fingerprint, err := types.ComputeConfigFingerprint(types.AlgorithmVersion, changed, types.ConfigFlags{})
+		require.NoError(t, err)
+		require.NotEqual(t, base, fingerprint)
+	})
+
+	t.Run("changes with the mode", func(t *testing.T) {
+		changed := types.DefaultParams()
+		changed.Mode = types.Mode_MODE_EMA
+
+		fingerprint, err := types.ComputeConfigFingerprint(types.AlgorithmVersion, changed, types.ConfigFlags{})
+		require.NoError(t, err)
+		require.NotEqual(t, base, fingerprint)
+	})
+
+	t.Run("changes with each config flag", func(t *testing.T) {
+		for name, flags := range map[string]types.ConfigFlags{
+			"corrupt state policy":      {CorruptStatePolicy: types.CorruptStatePolicyReset},
+			"first block at floor":      {FirstBlockAtFloor: true},
+			"resolver cache":            {ResolverCache: true},
+			"fee exempt module account": {FeeExemptModuleAccounts: []sdk.AccAddress{sdk.AccAddress("exempt")}},
+		} {
+			fingerprint, err := types.ComputeConfigFingerprint(types.AlgorithmVersion, params, flags)
+			require.NoError(t, err, name)
+			require.NotEqual(t, base, fingerprint, name)
+		}
+	})
+
+	t.Run("does not depend on the order of the fee exempt module accounts", func(t *testing.T) {
+		a, b := sdk.AccAddress("a"), sdk.AccAddress("b")
+
+		ab, err := types.ComputeConfigFingerprint(types.AlgorithmVersion, params, types.ConfigFlags{FeeExemptModuleAccounts: []sdk.AccAddress{a, b}})
+		require.NoError(t, err)
+		ba, err := types.ComputeConfigFingerprint(types.AlgorithmVersion, params, types.ConfigFlags{FeeExemptModuleAccounts: []sdk.AccAddress{b, a}})
+		require.NoError(t, err)
+		require.Equal(t, ab, ba)
+	})
+}
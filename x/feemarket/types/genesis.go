@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 )
@@ -12,18 +13,92 @@ func NewGenesisState(
 	state State,
 ) *GenesisState {
 	return &GenesisState{
-		Params: params,
-		State:  state,
+		Params:        params,
+		State:         state,
+		EnabledHeight: -1,
 	}
 }
 
 // ValidateBasic performs basic validation of the genesis state data returning an
-// error for any failed validation criteria.
+// error for any failed validation criteria. Beyond validating the params and state on
+// their own, the window must hold exactly params.Window blocks, the base gas price
+// cannot be below the minimum base gas price, the denom min gas prices must be
+// sorted, positive and unique, each message type can have at most one multiplier, the
+// accumulated fees must be valid coins and the community pool contributions must be valid
+// coins tracked since a non-negative height.
 func (gs *GenesisState) ValidateBasic() error {
 	if err := gs.Params.ValidateBasic(); err != nil {
 		return err
 	}
-	return gs.State.ValidateBasic()
+
+	if err := gs.State.ValidateBasic(); err != nil {
+		return err
+	}
+
+	if uint64(len(gs.State.Window)) != gs.Params.Window {
+		return fmt.Errorf(
+			"genesis state and parameters do not match for window; got %d blocks, expected %d",
+			len(gs.State.Window), gs.Params.Window,
+		)
+	}
+
+	if gs.State.BaseGasPrice.LT(gs.Params.MinBaseGasPrice) {
+		return fmt.Errorf(
+			"base gas price %s cannot be below the min base gas price %s",
+			gs.State.BaseGasPrice, gs.Params.MinBaseGasPrice,
+		)
+	}
+
+	if gs.EnabledHeight < -1 {
+		return fmt.Errorf("enabled height must be -1 or non-negative; got %d", gs.EnabledHeight)
+	}
+
+	if gs.FrozenHeight < 0 {
+		return fmt.Errorf("frozen height cannot be negative; got %d", gs.FrozenHeight)
+	}
+
+	if err := gs.DenomMinGasPrices.Validate(); err != nil {
+		return fmt.Errorf("invalid denom min gas prices: %w", err)
+	}
+
+	seen := make(map[string]struct{}, len(gs.MsgTypeMultipliers))
+	for _, m := range gs.MsgTypeMultipliers {
+		if err := ValidateMsgTypeMultiplier(m.MsgTypeUrl, m.Multiplier); err != nil {
+			return err
+		}
+
+		if _, ok := seen[m.MsgTypeUrl]; ok {
+			return fmt.Errorf("duplicate multiplier for message type %s", m.MsgTypeUrl)
+		}
+		seen[m.MsgTypeUrl] = struct{}{}
+	}
+
+	if err := gs.AccumulatedFees.Validate(); err != nil {
+		return fmt.Errorf("invalid accumulated fees: %w", err)
+	}
+
+	if err := gs.CommunityPoolContributions.Amount.Validate(); err != nil {
+		return fmt.Errorf("invalid community pool contributions: %w", err)
+	}
+
+	if gs.CommunityPoolContributions.SinceHeight < 0 {
+		return fmt.Errorf(
+			"community pool contributions since height cannot be negative; got %d",
+			gs.CommunityPoolContributions.SinceHeight,
+		)
+	}
+
+	return nil
+}
+
+// GetEnabledHeightOrUnset returns the enabled height, with zero, the value of genesis
+// files exported before the enabled height was part of the genesis, mapped to -1.
+func (gs *GenesisState) GetEnabledHeightOrUnset() int64 {
+	if gs.EnabledHeight == 0 {
+		return -1
+	}
+
+	return gs.EnabledHeight
 }
 
 // GetGenesisStateFromAppState returns x/feemarket GenesisState given raw application
@@ -7,6 +7,9 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -33,6 +36,26 @@ type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 	// State contains the current state of the AIMD fee market.
 	State State `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// EnabledHeight is the height at which the fee market was enabled by a
+	// MsgParams, or -1 if it was not. Zero is treated as -1, so that genesis files
+	// exported before the field existed import unchanged.
+	EnabledHeight int64 `protobuf:"varint,3,opt,name=enabled_height,json=enabledHeight,proto3" json:"enabled_height,omitempty"`
+	// DenomMinGasPrices are the minimum gas prices enforced per denom on top of
+	// the market-derived price.
+	DenomMinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,4,rep,name=denom_min_gas_prices,json=denomMinGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"denom_min_gas_prices"`
+	// MsgTypeMultipliers are the multipliers applied to the base gas price per
+	// message type.
+	MsgTypeMultipliers []MsgTypeMultiplier `protobuf:"bytes,5,rep,name=msg_type_multipliers,json=msgTypeMultipliers,proto3" json:"msg_type_multipliers"`
+	// AccumulatedFees are the fees held in the fee market's fee collector for
+	// distribution at the end of the current distribution epoch.
+	AccumulatedFees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=accumulated_fees,json=accumulatedFees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"accumulated_fees"`
+	// CommunityPoolContributions are the cumulative contributions to the
+	// community pool from the fees distributed by the fee market.
+	CommunityPoolContributions CommunityPoolContributions `protobuf:"bytes,7,opt,name=community_pool_contributions,json=communityPoolContributions,proto3" json:"community_pool_contributions"`
+	// FrozenHeight is the height at which a MsgParams last disabled the fee
+	// market after it had been enabled, or zero if none did. A disabled fee
+	// market with a frozen height keeps charging its frozen base gas price.
+	FrozenHeight int64 `protobuf:"varint,8,opt,name=frozen_height,json=frozenHeight,proto3" json:"frozen_height,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -82,6 +105,48 @@ func (m *GenesisState) GetState() State {
 	return State{}
 }
 
+func (m *GenesisState) GetEnabledHeight() int64 {
+	if m != nil {
+		return m.EnabledHeight
+	}
+	return 0
+}
+
+func (m *GenesisState) GetDenomMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.DenomMinGasPrices
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMsgTypeMultipliers() []MsgTypeMultiplier {
+	if m != nil {
+		return m.MsgTypeMultipliers
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAccumulatedFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.AccumulatedFees
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCommunityPoolContributions() CommunityPoolContributions {
+	if m != nil {
+		return m.CommunityPoolContributions
+	}
+	return CommunityPoolContributions{}
+}
+
+func (m *GenesisState) GetFrozenHeight() int64 {
+	if m != nil {
+		return m.FrozenHeight
+	}
+	return 0
+}
+
 // State is utilized to track the current state of the fee market. This includes
 // the current base fee, learning rate, and block utilization within the
 // specified AIMD window.
@@ -97,6 +162,10 @@ type State struct {
 	Window []uint64 `protobuf:"varint,3,rep,packed,name=window,proto3" json:"window,omitempty"`
 	// Index is the index of the current block in the block utilization window.
 	Index uint64 `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	// WindowSum is the running sum of the block utilization values in the window.
+	// It is updated incrementally as blocks enter and leave the window so that the
+	// window does not need to be summed every block.
+	WindowSum uint64 `protobuf:"varint,5,opt,name=window_sum,json=windowSum,proto3" json:"window_sum,omitempty"`
 }
 
 func (m *State) Reset()         { *m = State{} }
@@ -146,9 +215,67 @@ func (m *State) GetIndex() uint64 {
 	return 0
 }
 
+func (m *State) GetWindowSum() uint64 {
+	if m != nil {
+		return m.WindowSum
+	}
+	return 0
+}
+
+// MsgTypeMultiplier is the multiplier applied to the base gas price for a
+// message type.
+type MsgTypeMultiplier struct {
+	// MsgTypeUrl is the type URL of the message type.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Multiplier is the non-negative multiplier applied to the base gas price
+	// for the message type.
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+}
+
+func (m *MsgTypeMultiplier) Reset()         { *m = MsgTypeMultiplier{} }
+func (m *MsgTypeMultiplier) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeMultiplier) ProtoMessage()    {}
+func (*MsgTypeMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2180652c84279298, []int{2}
+}
+func (m *MsgTypeMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeMultiplier.Merge(m, src)
+}
+func (m *MsgTypeMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeMultiplier proto.InternalMessageInfo
+
+func (m *MsgTypeMultiplier) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "feemarket.feemarket.v1.GenesisState")
 	proto.RegisterType((*State)(nil), "feemarket.feemarket.v1.State")
+	proto.RegisterType((*MsgTypeMultiplier)(nil), "feemarket.feemarket.v1.MsgTypeMultiplier")
 }
 
 func init() {
@@ -156,30 +283,51 @@ func init() {
 }
 
 var fileDescriptor_2180652c84279298 = []byte{
-	// 364 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x51, 0x4d, 0x4b, 0x3a, 0x41,
-	0x18, 0xdf, 0xf9, 0xbb, 0x0a, 0xff, 0xc9, 0x3a, 0x2c, 0x22, 0x9b, 0xd1, 0x2a, 0xd6, 0xc1, 0x8b,
-	0xbb, 0x58, 0xa7, 0xa0, 0x93, 0x08, 0x12, 0x74, 0x90, 0x0d, 0x0a, 0xba, 0xc8, 0xb8, 0x3e, 0xad,
-	0x83, 0xed, 0xce, 0xb2, 0x33, 0xf9, 0xf2, 0x09, 0xba, 0xf6, 0x61, 0xfa, 0x10, 0x1e, 0xa5, 0x53,
-	0x74, 0x90, 0x50, 0xe8, 0x73, 0xc4, 0xec, 0xac, 0xe9, 0x21, 0x2f, 0xdd, 0x7e, 0x0f, 0xcf, 0xef,
-	0x6d, 0xe6, 0xc1, 0xa7, 0x0f, 0x00, 0x01, 0x89, 0x87, 0x20, 0x9c, 0x0d, 0x1a, 0x35, 0x1c, 0x1f,
-	0x42, 0xe0, 0x94, 0xdb, 0x51, 0xcc, 0x04, 0x33, 0x8a, 0x3f, 0x3b, 0x7b, 0x83, 0x46, 0x8d, 0x52,
-	0xc1, 0x67, 0x3e, 0x4b, 0x28, 0x8e, 0x44, 0x8a, 0x5d, 0x3a, 0xf4, 0x18, 0x0f, 0x18, 0xef, 0xaa,
-	0x85, 0x1a, 0xd2, 0xd5, 0xc9, 0x8e, 0xb8, 0x88, 0xc4, 0x24, 0x48, 0x49, 0xd5, 0x67, 0x84, 0xf3,
-	0x6d, 0x95, 0x7f, 0x23, 0x88, 0x00, 0xe3, 0x12, 0xe7, 0x14, 0xc1, 0x44, 0x15, 0x54, 0xdb, 0x3b,
-	0xb3, 0xec, 0xdf, 0xfb, 0xd8, 0x9d, 0x84, 0xd5, 0xd4, 0x67, 0x8b, 0xb2, 0xe6, 0xa6, 0x1a, 0xe3,
-	0x02, 0x67, 0xb9, 0xb4, 0x31, 0xff, 0x25, 0xe2, 0xe3, 0x5d, 0xe2, 0x24, 0x2b, 0xd5, 0x2a, 0x45,
-	0xf5, 0x0b, 0xe1, 0xac, 0xaa, 0x70, 0x87, 0x0f, 0x7a, 0x84, 0x43, 0xd7, 0x27, 0xf2, 0x5d, 0xd4,
-	0x83, 0xa4, 0xca, 0xff, 0x66, 0x43, 0xd2, 0x3f, 0x16, 0xe5, 0x23, 0xf5, 0x4c, 0xde, 0x1f, 0xda,
-	0x94, 0x39, 0x01, 0x11, 0x03, 0xfb, 0x1a, 0x7c, 0xe2, 0x4d, 0x5b, 0xe0, 0xbd, 0xbd, 0xd6, 0x71,
-	0xfa, 0x0b, 0x2d, 0xf0, 0xdc, 0xbc, 0x34, 0x6a, 0x13, 0xde, 0x91, 0x36, 0xc6, 0x2d, 0xde, 0x7f,
-	0x04, 0x12, 0x87, 0x34, 0xf4, 0xbb, 0xf1, 0xba, 0xe5, 0xdf, 0x7c, 0xd7, 0x3e, 0xae, 0x2c, 0x5c,
-	0xc4, 0xb9, 0x31, 0x0d, 0xfb, 0x6c, 0x6c, 0x66, 0x2a, 0x99, 0x9a, 0xee, 0xa6, 0x93, 0x51, 0xc0,
-	0x59, 0x1a, 0xf6, 0x61, 0x62, 0xea, 0x15, 0x54, 0xd3, 0x5d, 0x35, 0x34, 0xaf, 0x66, 0x4b, 0x0b,
-	0xcd, 0x97, 0x16, 0xfa, 0x5c, 0x5a, 0xe8, 0x65, 0x65, 0x69, 0xf3, 0x95, 0xa5, 0xbd, 0xaf, 0x2c,
-	0xed, 0xde, 0xf1, 0xa9, 0x18, 0x3c, 0xf5, 0x6c, 0x8f, 0x05, 0x0e, 0x1f, 0xd2, 0xa8, 0x1e, 0xc0,
-	0x68, 0xeb, 0x76, 0x93, 0x2d, 0x2c, 0xa6, 0x11, 0xf0, 0x5e, 0x2e, 0x39, 0xe2, 0xf9, 0x77, 0x00,
-	0x00, 0x00, 0xff, 0xff, 0xd7, 0xb3, 0xeb, 0x34, 0x5a, 0x02, 0x00, 0x00,
+	// 699 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcf, 0x4f, 0x13, 0x4f,
+	0x14, 0xef, 0xd2, 0x1f, 0xdf, 0x2f, 0x43, 0x41, 0xd9, 0x34, 0x64, 0x41, 0x58, 0x1a, 0xd0, 0xa4,
+	0x6a, 0xd8, 0x4d, 0x31, 0x26, 0x9a, 0x78, 0x2a, 0x44, 0x34, 0x91, 0x04, 0x17, 0x7f, 0x24, 0x5e,
+	0x36, 0xd3, 0xed, 0x63, 0x3b, 0xe9, 0xce, 0x4c, 0xb3, 0xb3, 0x5b, 0x28, 0x9e, 0x8d, 0x1e, 0xfd,
+	0x33, 0x8c, 0x27, 0x0f, 0x5e, 0xfc, 0x0f, 0x38, 0x12, 0x4f, 0xc6, 0x03, 0x1a, 0x38, 0xf8, 0x6f,
+	0x98, 0x9d, 0x99, 0xd2, 0x46, 0x69, 0x62, 0xb8, 0xb4, 0x6f, 0xde, 0x7b, 0x9f, 0xf7, 0xf6, 0xf3,
+	0x99, 0x4f, 0x06, 0x5d, 0xdf, 0x03, 0xa0, 0x38, 0xee, 0x40, 0xe2, 0x0e, 0xa3, 0x5e, 0xdd, 0x0d,
+	0x81, 0x81, 0x20, 0xc2, 0xe9, 0xc6, 0x3c, 0xe1, 0xe6, 0xdc, 0x79, 0xcd, 0x19, 0x46, 0xbd, 0xfa,
+	0x42, 0x25, 0xe4, 0x21, 0x97, 0x2d, 0x6e, 0x16, 0xa9, 0xee, 0x85, 0xf9, 0x80, 0x0b, 0xca, 0x85,
+	0xaf, 0x0a, 0xea, 0xa0, 0x4b, 0xab, 0x63, 0xd6, 0x75, 0x71, 0x8c, 0xe9, 0xa0, 0x69, 0x16, 0x53,
+	0xc2, 0xb8, 0x2b, 0x7f, 0x75, 0xca, 0x56, 0x53, 0xdc, 0x26, 0x16, 0xe0, 0xf6, 0xea, 0x4d, 0x48,
+	0x70, 0xdd, 0x0d, 0x38, 0x61, 0xba, 0x3e, 0x8e, 0x46, 0x0c, 0x3d, 0x60, 0x29, 0xa8, 0xae, 0x95,
+	0x2f, 0x45, 0x54, 0xde, 0x52, 0xc4, 0x76, 0x13, 0x9c, 0x80, 0xf9, 0x00, 0x95, 0xd4, 0x66, 0xcb,
+	0xa8, 0x1a, 0xb5, 0xa9, 0x75, 0xdb, 0xb9, 0x98, 0xa8, 0xb3, 0x23, 0xbb, 0x1a, 0x85, 0xa3, 0x93,
+	0xe5, 0x9c, 0xa7, 0x31, 0xe6, 0x7d, 0x54, 0x14, 0xd9, 0x18, 0x6b, 0x42, 0x82, 0x97, 0xc6, 0x81,
+	0xe5, 0x2e, 0x8d, 0x55, 0x08, 0xf3, 0x06, 0x9a, 0x01, 0x86, 0x9b, 0x11, 0xb4, 0xfc, 0x36, 0x90,
+	0xb0, 0x9d, 0x58, 0xf9, 0xaa, 0x51, 0xcb, 0x7b, 0xd3, 0x3a, 0xfb, 0x48, 0x26, 0xcd, 0xb7, 0x06,
+	0xaa, 0xb4, 0x80, 0x71, 0xea, 0x53, 0xc2, 0xfc, 0x10, 0x67, 0x9a, 0x92, 0x00, 0x84, 0x55, 0xa8,
+	0xe6, 0x6b, 0x53, 0xeb, 0x8b, 0x8e, 0x16, 0x37, 0x93, 0xc5, 0xd1, 0xb2, 0x38, 0x9b, 0x10, 0x6c,
+	0x70, 0xc2, 0x1a, 0xf7, 0xb2, 0x85, 0x1f, 0x7f, 0x2c, 0xdf, 0x0e, 0x49, 0xd2, 0x4e, 0x9b, 0x4e,
+	0xc0, 0xa9, 0xbe, 0x0c, 0xfd, 0xb7, 0x26, 0x5a, 0x1d, 0x37, 0xe9, 0x77, 0x41, 0x0c, 0x30, 0xe2,
+	0xc3, 0xaf, 0x4f, 0xb7, 0x0c, 0x6f, 0x56, 0xee, 0xdc, 0x26, 0x6c, 0x0b, 0x8b, 0x1d, 0xb9, 0xd0,
+	0xc4, 0xa8, 0x42, 0x45, 0xe8, 0x67, 0xdd, 0x3e, 0x4d, 0xa3, 0x84, 0x74, 0x23, 0x02, 0xb1, 0xb0,
+	0x8a, 0xf2, 0x43, 0x6e, 0x8e, 0xa3, 0xbe, 0x2d, 0xc2, 0x67, 0xfd, 0x2e, 0x6c, 0x9f, 0x23, 0xb4,
+	0x0c, 0x26, 0xfd, 0xb3, 0x20, 0xcc, 0xd7, 0xe8, 0x2a, 0x0e, 0x82, 0x94, 0xa6, 0x11, 0x4e, 0xa0,
+	0xe5, 0xef, 0x01, 0x08, 0xab, 0x24, 0xc7, 0xcf, 0x5f, 0xc8, 0x53, 0x92, 0xbc, 0xab, 0x49, 0xd6,
+	0xfe, 0x81, 0xe4, 0x08, 0xc3, 0x2b, 0x23, 0x9b, 0x1e, 0x02, 0x08, 0xf3, 0x10, 0x2d, 0x06, 0x9c,
+	0xd2, 0x94, 0x91, 0xa4, 0xef, 0x77, 0x39, 0x8f, 0xfc, 0x80, 0xb3, 0x24, 0x26, 0xcd, 0x34, 0x21,
+	0x9c, 0x09, 0xeb, 0x3f, 0x79, 0xc5, 0xeb, 0xe3, 0x78, 0x6e, 0x0c, 0xb0, 0x3b, 0x9c, 0x47, 0x1b,
+	0xa3, 0x48, 0x4d, 0x78, 0x21, 0x18, 0xdb, 0x61, 0xae, 0xa2, 0xe9, 0xbd, 0x98, 0x1f, 0x02, 0x1b,
+	0x78, 0xe1, 0x7f, 0xe9, 0x85, 0xb2, 0x4a, 0x2a, 0x2b, 0xac, 0xbc, 0x99, 0x40, 0x45, 0x65, 0xda,
+	0x97, 0x68, 0x26, 0xd3, 0x61, 0x68, 0x07, 0x69, 0xde, 0xc9, 0x46, 0x3d, 0x5b, 0xf4, 0xfd, 0x64,
+	0xf9, 0x9a, 0x22, 0x2e, 0x5a, 0x1d, 0x87, 0x70, 0x97, 0xe2, 0xa4, 0xed, 0x3c, 0x81, 0x10, 0x07,
+	0xfd, 0x4d, 0x08, 0xbe, 0x7e, 0x5e, 0x43, 0x5a, 0xcb, 0x4d, 0x08, 0xbc, 0x72, 0x36, 0x68, 0x70,
+	0xc9, 0xe6, 0x0b, 0x34, 0x1d, 0x01, 0x8e, 0x19, 0x61, 0xa1, 0x1f, 0x0f, 0x7c, 0x7d, 0xb9, 0xb9,
+	0x83, 0x39, 0x5e, 0xf6, 0xc1, 0x73, 0xa8, 0xb4, 0x4f, 0x58, 0x8b, 0xef, 0x5b, 0xf9, 0x6a, 0xbe,
+	0x56, 0xf0, 0xf4, 0xc9, 0xac, 0xa0, 0x22, 0x61, 0x2d, 0x38, 0xb0, 0x0a, 0x55, 0xa3, 0x56, 0xf0,
+	0xd4, 0xc1, 0x5c, 0x42, 0x48, 0xd5, 0x7d, 0x91, 0x52, 0xab, 0x28, 0x4b, 0x93, 0x2a, 0xb3, 0x9b,
+	0xd2, 0x95, 0x77, 0x06, 0x9a, 0xfd, 0xcb, 0x55, 0x66, 0x15, 0x95, 0xcf, 0xed, 0x99, 0xc6, 0x91,
+	0x52, 0xc4, 0x43, 0xda, 0x65, 0xcf, 0xe3, 0xc8, 0x7c, 0x8a, 0xd0, 0xd0, 0xb7, 0x97, 0x67, 0x36,
+	0x32, 0xa4, 0xf1, 0xf8, 0xe8, 0xd4, 0x36, 0x8e, 0x4f, 0x6d, 0xe3, 0xe7, 0xa9, 0x6d, 0xbc, 0x3f,
+	0xb3, 0x73, 0xc7, 0x67, 0x76, 0xee, 0xdb, 0x99, 0x9d, 0x7b, 0xe5, 0x8e, 0xb8, 0x51, 0x74, 0x48,
+	0x77, 0x8d, 0x42, 0x6f, 0xe4, 0x61, 0x3a, 0x18, 0x89, 0xa5, 0x35, 0x9b, 0x25, 0xf9, 0x40, 0xdd,
+	0xf9, 0x3d, 0x00, 0x39, 0x35, 0xcd, 0xe2, 0x8f, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -202,6 +350,68 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.FrozenHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FrozenHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size, err := m.CommunityPoolContributions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.AccumulatedFees) > 0 {
+		for iNdEx := len(m.AccumulatedFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AccumulatedFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.MsgTypeMultipliers) > 0 {
+		for iNdEx := len(m.MsgTypeMultipliers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MsgTypeMultipliers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.DenomMinGasPrices) > 0 {
+		for iNdEx := len(m.DenomMinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.EnabledHeight != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.EnabledHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	{
 		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -245,26 +455,31 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.WindowSum != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.WindowSum))
+		i--
+		dAtA[i] = 0x28
+	}
 	if m.Index != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Index))
 		i--
 		dAtA[i] = 0x20
 	}
 	if len(m.Window) > 0 {
-		dAtA4 := make([]byte, len(m.Window)*10)
-		var j3 int
+		dAtA5 := make([]byte, len(m.Window)*10)
+		var j4 int
 		for _, num := range m.Window {
 			for num >= 1<<7 {
-				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA5[j4] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j3++
+				j4++
 			}
-			dAtA4[j3] = uint8(num)
-			j3++
+			dAtA5[j4] = uint8(num)
+			j4++
 		}
-		i -= j3
-		copy(dAtA[i:], dAtA4[:j3])
-		i = encodeVarintGenesis(dAtA, i, uint64(j3))
+		i -= j4
+		copy(dAtA[i:], dAtA5[:j4])
+		i = encodeVarintGenesis(dAtA, i, uint64(j4))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -291,6 +506,46 @@ func (m *State) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgTypeMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -312,6 +567,32 @@ func (m *GenesisState) Size() (n int) {
 	n += 1 + l + sovGenesis(uint64(l))
 	l = m.State.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.EnabledHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.EnabledHeight))
+	}
+	if len(m.DenomMinGasPrices) > 0 {
+		for _, e := range m.DenomMinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MsgTypeMultipliers) > 0 {
+		for _, e := range m.MsgTypeMultipliers {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.AccumulatedFees) > 0 {
+		for _, e := range m.AccumulatedFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.CommunityPoolContributions.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if m.FrozenHeight != 0 {
+		n += 1 + sovGenesis(uint64(m.FrozenHeight))
+	}
 	return n
 }
 
@@ -335,6 +616,24 @@ func (m *State) Size() (n int) {
 	if m.Index != 0 {
 		n += 1 + sovGenesis(uint64(m.Index))
 	}
+	if m.WindowSum != 0 {
+		n += 1 + sovGenesis(uint64(m.WindowSum))
+	}
+	return n
+}
+
+func (m *MsgTypeMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -439,6 +738,179 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnabledHeight", wireType)
+			}
+			m.EnabledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EnabledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMinGasPrices = append(m.DenomMinGasPrices, types.DecCoin{})
+			if err := m.DenomMinGasPrices[len(m.DenomMinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeMultipliers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeMultipliers = append(m.MsgTypeMultipliers, MsgTypeMultiplier{})
+			if err := m.MsgTypeMultipliers[len(m.MsgTypeMultipliers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccumulatedFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AccumulatedFees = append(m.AccumulatedFees, types.Coin{})
+			if err := m.AccumulatedFees[len(m.AccumulatedFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommunityPoolContributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CommunityPoolContributions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenHeight", wireType)
+			}
+			m.FrozenHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrozenHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -652,6 +1124,141 @@ func (m *State) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSum", wireType)
+			}
+			m.WindowSum = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSum |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTypeMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
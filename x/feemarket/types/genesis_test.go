@@ -3,8 +3,12 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	"github.com/stretchr/testify/require"
 
+	"github.com/skip-mev/feemarket/x/feemarket"
 	"github.com/skip-mev/feemarket/x/feemarket/types"
 )
 
@@ -18,4 +22,107 @@ func TestGenesis(t *testing.T) {
 		gs := types.DefaultAIMDGenesisState()
 		require.NoError(t, gs.ValidateBasic())
 	})
+
+	t.Run("rejects a window that does not match the params", func(t *testing.T) {
+		gs := types.DefaultAIMDGenesisState()
+		gs.State.Window = gs.State.Window[:len(gs.State.Window)-1]
+		require.ErrorContains(t, gs.ValidateBasic(), "window")
+	})
+
+	t.Run("rejects a base gas price below the min base gas price", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.State.BaseGasPrice = gs.Params.MinBaseGasPrice.Quo(math.LegacyNewDec(2))
+		require.ErrorContains(t, gs.ValidateBasic(), "min base gas price")
+	})
+
+	t.Run("accepts a base gas price at the min base gas price", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.State.BaseGasPrice = gs.Params.MinBaseGasPrice
+		require.NoError(t, gs.ValidateBasic())
+	})
+
+	t.Run("accepts a disabled fee market regardless of the learning rate bounds", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.Params.Enabled = false
+		gs.Params.MinLearningRate = gs.Params.MaxLearningRate.Add(math.LegacyOneDec())
+		require.NoError(t, gs.ValidateBasic())
+
+		gs.Params.Enabled = true
+		require.ErrorContains(t, gs.ValidateBasic(), "min learning rate")
+	})
+
+	t.Run("rejects an enabled height below -1", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.EnabledHeight = -2
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("rejects a negative frozen height", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.FrozenHeight = -1
+		require.ErrorContains(t, gs.ValidateBasic(), "frozen height")
+	})
+
+	t.Run("rejects invalid denom min gas prices", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.DenomMinGasPrices = sdk.DecCoins{
+			sdk.NewDecCoinFromDec("uatom", math.LegacyOneDec()),
+			sdk.NewDecCoinFromDec("uatom", math.LegacyOneDec()),
+		}
+		require.Error(t, gs.ValidateBasic())
+
+		gs.DenomMinGasPrices = sdk.DecCoins{sdk.DecCoin{Denom: "uatom", Amount: math.LegacyZeroDec()}}
+		require.Error(t, gs.ValidateBasic())
+	})
+
+	t.Run("rejects invalid or duplicate message type multipliers", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.MsgTypeMultipliers = []types.MsgTypeMultiplier{{MsgTypeUrl: "", Multiplier: math.LegacyOneDec()}}
+		require.Error(t, gs.ValidateBasic())
+
+		gs.MsgTypeMultipliers = []types.MsgTypeMultiplier{{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyNewDec(-1)}}
+		require.Error(t, gs.ValidateBasic())
+
+		gs.MsgTypeMultipliers = []types.MsgTypeMultiplier{
+			{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyNewDec(2)},
+			{MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend", Multiplier: math.LegacyNewDec(3)},
+		}
+		require.ErrorContains(t, gs.ValidateBasic(), "duplicate")
+	})
+
+	t.Run("rejects invalid accumulated fees", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.AccumulatedFees = sdk.Coins{sdk.Coin{Denom: "stake", Amount: math.NewInt(-1)}}
+		require.ErrorContains(t, gs.ValidateBasic(), "accumulated fees")
+	})
+
+	t.Run("rejects invalid community pool contributions", func(t *testing.T) {
+		gs := types.DefaultGenesisState()
+		gs.CommunityPoolContributions.Amount = sdk.DecCoins{sdk.DecCoin{Denom: "stake", Amount: math.LegacyNewDec(-1)}}
+		require.ErrorContains(t, gs.ValidateBasic(), "community pool contributions")
+
+		gs = types.DefaultGenesisState()
+		gs.CommunityPoolContributions.SinceHeight = -1
+		require.ErrorContains(t, gs.ValidateBasic(), "since height")
+	})
+}
+
+func TestGenerateGenesisTemplate(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig()
+
+	for _, profile := range types.ChainProfiles() {
+		t.Run(profile, func(t *testing.T) {
+			gs, err := types.GenerateGenesisTemplate(profile)
+			require.NoError(t, err)
+
+			bz, err := encCfg.Codec.MarshalJSON(gs)
+			require.NoError(t, err)
+			require.NoError(t, feemarket.AppModuleBasic{}.ValidateGenesis(encCfg.Codec, nil, bz))
+		})
+	}
+
+	t.Run("unknown profile", func(t *testing.T) {
+		_, err := types.GenerateGenesisTemplate("unknown")
+		require.Error(t, err)
+	})
 }
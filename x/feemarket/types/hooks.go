@@ -0,0 +1,40 @@
+package types
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeMarketHooks is implemented by modules that react to fee market updates. Hooks are
+// called after the update has been written to the store.
+type FeeMarketHooks interface {
+	// AfterBaseGasPriceUpdated is called when the base gas price changes. oldPrice is zero
+	// for the first state written.
+	AfterBaseGasPriceUpdated(ctx sdk.Context, oldPrice, newPrice math.LegacyDec) error
+}
+
+var _ FeeMarketHooks = MultiFeeMarketHooks{}
+
+// MultiFeeMarketHooks combines multiple fee market hooks. They are called in order, and the
+// first error is returned.
+type MultiFeeMarketHooks []FeeMarketHooks
+
+// NewMultiFeeMarketHooks returns hooks that call each of the given hooks in order.
+func NewMultiFeeMarketHooks(hooks ...FeeMarketHooks) MultiFeeMarketHooks {
+	return hooks
+}
+
+// AfterBaseGasPriceUpdated calls AfterBaseGasPriceUpdated on each of the hooks.
+func (h MultiFeeMarketHooks) AfterBaseGasPriceUpdated(ctx sdk.Context, oldPrice, newPrice math.LegacyDec) error {
+	for _, hook := range h {
+		if hook == nil {
+			continue
+		}
+
+		if err := hook.AfterBaseGasPriceUpdated(ctx, oldPrice, newPrice); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
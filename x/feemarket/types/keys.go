@@ -1,5 +1,11 @@
 package types
 
+import (
+	"encoding/binary"
+
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName is the name of the feemarket module.
 	ModuleName = "feemarket"
@@ -13,7 +19,23 @@ const (
 const (
 	prefixParams = iota + 1
 	prefixState
-	prefixEnableHeight = 3
+	prefixEnableHeight                   = 3
+	prefixRevenue                        = 4
+	prefixAccountFeeSpend                = 5
+	prefixObservation                    = 6
+	prefixAccumulatedFees                = 7
+	prefixResolverRate                   = 8
+	prefixBaseGasPriceHistory            = 9
+	prefixCommunityPoolContributions     = 10
+	prefixBlockCommunityPoolContribution = 11
+	prefixCompactBaseGasPriceHistory     = 12
+	prefixCompactBaseGasPriceHistoryTip  = 13
+	prefixDenomMinGasPrice               = 14
+	prefixDenomResolverName              = 15
+	prefixMsgTypeMultiplier              = 16
+	prefixPendingDistributedFees         = 17
+	prefixAccountFeeSpendByHeight        = 18
+	prefixFrozenHeight                   = 19
 )
 
 var (
@@ -26,9 +48,174 @@ var (
 	// KeyEnabledHeight is the store key for the feemarket module's enabled height.
 	KeyEnabledHeight = []byte{prefixEnableHeight}
 
-	EventTypeFeePay      = "fee_pay"
-	EventTypeTipPay      = "tip_pay"
-	AttributeKeyTip      = "tip"
-	AttributeKeyTipPayer = "tip_payer"
-	AttributeKeyTipPayee = "tip_payee"
+	// KeyFrozenHeight is the store key for the height at which the feemarket module was
+	// disabled after being enabled.
+	KeyFrozenHeight = []byte{prefixFrozenHeight}
+
+	// KeyPrefixRevenue is the store key prefix for the fee revenue collected per
+	// height and primary message type.
+	KeyPrefixRevenue = []byte{prefixRevenue}
+
+	// KeyPrefixAccountFeeSpend is the store key prefix for the fees paid per account
+	// and height.
+	KeyPrefixAccountFeeSpend = []byte{prefixAccountFeeSpend}
+
+	// KeyPrefixAccountFeeSpendByHeight is the store key prefix for the index of the
+	// accounts that paid fees per height, used to prune the account fee spend.
+	KeyPrefixAccountFeeSpendByHeight = []byte{prefixAccountFeeSpendByHeight}
+
+	// KeyPrefixObservation is the store key prefix for the base gas price and
+	// utilization observed per height.
+	KeyPrefixObservation = []byte{prefixObservation}
+
+	// KeyAccumulatedFees is the store key for the fees accumulated for distribution at
+	// the end of the current distribution epoch.
+	KeyAccumulatedFees = []byte{prefixAccumulatedFees}
+
+	// KeyPrefixResolverRate is the store key prefix for the last good rate at which the
+	// denom resolver converted the fee denom into each denom.
+	KeyPrefixResolverRate = []byte{prefixResolverRate}
+
+	// KeyPrefixBaseGasPriceHistory is the store key prefix for the ring buffer of the
+	// base gas prices of the most recent blocks.
+	KeyPrefixBaseGasPriceHistory = []byte{prefixBaseGasPriceHistory}
+
+	// KeyCommunityPoolContributions is the store key for the cumulative contributions to
+	// the community pool from the distributed fees.
+	KeyCommunityPoolContributions = []byte{prefixCommunityPoolContributions}
+
+	// KeyPrefixBlockCommunityPoolContribution is the store key prefix for the contributions
+	// to the community pool from the fees distributed per height.
+	KeyPrefixBlockCommunityPoolContribution = []byte{prefixBlockCommunityPoolContribution}
+
+	// KeyPrefixCompactBaseGasPriceHistory is the store key prefix for the changes of the
+	// base gas price recorded by the compact base gas price history.
+	KeyPrefixCompactBaseGasPriceHistory = []byte{prefixCompactBaseGasPriceHistory}
+
+	// KeyCompactBaseGasPriceHistoryTip is the store key for the most recent base gas price
+	// recorded by the compact base gas price history.
+	KeyCompactBaseGasPriceHistoryTip = []byte{prefixCompactBaseGasPriceHistoryTip}
+
+	// KeyPrefixDenomMinGasPrice is the store key prefix for the minimum gas prices enforced
+	// per denom on top of the market-derived price.
+	KeyPrefixDenomMinGasPrice = []byte{prefixDenomMinGasPrice}
+
+	// KeyDenomResolverName is the store key for the name of the registered denom resolver
+	// selected with MsgSetResolver.
+	KeyDenomResolverName = []byte{prefixDenomResolverName}
+
+	// KeyPrefixMsgTypeMultiplier is the store key prefix for the multipliers applied to the
+	// base gas price per message type.
+	KeyPrefixMsgTypeMultiplier = []byte{prefixMsgTypeMultiplier}
+
+	// KeyPendingDistributedFees is the store key for the fees distributed in the current
+	// block, whose community pool share is recorded at the end of the block.
+	KeyPendingDistributedFees = []byte{prefixPendingDistributedFees}
+
+	EventTypeFeePay              = "fee_pay"
+	EventTypeTipPay              = "tip_pay"
+	EventTypeFeeRefund           = "fee_refund"
+	EventTypeFeeBurn             = "fee_burn"
+	EventTypeFeeDistribution     = "fee_distribution"
+	EventTypeStateReset          = "state_reset"
+	EventTypeResolverRateFlagged = "resolver_rate_flagged"
+	EventTypeRequiredFee         = "required_fee"
+	AttributeKeyTip              = "tip"
+	AttributeKeyTipPayer         = "tip_payer"
+	AttributeKeyTipPayee         = "tip_payee"
+	AttributeKeyRefund           = "refund"
+	AttributeKeyRefundee         = "refundee"
+	AttributeKeyError            = "error"
+	AttributeKeyGasPrice         = "gas_price"
+	AttributeKeyGasLimit         = "gas_limit"
+	AttributeKeyDenom            = "denom"
+	AttributeKeyRate             = "rate"
+	AttributeKeyLastRate         = "last_rate"
+	AttributeKeyRequiredFee      = "required_fee"
 )
+
+// RevenueHeightPrefix returns the store key prefix for the fee revenue collected at
+// the given height.
+func RevenueHeightPrefix(height int64) []byte {
+	return heightPrefix(KeyPrefixRevenue, height)
+}
+
+// RevenueKey returns the store key for the fee revenue collected at the given height
+// from transactions whose primary message is of the given type.
+func RevenueKey(height int64, msgTypeURL string) []byte {
+	return append(RevenueHeightPrefix(height), msgTypeURL...)
+}
+
+// AccountFeeSpendAccountPrefix returns the store key prefix for the fees paid by the given
+// account. The address is length-prefixed so that no account's prefix is a prefix of
+// another's.
+func AccountFeeSpendAccountPrefix(addr []byte) []byte {
+	return append([]byte{prefixAccountFeeSpend}, address.MustLengthPrefix(addr)...)
+}
+
+// AccountFeeSpendKey returns the store key for the fees paid by the given account at the
+// given height.
+func AccountFeeSpendKey(addr []byte, height int64) []byte {
+	return heightPrefix(AccountFeeSpendAccountPrefix(addr), height)
+}
+
+// AccountFeeSpendHeightPrefix returns the store key prefix for the index of the accounts
+// that paid fees at the given height.
+func AccountFeeSpendHeightPrefix(height int64) []byte {
+	return heightPrefix(KeyPrefixAccountFeeSpendByHeight, height)
+}
+
+// AccountFeeSpendIndexKey returns the store key for the index entry of the given account
+// at the given height.
+func AccountFeeSpendIndexKey(height int64, addr []byte) []byte {
+	return append(AccountFeeSpendHeightPrefix(height), addr...)
+}
+
+// ObservationKey returns the store key for the base gas price and utilization observed
+// at the given height.
+func ObservationKey(height int64) []byte {
+	return heightPrefix(KeyPrefixObservation, height)
+}
+
+// BlockCommunityPoolContributionKey returns the store key for the contribution to the
+// community pool from the fees distributed at the given height.
+func BlockCommunityPoolContributionKey(height int64) []byte {
+	return heightPrefix(KeyPrefixBlockCommunityPoolContribution, height)
+}
+
+// CompactBaseGasPriceHistoryKey returns the store key for the change of the base gas price
+// at the end of the block at the given height in the compact base gas price history.
+func CompactBaseGasPriceHistoryKey(height int64) []byte {
+	return heightPrefix(KeyPrefixCompactBaseGasPriceHistory, height)
+}
+
+// ResolverRateKey returns the store key for the last good rate at which the denom resolver
+// converted the fee denom into the given denom.
+func ResolverRateKey(denom string) []byte {
+	return append([]byte{prefixResolverRate}, denom...)
+}
+
+// DenomMinGasPriceKey returns the store key for the minimum gas price of the given denom.
+func DenomMinGasPriceKey(denom string) []byte {
+	return append([]byte{prefixDenomMinGasPrice}, denom...)
+}
+
+// MsgTypeMultiplierKey returns the store key for the base gas price multiplier of the given
+// message type.
+func MsgTypeMultiplierKey(msgTypeURL string) []byte {
+	return append([]byte{prefixMsgTypeMultiplier}, msgTypeURL...)
+}
+
+// BaseGasPriceHistoryKey returns the store key for the given slot of the base gas price
+// history ring buffer.
+func BaseGasPriceHistoryKey(slot uint64) []byte {
+	return binary.BigEndian.AppendUint64([]byte{prefixBaseGasPriceHistory}, slot)
+}
+
+// heightPrefix returns the given prefix followed by the big-endian encoded height, such
+// that keys are ordered by height.
+func heightPrefix(prefix []byte, height int64) []byte {
+	key := make([]byte, 0, len(prefix)+8)
+	key = append(key, prefix...)
+	return binary.BigEndian.AppendUint64(key, uint64(height))
+}
@@ -0,0 +1,26 @@
+package types
+
+import "cosmossdk.io/math"
+
+// DerivedMetrics contains the metrics derived from the fee market params, state and
+// observations at a height.
+type DerivedMetrics struct {
+	// AverageUtilization is the average utilization of the blocks in the window relative to
+	// the max block utilization.
+	AverageUtilization math.LegacyDec
+	// Trend is the mean per-block relative change of the base gas price observed over the
+	// window. It is zero if fewer than two positive prices were observed.
+	Trend math.LegacyDec
+	// CongestionMultiplier is the base gas price as a multiple of the minimum base gas price.
+	// It is zero if the minimum base gas price is zero.
+	CongestionMultiplier math.LegacyDec
+}
+
+// Clone returns a deep copy of the metrics.
+func (m DerivedMetrics) Clone() DerivedMetrics {
+	return DerivedMetrics{
+		AverageUtilization:   m.AverageUtilization.Clone(),
+		Trend:                m.Trend.Clone(),
+		CongestionMultiplier: m.CongestionMultiplier.Clone(),
+	}
+}
@@ -1,10 +1,19 @@
 package types
 
 import (
+	"fmt"
+
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-var _ sdk.Msg = &MsgParams{}
+var (
+	_ sdk.Msg = &MsgParams{}
+	_ sdk.Msg = &MsgResetCommunityPoolContributions{}
+	_ sdk.Msg = &MsgSetResolver{}
+	_ sdk.Msg = &MsgSetMsgTypeMultiplier{}
+	_ sdk.Msg = &MsgSetDenomMinGasPrice{}
+)
 
 // NewMsgParams returns a new message to update the x/feemarket module's parameters.
 func NewMsgParams(authority string, params Params) MsgParams {
@@ -31,3 +40,134 @@ func (m *MsgParams) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgResetCommunityPoolContributions returns a new message to reset the cumulative
+// community pool contributions.
+func NewMsgResetCommunityPoolContributions(authority string) MsgResetCommunityPoolContributions {
+	return MsgResetCommunityPoolContributions{
+		Authority: authority,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgResetCommunityPoolContributions) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address.
+func (m *MsgResetCommunityPoolContributions) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Authority)
+	return err
+}
+
+// NewMsgSetResolver returns a new message to select the denom resolver registered under the
+// given name.
+func NewMsgSetResolver(authority, name string) MsgSetResolver {
+	return MsgSetResolver{
+		Authority: authority,
+		Name:      name,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgSetResolver) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address and the name is not empty. Whether a denom resolver is
+// registered under the name is checked by the keeper, which holds the registered resolvers.
+func (m *MsgSetResolver) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	if m.Name == "" {
+		return fmt.Errorf("denom resolver name cannot be empty")
+	}
+
+	return nil
+}
+
+// NewMsgSetMsgTypeMultiplier returns a new message to set the multiplier applied to the base
+// gas price for the given message type.
+func NewMsgSetMsgTypeMultiplier(authority, msgTypeURL string, multiplier math.LegacyDec) MsgSetMsgTypeMultiplier {
+	return MsgSetMsgTypeMultiplier{
+		Authority:  authority,
+		MsgTypeUrl: msgTypeURL,
+		Multiplier: multiplier,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgSetMsgTypeMultiplier) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address and the multiplier is valid for the message type.
+func (m *MsgSetMsgTypeMultiplier) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	return ValidateMsgTypeMultiplier(m.MsgTypeUrl, m.Multiplier)
+}
+
+// ValidateMsgTypeMultiplier returns an error if the message type URL is empty or the base gas
+// price multiplier is nil or not positive.
+func ValidateMsgTypeMultiplier(msgTypeURL string, multiplier math.LegacyDec) error {
+	if msgTypeURL == "" {
+		return fmt.Errorf("message type url cannot be empty")
+	}
+
+	if multiplier.IsNil() || !multiplier.IsPositive() {
+		return fmt.Errorf("multiplier of %s must be positive", msgTypeURL)
+	}
+
+	return nil
+}
+
+// NewMsgSetDenomMinGasPrice returns a new message to set the minimum gas price enforced for
+// the given denom.
+func NewMsgSetDenomMinGasPrice(authority, denom string, minGasPrice math.LegacyDec) MsgSetDenomMinGasPrice {
+	return MsgSetDenomMinGasPrice{
+		Authority:   authority,
+		Denom:       denom,
+		MinGasPrice: minGasPrice,
+	}
+}
+
+// GetSigners implements GetSigners for the msg.
+func (m *MsgSetDenomMinGasPrice) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+// ValidateBasic determines whether the information in the message is formatted correctly, specifically
+// whether the authority is a valid acc-address and the min gas price is valid for the denom.
+func (m *MsgSetDenomMinGasPrice) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(m.Authority); err != nil {
+		return err
+	}
+
+	return ValidateDenomMinGasPrice(m.Denom, m.MinGasPrice)
+}
+
+// ValidateDenomMinGasPrice returns an error if the denom is invalid or its min gas price is nil
+// or negative.
+func ValidateDenomMinGasPrice(denom string, price math.LegacyDec) error {
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return err
+	}
+
+	if price.IsNil() || price.IsNegative() {
+		return fmt.Errorf("min gas price of %s cannot be nil or negative", denom)
+	}
+
+	return nil
+}
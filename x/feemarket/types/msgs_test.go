@@ -3,6 +3,7 @@ package types_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 
@@ -22,3 +23,98 @@ func TestMsgParams(t *testing.T) {
 		require.NoError(t, err)
 	})
 }
+
+func TestMsgResetCommunityPoolContributions(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgResetCommunityPoolContributions("invalid")
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should accept a message with a valid authority address", func(t *testing.T) {
+		msg := types.NewMsgResetCommunityPoolContributions(sdk.AccAddress("test").String())
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgSetResolver(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgSetResolver("invalid", "types-test-static")
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with an empty resolver name", func(t *testing.T) {
+		msg := types.NewMsgSetResolver(sdk.AccAddress("test").String(), "")
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should accept a message with a resolver name", func(t *testing.T) {
+		msg := types.NewMsgSetResolver(sdk.AccAddress("test").String(), "types-test-static")
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgSetMsgTypeMultiplier(t *testing.T) {
+	const msgTypeURL = "/cosmos.bank.v1beta1.MsgSend"
+
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgSetMsgTypeMultiplier("invalid", msgTypeURL, math.LegacyOneDec())
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with an empty message type url", func(t *testing.T) {
+		msg := types.NewMsgSetMsgTypeMultiplier(sdk.AccAddress("test").String(), "", math.LegacyOneDec())
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with a nil, negative or zero multiplier", func(t *testing.T) {
+		msg := types.NewMsgSetMsgTypeMultiplier(sdk.AccAddress("test").String(), msgTypeURL, math.LegacyNewDec(-1))
+		require.Error(t, msg.ValidateBasic())
+
+		msg = types.NewMsgSetMsgTypeMultiplier(sdk.AccAddress("test").String(), msgTypeURL, math.LegacyDec{})
+		require.Error(t, msg.ValidateBasic())
+
+		msg = types.NewMsgSetMsgTypeMultiplier(sdk.AccAddress("test").String(), msgTypeURL, math.LegacyZeroDec())
+		require.Error(t, msg.ValidateBasic())
+	})
+
+	t.Run("should accept a message with a fractional multiplier", func(t *testing.T) {
+		msg := types.NewMsgSetMsgTypeMultiplier(sdk.AccAddress("test").String(), msgTypeURL, math.LegacyMustNewDecFromStr("0.5"))
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
+
+func TestMsgSetDenomMinGasPrice(t *testing.T) {
+	t.Run("should reject a message with an invalid authority address", func(t *testing.T) {
+		msg := types.NewMsgSetDenomMinGasPrice("invalid", "uatom", math.LegacyOneDec())
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with an invalid denom", func(t *testing.T) {
+		msg := types.NewMsgSetDenomMinGasPrice(sdk.AccAddress("test").String(), "", math.LegacyOneDec())
+		err := msg.ValidateBasic()
+		require.Error(t, err)
+	})
+
+	t.Run("should reject a message with a nil or negative min gas price", func(t *testing.T) {
+		msg := types.NewMsgSetDenomMinGasPrice(sdk.AccAddress("test").String(), "uatom", math.LegacyNewDec(-1))
+		require.Error(t, msg.ValidateBasic())
+
+		msg = types.NewMsgSetDenomMinGasPrice(sdk.AccAddress("test").String(), "uatom", math.LegacyDec{})
+		require.Error(t, msg.ValidateBasic())
+	})
+
+	t.Run("should accept a message with a zero min gas price", func(t *testing.T) {
+		msg := types.NewMsgSetDenomMinGasPrice(sdk.AccAddress("test").String(), "uatom", math.LegacyZeroDec())
+		err := msg.ValidateBasic()
+		require.NoError(t, err)
+	})
+}
@@ -0,0 +1,741 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: feemarket/feemarket/v1/observation.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// BlockObservation is the base gas price in effect during a block and the
+// utilization of that block.
+type BlockObservation struct {
+	// BaseGasPrice is the base gas price that was charged during the block.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// Utilization is the gas consumed by the block.
+	Utilization uint64 `protobuf:"varint,2,opt,name=utilization,proto3" json:"utilization,omitempty"`
+}
+
+func (m *BlockObservation) Reset()         { *m = BlockObservation{} }
+func (m *BlockObservation) String() string { return proto.CompactTextString(m) }
+func (*BlockObservation) ProtoMessage()    {}
+func (*BlockObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1846041ebef71986, []int{0}
+}
+func (m *BlockObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockObservation.Merge(m, src)
+}
+func (m *BlockObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockObservation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockObservation proto.InternalMessageInfo
+
+func (m *BlockObservation) GetUtilization() uint64 {
+	if m != nil {
+		return m.Utilization
+	}
+	return 0
+}
+
+// BaseGasPriceRecord is the base gas price as of the end of a block.
+type BaseGasPriceRecord struct {
+	// Height is the height of the block.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// BaseGasPrice is the base gas price set at the end of the block, i.e. the
+	// base gas price of the next block.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+}
+
+func (m *BaseGasPriceRecord) Reset()         { *m = BaseGasPriceRecord{} }
+func (m *BaseGasPriceRecord) String() string { return proto.CompactTextString(m) }
+func (*BaseGasPriceRecord) ProtoMessage()    {}
+func (*BaseGasPriceRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1846041ebef71986, []int{1}
+}
+func (m *BaseGasPriceRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseGasPriceRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseGasPriceRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseGasPriceRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseGasPriceRecord.Merge(m, src)
+}
+func (m *BaseGasPriceRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseGasPriceRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseGasPriceRecord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseGasPriceRecord proto.InternalMessageInfo
+
+func (m *BaseGasPriceRecord) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+// BaseGasPriceChange is an entry of the compact base gas price history, a
+// change of the base gas price at the end of a block. The height is part of the
+// store key.
+type BaseGasPriceChange struct {
+	// Delta is the change from the base gas price of the previous entry. The
+	// oldest entry holds the absolute base gas price.
+	Delta cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=delta,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"delta"`
+}
+
+func (m *BaseGasPriceChange) Reset()         { *m = BaseGasPriceChange{} }
+func (m *BaseGasPriceChange) String() string { return proto.CompactTextString(m) }
+func (*BaseGasPriceChange) ProtoMessage()    {}
+func (*BaseGasPriceChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1846041ebef71986, []int{2}
+}
+func (m *BaseGasPriceChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseGasPriceChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseGasPriceChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseGasPriceChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseGasPriceChange.Merge(m, src)
+}
+func (m *BaseGasPriceChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseGasPriceChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseGasPriceChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BaseGasPriceChange proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*BlockObservation)(nil), "feemarket.feemarket.v1.BlockObservation")
+	proto.RegisterType((*BaseGasPriceRecord)(nil), "feemarket.feemarket.v1.BaseGasPriceRecord")
+	proto.RegisterType((*BaseGasPriceChange)(nil), "feemarket.feemarket.v1.BaseGasPriceChange")
+}
+
+func init() {
+	proto.RegisterFile("feemarket/feemarket/v1/observation.proto", fileDescriptor_1846041ebef71986)
+}
+
+var fileDescriptor_1846041ebef71986 = []byte{
+	// 325 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x52, 0xcd, 0x4a, 0xf3, 0x40,
+	0x14, 0xcd, 0xf4, 0xfb, 0x2c, 0x38, 0x8a, 0x48, 0x90, 0x52, 0x2b, 0xa4, 0xa5, 0xab, 0x6e, 0x9a,
+	0x50, 0x7c, 0x83, 0x58, 0x28, 0x82, 0xa0, 0x64, 0x23, 0x08, 0x52, 0x26, 0xd3, 0xeb, 0x64, 0x48,
+	0xd3, 0x5b, 0x32, 0xd3, 0x60, 0xdd, 0xbb, 0x14, 0x7c, 0x18, 0x1f, 0xa2, 0xcb, 0xe2, 0x4a, 0x5c,
+	0x14, 0x69, 0x5f, 0x44, 0x92, 0x14, 0x13, 0xc1, 0x95, 0xb8, 0x3b, 0x77, 0xce, 0x3d, 0x3f, 0xcc,
+	0x0c, 0xed, 0xdc, 0x01, 0x44, 0x2c, 0x0e, 0x41, 0x3b, 0x05, 0x4a, 0x7a, 0x0e, 0xfa, 0x0a, 0xe2,
+	0x84, 0x69, 0x89, 0x13, 0x7b, 0x1a, 0xa3, 0x46, 0xb3, 0xf6, 0xc5, 0xdb, 0x05, 0x4a, 0x7a, 0x8d,
+	0x23, 0x81, 0x02, 0xb3, 0x15, 0x27, 0x45, 0xf9, 0x76, 0xe3, 0x98, 0xa3, 0x8a, 0x50, 0x0d, 0x73,
+	0x22, 0x1f, 0x72, 0xaa, 0xfd, 0x44, 0xe8, 0xa1, 0x3b, 0x46, 0x1e, 0x5e, 0x16, 0x19, 0xe6, 0x35,
+	0x3d, 0xf0, 0x99, 0x82, 0xa1, 0x60, 0xa9, 0x46, 0x72, 0xa8, 0x93, 0x16, 0xe9, 0xec, 0xba, 0xbd,
+	0xc5, 0xaa, 0x69, 0xbc, 0xaf, 0x9a, 0x27, 0xb9, 0x85, 0x1a, 0x85, 0xb6, 0x44, 0x27, 0x62, 0x3a,
+	0xb0, 0x2f, 0x40, 0x30, 0x3e, 0xef, 0x03, 0x7f, 0x7d, 0xe9, 0xd2, 0x6d, 0x42, 0x1f, 0xb8, 0xb7,
+	0x9f, 0x1a, 0x0d, 0x98, 0xba, 0x4a, 0x6d, 0xcc, 0x16, 0xdd, 0x9b, 0x69, 0x39, 0x96, 0x0f, 0x59,
+	0x4e, 0xbd, 0xd2, 0x22, 0x9d, 0xff, 0x5e, 0xf9, 0xa8, 0xfd, 0x48, 0xa8, 0xe9, 0x96, 0x24, 0x1e,
+	0x70, 0x8c, 0x47, 0x66, 0x8d, 0x56, 0x03, 0x90, 0x22, 0xd0, 0x59, 0x93, 0x7f, 0xde, 0x76, 0xfa,
+	0xa1, 0x69, 0xe5, 0x4f, 0x9a, 0xb6, 0x6f, 0xbf, 0xd7, 0x38, 0x0b, 0xd8, 0x44, 0x80, 0x39, 0xa0,
+	0x3b, 0x23, 0x18, 0x6b, 0xf6, 0xfb, 0xfb, 0xc8, 0xf5, 0xee, 0xf9, 0x62, 0x6d, 0x91, 0xe5, 0xda,
+	0x22, 0x1f, 0x6b, 0x8b, 0x3c, 0x6f, 0x2c, 0x63, 0xb9, 0xb1, 0x8c, 0xb7, 0x8d, 0x65, 0xdc, 0x38,
+	0x42, 0xea, 0x60, 0xe6, 0xdb, 0x1c, 0x23, 0x47, 0x85, 0x72, 0xda, 0x8d, 0x20, 0x29, 0xfd, 0x86,
+	0xfb, 0x12, 0xd6, 0xf3, 0x29, 0x28, 0xbf, 0x9a, 0x3d, 0xe4, 0xe9, 0xe7, 0x00, 0x76, 0x0d, 0x0c,
+	0x4a, 0x3d, 0x02, 0x00, 0x00,
+}
+
+func (m *BlockObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Utilization != 0 {
+		i = encodeVarintObservation(dAtA, i, uint64(m.Utilization))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintObservation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseGasPriceRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseGasPriceRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseGasPriceRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintObservation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintObservation(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseGasPriceChange) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseGasPriceChange) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseGasPriceChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Delta.Size()
+		i -= size
+		if _, err := m.Delta.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintObservation(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintObservation(dAtA []byte, offset int, v uint64) int {
+	offset -= sovObservation(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *BlockObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovObservation(uint64(l))
+	if m.Utilization != 0 {
+		n += 1 + sovObservation(uint64(m.Utilization))
+	}
+	return n
+}
+
+func (m *BaseGasPriceRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovObservation(uint64(m.Height))
+	}
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovObservation(uint64(l))
+	return n
+}
+
+func (m *BaseGasPriceChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Delta.Size()
+	n += 1 + l + sovObservation(uint64(l))
+	return n
+}
+
+func sovObservation(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozObservation(x uint64) (n int) {
+	return sovObservation(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *BlockObservation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObservation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockObservation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObservation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
+			}
+			m.Utilization = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Utilization |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObservation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseGasPriceRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObservation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseGasPriceRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseGasPriceRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObservation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObservation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BaseGasPriceChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowObservation
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseGasPriceChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseGasPriceChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Delta", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthObservation
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Delta.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipObservation(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthObservation
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipObservation(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowObservation
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowObservation
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthObservation
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupObservation
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthObservation
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthObservation        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowObservation          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupObservation = fmt.Errorf("proto: unexpected end of group")
+)
@@ -6,6 +6,14 @@ import (
 	"cosmossdk.io/math"
 )
 
+// MaxAccountFeeSpendWindow is the maximum number of blocks over which per-account fee
+// spend can be retained.
+const MaxAccountFeeSpendWindow = 100_000
+
+// MaxBaseGasPriceHistorySize is the maximum number of blocks for which the base gas price
+// can be retained.
+const MaxBaseGasPriceHistorySize = 10_000
+
 // NewParams instantiates a new EIP-1559 Params object. This params object is utilized
 // to implement both the base EIP-1559 fee and AIMD EIP-1559 fee market implementations.
 func NewParams(
@@ -33,6 +41,11 @@ func NewParams(
 		Window:              window,
 		FeeDenom:            feeDenom,
 		Enabled:             enabled,
+
+		EffectiveMinLearningRate: math.LegacyZeroDec(),
+		TargetDeadBand:           math.LegacyZeroDec(),
+		MaxRateChangePerBlock:    math.LegacyZeroDec(),
+		BurnFraction:             math.LegacyZeroDec(),
 	}
 }
 
@@ -46,12 +59,22 @@ func (p *Params) ValidateBasic() error {
 		return fmt.Errorf("alpha cannot be nil must be between [0, inf)")
 	}
 
+	switch p.Mode {
+	case Mode_MODE_AIMD:
+	case Mode_MODE_EMA:
+		if !p.Alpha.IsPositive() || p.Alpha.GT(math.LegacyOneDec()) {
+			return fmt.Errorf("alpha must be between (0, 1] in EMA mode")
+		}
+	default:
+		return fmt.Errorf("unknown mode %d", p.Mode)
+	}
+
 	if p.Beta.IsNil() || p.Beta.IsNegative() || p.Beta.GT(math.LegacyOneDec()) {
 		return fmt.Errorf("beta cannot be nil and must be between [0, 1]")
 	}
 
 	if p.Gamma.IsNil() || p.Gamma.IsNegative() || p.Gamma.GT(math.LegacyMustNewDecFromStr("0.5")) {
-		return fmt.Errorf("theta cannot be nil and must be between [0, 0.5]")
+		return fmt.Errorf("gamma cannot be nil and must be between [0, 0.5]")
 	}
 
 	if p.Delta.IsNil() || p.Delta.IsNegative() {
@@ -62,15 +85,78 @@ func (p *Params) ValidateBasic() error {
 		return fmt.Errorf("min base gas price cannot be nil and must be greater than or equal to zero")
 	}
 
-	if p.MaxLearningRate.IsNil() || p.MinLearningRate.IsNegative() {
+	if p.MaxBlockUtilization < 2 {
+		return fmt.Errorf("max block utilization cannot be less than 2")
+	}
+
+	if err := p.validateLearningRateBounds(); err != nil {
+		return err
+	}
+
+	if p.FeeDenom == "" {
+		return fmt.Errorf("fee denom must be set")
+	}
+
+	if p.AccountFeeSpendWindow > MaxAccountFeeSpendWindow {
+		return fmt.Errorf("account fee spend window cannot be greater than %d", MaxAccountFeeSpendWindow)
+	}
+
+	if p.BaseGasPriceHistorySize > MaxBaseGasPriceHistorySize {
+		return fmt.Errorf("base gas price history size cannot be greater than %d", MaxBaseGasPriceHistorySize)
+	}
+
+	if !p.TargetDeadBand.IsNil() && (p.TargetDeadBand.IsNegative() || p.TargetDeadBand.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("target dead band must be between [0, 1]")
+	}
+
+	if !p.MaxRateChangePerBlock.IsNil() && p.MaxRateChangePerBlock.IsNegative() {
+		return fmt.Errorf("max rate change per block cannot be negative")
+	}
+
+	if !p.BurnFraction.IsNil() && (p.BurnFraction.IsNegative() || p.BurnFraction.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("burn fraction must be between [0, 1]")
+	}
+
+	for i, tier := range p.FeeDiscountTiers {
+		if tier.MinSpend.IsNil() || tier.MinSpend.IsNegative() {
+			return fmt.Errorf("fee discount tier %d min spend cannot be nil or negative", i)
+		}
+
+		if tier.Discount.IsNil() || tier.Discount.IsNegative() || tier.Discount.GTE(math.LegacyOneDec()) {
+			return fmt.Errorf("fee discount tier %d discount cannot be nil and must be between [0, 1)", i)
+		}
+
+		if i > 0 && tier.MinSpend.LTE(p.FeeDiscountTiers[i-1].MinSpend) {
+			return fmt.Errorf("fee discount tiers must be sorted by strictly increasing min spend")
+		}
+	}
+
+	return nil
+}
+
+// validateLearningRateBounds validates the learning rate bounds. The bounds must be set, but
+// are only checked to be in order while the fee market is enabled, as a disabled fee market
+// does not adjust the learning rate, e.g. while its controller is frozen for an upgrade.
+// Enabling the fee market again validates them, since the MsgParams that enables it is
+// validated with the fee market enabled.
+func (p *Params) validateLearningRateBounds() error {
+	if p.MinLearningRate.IsNil() {
 		return fmt.Errorf("min learning rate cannot be negative or nil")
 	}
 
-	if p.MaxBlockUtilization < 2 {
-		return fmt.Errorf("max block utilization cannot be less than 2")
+	if p.MaxLearningRate.IsNil() {
+		return fmt.Errorf("max learning rate cannot be negative or nil")
+	}
+
+	if !p.Enabled {
+		return nil
 	}
 
-	if p.MaxLearningRate.IsNil() || p.MaxLearningRate.IsNegative() {
+	if p.MinLearningRate.IsNegative() {
+		return fmt.Errorf("min learning rate cannot be negative or nil")
+	}
+
+	if p.MaxLearningRate.IsNegative() {
 		return fmt.Errorf("max learning rate cannot be negative or nil")
 	}
 
@@ -78,14 +164,84 @@ func (p *Params) ValidateBasic() error {
 		return fmt.Errorf("min learning rate cannot be greater than max learning rate")
 	}
 
-	if p.FeeDenom == "" {
-		return fmt.Errorf("fee denom must be set")
+	if !p.EffectiveMinLearningRate.IsNil() {
+		if p.EffectiveMinLearningRate.IsNegative() {
+			return fmt.Errorf("effective min learning rate cannot be negative")
+		}
+
+		if p.EffectiveMinLearningRate.GT(p.MaxLearningRate) {
+			return fmt.Errorf("effective min learning rate cannot be greater than max learning rate")
+		}
 	}
 
 	return nil
 }
 
+// FeeDiscount returns the discount of the highest fee discount tier whose min spend is met
+// by the given fee spend, or zero if no tier is met.
+func (p *Params) FeeDiscount(spend math.Int) math.LegacyDec {
+	discount := math.LegacyZeroDec()
+	for _, tier := range p.FeeDiscountTiers {
+		if spend.LT(tier.MinSpend) {
+			break
+		}
+
+		discount = tier.Discount
+	}
+
+	return discount
+}
+
+// CongestionMultiplier returns the given base gas price as a multiple of the min base gas
+// price. It is zero if the min base gas price is zero, as the multiplier is undefined then.
+func (p *Params) CongestionMultiplier(baseGasPrice math.LegacyDec) math.LegacyDec {
+	if !p.MinBaseGasPrice.IsPositive() {
+		return math.LegacyZeroDec()
+	}
+
+	return baseGasPrice.Quo(p.MinBaseGasPrice)
+}
+
+// AccumulatesFees returns true if fees are accumulated in the module account over a
+// distribution epoch instead of being distributed in every transaction.
+func (p *Params) AccumulatesFees() bool {
+	return p.DistributeFees && p.DistributionEpochBlocks > 0
+}
+
+// CompactsBaseGasPriceHistory returns true if the base gas price history is enabled and
+// stored as a delta-encoded series of the price changes.
+func (p *Params) CompactsBaseGasPriceHistory() bool {
+	return p.CompactBaseGasPriceHistory && p.BaseGasPriceHistorySize > 0
+}
+
+// IsEMA returns true if the base gas price is updated by an exponential moving average of
+// the window utilization instead of the AIMD algorithm.
+func (p *Params) IsEMA() bool {
+	return p.Mode == Mode_MODE_EMA
+}
+
+// IsDistributionEpochEnd returns true if the block at the given height is the last block of
+// a distribution epoch. Without epochs, every block ends one.
+func (p *Params) IsDistributionEpochEnd(height int64) bool {
+	return p.DistributionEpochBlocks == 0 || height%int64(p.DistributionEpochBlocks) == 0
+}
+
 // TargetBlockUtilization returns 0.5 * MaxBlockUtilization.
 func (p *Params) TargetBlockUtilization() uint64 {
 	return p.MaxBlockUtilization / 2
 }
+
+// WarmupFactor returns the factor by which the learning rate adjustment is scaled
+// given the number of blocks that have elapsed since the fee market was enabled.
+// The factor ramps linearly from 1/WarmupBlocks to 1 and is 1 once the warmup has
+// completed or if the warmup is disabled.
+func (p *Params) WarmupFactor(blocksSinceEnabled uint64) math.LegacyDec {
+	if p.WarmupBlocks == 0 || blocksSinceEnabled >= p.WarmupBlocks {
+		return math.LegacyOneDec()
+	}
+
+	elapsed := math.LegacyNewDecFromInt(math.NewIntFromUint64(blocksSinceEnabled + 1))
+	total := math.LegacyNewDecFromInt(math.NewIntFromUint64(p.WarmupBlocks))
+
+	return elapsed.Quo(total)
+}
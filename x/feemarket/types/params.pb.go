@@ -25,6 +25,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Mode is the algorithm with which the fee market updates the base gas price.
+type Mode int32
+
+const (
+	// MODE_AIMD adjusts the learning rate additively or multiplicatively by the
+	// average utilization of the window.
+	Mode_MODE_AIMD Mode = 0
+	// MODE_EMA smooths the window utilization with an exponential moving average.
+	Mode_MODE_EMA Mode = 1
+)
+
+var Mode_name = map[int32]string{
+	0: "MODE_AIMD",
+	1: "MODE_EMA",
+}
+
+var Mode_value = map[string]int32{
+	"MODE_AIMD": 0,
+	"MODE_EMA":  1,
+}
+
+func (x Mode) String() string {
+	return proto.EnumName(Mode_name, int32(x))
+}
+
+func (Mode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3907de4df2e1c66e, []int{0}
+}
+
 // Params contains the required set of parameters for the EIP1559 fee market
 // plugin implementation.
 type Params struct {
@@ -45,7 +74,7 @@ type Params struct {
 	//
 	// Must be [0, 0.5].
 	Gamma cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=gamma,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"gamma"`
-	// Delta is the amount we additively increase/decrease the base fee when the
+	// Delta is the amount we additively increase/decrease the gas price when the
 	// net block utilization difference in the window is above/below the target
 	// utilization.
 	Delta cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=delta,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"delta"`
@@ -69,6 +98,73 @@ type Params struct {
 	// DistributeFees is a boolean that determines whether the fees are burned or
 	// distributed to all stakers.
 	DistributeFees bool `protobuf:"varint,12,opt,name=distribute_fees,json=distributeFees,proto3" json:"distribute_fees,omitempty"`
+	// WarmupBlocks is the number of blocks after the fee market is enabled
+	// during which the learning rate adjustment is dampened. The dampening
+	// factor ramps linearly from 1/WarmupBlocks to 1 as the window fills. A
+	// value of zero disables the warmup.
+	WarmupBlocks uint64 `protobuf:"varint,13,opt,name=warmup_blocks,json=warmupBlocks,proto3" json:"warmup_blocks,omitempty"`
+	// EffectiveMinLearningRate is the minimum learning rate applied to the base
+	// gas price adjustment when the current block's utilization is extreme (within
+	// Gamma of empty or full), regardless of how low the nominal learning rate has
+	// been clamped. This guarantees the market always reacts to extreme
+	// conditions. A value of zero disables the guarantee.
+	EffectiveMinLearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=effective_min_learning_rate,json=effectiveMinLearningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"effective_min_learning_rate"`
+	// RejectGasAboveBlockLimit is a boolean that determines whether transactions
+	// requesting more gas than a single block can hold (the lower of
+	// MaxBlockUtilization and the consensus block gas limit) are rejected by the
+	// ante handler, since they can never be included in a block.
+	RejectGasAboveBlockLimit bool `protobuf:"varint,15,opt,name=reject_gas_above_block_limit,json=rejectGasAboveBlockLimit,proto3" json:"reject_gas_above_block_limit,omitempty"`
+	// TargetDeadBand is the maximum relative deviation of the current block's
+	// utilization from the target, as a fraction of the target, within which the
+	// base gas price is left unchanged. This reduces price jitter caused by small
+	// deviations from the target. A value of zero disables the dead-band.
+	TargetDeadBand cosmossdk_io_math.LegacyDec `protobuf:"bytes,16,opt,name=target_dead_band,json=targetDeadBand,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"target_dead_band"`
+	// AccountFeeSpendWindow is the number of blocks over which the fees paid by
+	// each account are retained for spending reports. A value of zero disables
+	// per-account fee tracking.
+	AccountFeeSpendWindow uint64 `protobuf:"varint,17,opt,name=account_fee_spend_window,json=accountFeeSpendWindow,proto3" json:"account_fee_spend_window,omitempty"`
+	// DistributionEpochBlocks is the number of blocks per fee distribution epoch.
+	// When DistributeFees is enabled and this is non-zero, fees are accumulated in
+	// the module account and distributed at the end of the last block of each
+	// epoch rather than in every transaction. A value of zero distributes fees in
+	// every transaction.
+	DistributionEpochBlocks uint64 `protobuf:"varint,19,opt,name=distribution_epoch_blocks,json=distributionEpochBlocks,proto3" json:"distribution_epoch_blocks,omitempty"`
+	// FeeDiscountTiers are the loyalty discounts offered to accounts based on the
+	// fees they paid, in the fee denom, over the account fee spend window. An
+	// account receives the discount of the highest tier whose min spend it has
+	// reached. The tiers must be sorted by strictly increasing min spend.
+	FeeDiscountTiers []FeeDiscountTier `protobuf:"bytes,20,rep,name=fee_discount_tiers,json=feeDiscountTiers,proto3" json:"fee_discount_tiers"`
+	// MaxRateChangePerBlock is the maximum relative change, per block, of the rate
+	// at which the denom resolver converts the fee denom into another denom. A
+	// conversion whose rate moved further from the last good rate is flagged and
+	// priced at the last good rate instead. A value of zero disables the guard.
+	MaxRateChangePerBlock cosmossdk_io_math.LegacyDec `protobuf:"bytes,21,opt,name=max_rate_change_per_block,json=maxRateChangePerBlock,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_rate_change_per_block"`
+	// BaseGasPriceHistorySize is the number of most recent blocks for which the
+	// base gas price is retained for the base gas price history query. A value of
+	// zero disables the history.
+	BaseGasPriceHistorySize uint64 `protobuf:"varint,22,opt,name=base_gas_price_history_size,json=baseGasPriceHistorySize,proto3" json:"base_gas_price_history_size,omitempty"`
+	// CompactBaseGasPriceHistory stores the base gas price history as a
+	// delta-encoded series of the price changes instead of one absolute price per
+	// block, which saves space when the price changes less often than every
+	// block, e.g. on archival nodes or at the floor.
+	CompactBaseGasPriceHistory bool `protobuf:"varint,23,opt,name=compact_base_gas_price_history,json=compactBaseGasPriceHistory,proto3" json:"compact_base_gas_price_history,omitempty"`
+	// Mode selects how the base gas price responds to the block utilization. AIMD,
+	// the default, adjusts the learning rate every block and moves the price by
+	// the utilization of the current block. EMA holds the learning rate steady
+	// and moves the price by an exponential moving average of the utilization of
+	// the window, using Alpha as the smoothing factor.
+	Mode Mode `protobuf:"varint,24,opt,name=mode,proto3,enum=feemarket.feemarket.v1.Mode" json:"mode,omitempty"`
+	// BurnFraction is the fraction, in [0, 1], of the base fee of each
+	// transaction that is burned rather than paid to the fee collector. The tip
+	// is never burned. A value of zero sends the entire base fee to the fee
+	// collector.
+	BurnFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,25,opt,name=burn_fraction,json=burnFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"burn_fraction"`
+	// RecordAnalytics determines whether the fee market records the fee revenue
+	// of each transaction by its primary message type and the base gas price and
+	// utilization observed each block, for the RevenueByMsgType query and the
+	// empirical price elasticity. Recording the revenue is charged to the
+	// transaction.
+	RecordAnalytics bool `protobuf:"varint,26,opt,name=record_analytics,json=recordAnalytics,proto3" json:"record_analytics,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -139,8 +235,116 @@ func (m *Params) GetDistributeFees() bool {
 	return false
 }
 
+func (m *Params) GetWarmupBlocks() uint64 {
+	if m != nil {
+		return m.WarmupBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetRejectGasAboveBlockLimit() bool {
+	if m != nil {
+		return m.RejectGasAboveBlockLimit
+	}
+	return false
+}
+
+func (m *Params) GetAccountFeeSpendWindow() uint64 {
+	if m != nil {
+		return m.AccountFeeSpendWindow
+	}
+	return 0
+}
+
+func (m *Params) GetDistributionEpochBlocks() uint64 {
+	if m != nil {
+		return m.DistributionEpochBlocks
+	}
+	return 0
+}
+
+func (m *Params) GetFeeDiscountTiers() []FeeDiscountTier {
+	if m != nil {
+		return m.FeeDiscountTiers
+	}
+	return nil
+}
+
+func (m *Params) GetBaseGasPriceHistorySize() uint64 {
+	if m != nil {
+		return m.BaseGasPriceHistorySize
+	}
+	return 0
+}
+
+func (m *Params) GetCompactBaseGasPriceHistory() bool {
+	if m != nil {
+		return m.CompactBaseGasPriceHistory
+	}
+	return false
+}
+
+func (m *Params) GetMode() Mode {
+	if m != nil {
+		return m.Mode
+	}
+	return Mode_MODE_AIMD
+}
+
+func (m *Params) GetRecordAnalytics() bool {
+	if m != nil {
+		return m.RecordAnalytics
+	}
+	return false
+}
+
+// FeeDiscountTier is a loyalty discount offered to accounts that paid at least
+// a minimum amount of fees over the account fee spend window.
+type FeeDiscountTier struct {
+	// MinSpend is the minimum amount of fees, in the fee denom, an account must
+	// have paid over the account fee spend window to receive the discount.
+	MinSpend cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=min_spend,json=minSpend,proto3,customtype=cosmossdk.io/math.Int" json:"min_spend"`
+	// Discount is the fraction of the fee that is waived, in [0, 1).
+	Discount cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=discount,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"discount"`
+}
+
+func (m *FeeDiscountTier) Reset()         { *m = FeeDiscountTier{} }
+func (m *FeeDiscountTier) String() string { return proto.CompactTextString(m) }
+func (*FeeDiscountTier) ProtoMessage()    {}
+func (*FeeDiscountTier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3907de4df2e1c66e, []int{1}
+}
+func (m *FeeDiscountTier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDiscountTier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDiscountTier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDiscountTier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDiscountTier.Merge(m, src)
+}
+func (m *FeeDiscountTier) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDiscountTier) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDiscountTier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDiscountTier proto.InternalMessageInfo
+
 func init() {
+	proto.RegisterEnum("feemarket.feemarket.v1.Mode", Mode_name, Mode_value)
 	proto.RegisterType((*Params)(nil), "feemarket.feemarket.v1.Params")
+	proto.RegisterType((*FeeDiscountTier)(nil), "feemarket.feemarket.v1.FeeDiscountTier")
 }
 
 func init() {
@@ -148,35 +352,64 @@ func init() {
 }
 
 var fileDescriptor_3907de4df2e1c66e = []byte{
-	// 447 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x93, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x63, 0x48, 0xd2, 0x64, 0x41, 0x54, 0x2c, 0x50, 0x2d, 0xad, 0xe4, 0x46, 0x70, 0x20,
-	0x97, 0xda, 0x0a, 0xbc, 0x41, 0x14, 0xa8, 0x90, 0x7a, 0xa8, 0x2c, 0x71, 0x41, 0x02, 0x6b, 0x6c,
-	0x4f, 0x9c, 0x55, 0xbc, 0xbb, 0x96, 0x77, 0x93, 0xa6, 0x3c, 0x05, 0x0f, 0xc3, 0x43, 0xf4, 0x58,
-	0x71, 0x42, 0x1c, 0x2a, 0x94, 0xbc, 0x02, 0x0f, 0x80, 0x76, 0x1d, 0x48, 0xe1, 0x68, 0x6e, 0xff,
-	0x3f, 0x33, 0xff, 0xb7, 0xa3, 0x95, 0x86, 0x3c, 0x9f, 0x22, 0x0a, 0xa8, 0xe6, 0x68, 0xc2, 0x9d,
-	0x5a, 0x8e, 0xc2, 0x12, 0x2a, 0x10, 0x3a, 0x28, 0x2b, 0x65, 0x14, 0x3d, 0xf8, 0xd3, 0x0a, 0x76,
-	0x6a, 0x39, 0x3a, 0x7c, 0x9a, 0x2a, 0x2d, 0x94, 0x8e, 0xdd, 0x54, 0x58, 0x9b, 0x3a, 0x72, 0xf8,
-	0x38, 0x57, 0xb9, 0xaa, 0xeb, 0x56, 0xd5, 0xd5, 0x67, 0x3f, 0x3b, 0xa4, 0x7b, 0xee, 0xc8, 0xf4,
-	0x94, 0x74, 0xa0, 0x28, 0x67, 0xc0, 0xbc, 0x81, 0x37, 0xec, 0x8f, 0x47, 0x57, 0x37, 0xc7, 0xad,
-	0xef, 0x37, 0xc7, 0x47, 0x35, 0x45, 0x67, 0xf3, 0x80, 0xab, 0x50, 0x80, 0x99, 0x05, 0x67, 0x98,
-	0x43, 0x7a, 0x39, 0xc1, 0xf4, 0xeb, 0x97, 0x13, 0xb2, 0x7d, 0x64, 0x82, 0x69, 0x54, 0xe7, 0xe9,
-	0x6b, 0xd2, 0x4e, 0xd0, 0x00, 0xbb, 0xd3, 0x94, 0xe3, 0xe2, 0x76, 0x9f, 0x1c, 0x84, 0x00, 0x76,
-	0xb7, 0xf1, 0x3e, 0x2e, 0x6f, 0x41, 0x19, 0x16, 0x06, 0x58, 0xbb, 0x31, 0xc8, 0xe5, 0xe9, 0x47,
-	0x42, 0x05, 0x97, 0x71, 0x02, 0x1a, 0xe3, 0x1c, 0xec, 0x2f, 0xf3, 0x14, 0x59, 0xa7, 0x29, 0x75,
-	0x5f, 0x70, 0x39, 0x06, 0x8d, 0xa7, 0xa0, 0xcf, 0x2d, 0x89, 0x7e, 0x20, 0x0f, 0x2d, 0xbf, 0x40,
-	0xa8, 0x24, 0x97, 0x79, 0x5c, 0x81, 0x41, 0xd6, 0xfd, 0x1f, 0xfc, 0xd9, 0x16, 0x15, 0x81, 0xa9,
-	0xf1, 0xb0, 0xfa, 0x07, 0xbf, 0xd7, 0x1c, 0x0f, 0xab, 0xbf, 0xf0, 0x2f, 0xc9, 0x13, 0x8b, 0x4f,
-	0x0a, 0x95, 0xce, 0xe3, 0x85, 0xe1, 0x05, 0xff, 0x04, 0x86, 0x2b, 0xc9, 0x7a, 0x03, 0x6f, 0xd8,
-	0x8e, 0x1e, 0x09, 0x58, 0x8d, 0x6d, 0xef, 0xdd, 0xae, 0x45, 0x0f, 0x48, 0xf7, 0x82, 0xcb, 0x4c,
-	0x5d, 0xb0, 0xbe, 0x1b, 0xda, 0x3a, 0x7a, 0x44, 0xfa, 0x53, 0xc4, 0x38, 0x43, 0xa9, 0x04, 0x23,
-	0x76, 0xc5, 0xa8, 0x37, 0x45, 0x9c, 0x58, 0x4f, 0x19, 0xd9, 0x43, 0x09, 0x49, 0x81, 0x19, 0xbb,
-	0x37, 0xf0, 0x86, 0xbd, 0xe8, 0xb7, 0xa5, 0x2f, 0xc8, 0x7e, 0xc6, 0xb5, 0xa9, 0x78, 0xb2, 0x30,
-	0x18, 0x4f, 0x11, 0x35, 0xbb, 0xef, 0x26, 0x1e, 0xec, 0xca, 0x6f, 0x10, 0xf5, 0xf8, 0xed, 0xd5,
-	0xda, 0xf7, 0xae, 0xd7, 0xbe, 0xf7, 0x63, 0xed, 0x7b, 0x9f, 0x37, 0x7e, 0xeb, 0x7a, 0xe3, 0xb7,
-	0xbe, 0x6d, 0xfc, 0xd6, 0xfb, 0x30, 0xe7, 0x66, 0xb6, 0x48, 0x82, 0x54, 0x89, 0x50, 0xcf, 0x79,
-	0x79, 0x22, 0x70, 0x79, 0xeb, 0x10, 0x57, 0xb7, 0xb4, 0xb9, 0x2c, 0x51, 0x27, 0x5d, 0x77, 0x48,
-	0xaf, 0x7e, 0x05, 0x00, 0x00, 0xff, 0xff, 0x33, 0xa4, 0xbf, 0xc2, 0xb8, 0x03, 0x00, 0x00,
+	// 900 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x96, 0xdd, 0x4e, 0x1b, 0x47,
+	0x14, 0xc7, 0xd9, 0xc6, 0x21, 0xf6, 0x04, 0x63, 0x33, 0x04, 0x32, 0x40, 0xe4, 0x58, 0xe1, 0x22,
+	0x6e, 0xab, 0xd8, 0x85, 0x5e, 0x54, 0xaa, 0xaa, 0x4a, 0xb8, 0x06, 0x42, 0x85, 0x55, 0xe4, 0xf4,
+	0x43, 0x6a, 0xd4, 0x8e, 0xce, 0xee, 0x1e, 0xdb, 0x53, 0x7b, 0x67, 0x56, 0x3b, 0x63, 0xf3, 0xf1,
+	0x14, 0x7d, 0x8e, 0x5e, 0x57, 0xea, 0x2b, 0xe4, 0x32, 0xea, 0x55, 0xd5, 0x8b, 0xa8, 0x82, 0x17,
+	0xa9, 0x66, 0x76, 0x01, 0x03, 0xe9, 0xcd, 0xf6, 0x6e, 0xe6, 0x7c, 0xfc, 0xf6, 0xcc, 0xff, 0x9c,
+	0xdd, 0x59, 0xb2, 0xd9, 0x47, 0x8c, 0x20, 0x19, 0xa1, 0x69, 0x5d, 0xaf, 0xa6, 0x5b, 0xad, 0x18,
+	0x12, 0x88, 0x74, 0x33, 0x4e, 0x94, 0x51, 0x74, 0xf5, 0xca, 0xd5, 0xbc, 0x5e, 0x4d, 0xb7, 0xd6,
+	0xd7, 0x02, 0xa5, 0x23, 0xa5, 0xb9, 0x8b, 0x6a, 0xa5, 0x9b, 0x34, 0x65, 0xfd, 0xd1, 0x40, 0x0d,
+	0x54, 0x6a, 0xb7, 0xab, 0xd4, 0xfa, 0xec, 0x8f, 0x32, 0x99, 0x3f, 0x72, 0x64, 0xba, 0x4f, 0xee,
+	0xc3, 0x38, 0x1e, 0x02, 0xf3, 0xea, 0x5e, 0xa3, 0xd4, 0xde, 0x7a, 0xf3, 0xee, 0xe9, 0xdc, 0xdf,
+	0xef, 0x9e, 0x6e, 0xa4, 0x14, 0x1d, 0x8e, 0x9a, 0x42, 0xb5, 0x22, 0x30, 0xc3, 0xe6, 0x21, 0x0e,
+	0x20, 0x38, 0xed, 0x60, 0xf0, 0xe7, 0xef, 0x2f, 0x48, 0xf6, 0x90, 0x0e, 0x06, 0xbd, 0x34, 0x9f,
+	0xee, 0x92, 0x82, 0x8f, 0x06, 0xd8, 0x07, 0x79, 0x39, 0x2e, 0xdd, 0xd6, 0x33, 0x80, 0x28, 0x02,
+	0x76, 0x2f, 0x77, 0x3d, 0x2e, 0xdf, 0x82, 0x42, 0x1c, 0x1b, 0x60, 0x85, 0xdc, 0x20, 0x97, 0x4f,
+	0x7f, 0x26, 0x34, 0x12, 0x92, 0xfb, 0xa0, 0x91, 0x0f, 0xc0, 0xaa, 0x2c, 0x02, 0x64, 0xf7, 0xf3,
+	0x52, 0x2b, 0x91, 0x90, 0x6d, 0xd0, 0xb8, 0x0f, 0xfa, 0xc8, 0x92, 0xe8, 0x4f, 0x64, 0xc9, 0xf2,
+	0xc7, 0x08, 0x89, 0x14, 0x72, 0xc0, 0x13, 0x30, 0xc8, 0xe6, 0xff, 0x0f, 0xfe, 0x30, 0x43, 0xf5,
+	0xc0, 0xa4, 0x78, 0x38, 0xb9, 0x85, 0x7f, 0x90, 0x1f, 0x0f, 0x27, 0x37, 0xf0, 0xdb, 0x64, 0xc5,
+	0xe2, 0xfd, 0xb1, 0x0a, 0x46, 0x7c, 0x62, 0xc4, 0x58, 0x9c, 0x81, 0x11, 0x4a, 0xb2, 0x62, 0xdd,
+	0x6b, 0x14, 0x7a, 0xcb, 0x11, 0x9c, 0xb4, 0xad, 0xef, 0xbb, 0x6b, 0x17, 0x5d, 0x25, 0xf3, 0xc7,
+	0x42, 0x86, 0xea, 0x98, 0x95, 0x5c, 0x50, 0xb6, 0xa3, 0x1b, 0xa4, 0xd4, 0x47, 0xe4, 0x21, 0x4a,
+	0x15, 0x31, 0x62, 0x4b, 0xec, 0x15, 0xfb, 0x88, 0x1d, 0xbb, 0xa7, 0x8c, 0x3c, 0x40, 0x09, 0xfe,
+	0x18, 0x43, 0xf6, 0xb0, 0xee, 0x35, 0x8a, 0xbd, 0xcb, 0x2d, 0x7d, 0x4e, 0x2a, 0xa1, 0xd0, 0x26,
+	0x11, 0xfe, 0xc4, 0x20, 0xef, 0x23, 0x6a, 0xb6, 0xe0, 0x22, 0x16, 0xaf, 0xcd, 0x7b, 0x88, 0x9a,
+	0x6e, 0x92, 0xf2, 0x31, 0x24, 0xd1, 0x24, 0x4e, 0xcb, 0xd5, 0xac, 0xec, 0x1e, 0xbf, 0x90, 0x1a,
+	0x5d, 0x99, 0x9a, 0xc6, 0x64, 0x03, 0xfb, 0x7d, 0x0c, 0x8c, 0x98, 0x22, 0xbf, 0xdb, 0x98, 0xc5,
+	0xbc, 0xca, 0xb1, 0x2b, 0x6a, 0xf7, 0x56, 0x87, 0xbe, 0x24, 0x4f, 0x12, 0xfc, 0x05, 0x03, 0xe3,
+	0xc6, 0x0b, 0x7c, 0x35, 0xc5, 0x4c, 0xcf, 0xb1, 0x88, 0x84, 0x61, 0x15, 0x77, 0x18, 0x96, 0xc6,
+	0xec, 0x83, 0xde, 0xb1, 0x11, 0xae, 0xda, 0x43, 0xeb, 0xa7, 0xaf, 0x49, 0xd5, 0x40, 0x32, 0x40,
+	0xc3, 0x43, 0x84, 0x90, 0xfb, 0x20, 0x43, 0x56, 0xcd, 0x5b, 0xe6, 0x62, 0x8a, 0xea, 0x20, 0x84,
+	0x6d, 0x90, 0x21, 0xfd, 0x8c, 0x30, 0x08, 0x02, 0x35, 0x91, 0xc6, 0x2a, 0xcb, 0x75, 0x8c, 0x32,
+	0xe4, 0x59, 0xf7, 0x96, 0x9c, 0x7c, 0x2b, 0x99, 0x7f, 0x0f, 0xf1, 0x95, 0xf5, 0xfe, 0x90, 0x36,
+	0xf3, 0x73, 0xb2, 0x76, 0x25, 0xbf, 0x50, 0x92, 0x63, 0xac, 0x82, 0xe1, 0xa5, 0xf0, 0xcb, 0x2e,
+	0xf3, 0xf1, 0x6c, 0xc0, 0xae, 0xf5, 0x67, 0x3d, 0x78, 0x4d, 0xa8, 0x1b, 0x04, 0xa1, 0xd3, 0x27,
+	0x1b, 0x81, 0x89, 0x66, 0x8f, 0xea, 0xf7, 0x1a, 0x0f, 0xb7, 0x9f, 0x37, 0xdf, 0xff, 0x15, 0x6c,
+	0xee, 0x21, 0x76, 0xb2, 0x84, 0x6f, 0x05, 0x26, 0xed, 0x82, 0x3d, 0x7c, 0xaf, 0xda, 0xbf, 0x69,
+	0xd6, 0x74, 0x44, 0xd6, 0xec, 0xc4, 0xda, 0x6e, 0xf2, 0x60, 0x08, 0x72, 0x80, 0x3c, 0xc6, 0x24,
+	0xad, 0x8c, 0xad, 0xe4, 0xd5, 0xcd, 0xbe, 0x05, 0xb6, 0x9b, 0x5f, 0x39, 0xe2, 0x11, 0x26, 0xee,
+	0x28, 0xf4, 0x0b, 0xb2, 0x71, 0xf3, 0xc3, 0xc1, 0x87, 0x42, 0x1b, 0x95, 0x9c, 0x72, 0x2d, 0xce,
+	0x90, 0xad, 0xa6, 0x3a, 0xf8, 0x33, 0xdf, 0x83, 0x97, 0xa9, 0xff, 0x95, 0x38, 0x43, 0xda, 0x26,
+	0xb5, 0x40, 0x45, 0x31, 0x04, 0x86, 0xbf, 0x9f, 0xc2, 0x1e, 0xbb, 0xd9, 0x58, 0xcf, 0xa2, 0xda,
+	0x77, 0x39, 0xf4, 0x13, 0x52, 0x88, 0x54, 0x88, 0x8c, 0xd5, 0xbd, 0xc6, 0xe2, 0xf6, 0x93, 0xff,
+	0x52, 0xaf, 0xab, 0x42, 0xec, 0xb9, 0x48, 0xfa, 0x3d, 0x29, 0xfb, 0x93, 0x44, 0xf2, 0x7e, 0x02,
+	0x81, 0x7b, 0x95, 0xd7, 0xf2, 0x8a, 0xb2, 0x60, 0x39, 0x7b, 0x19, 0x86, 0x7e, 0x48, 0xaa, 0x09,
+	0x06, 0x2a, 0x09, 0x39, 0x48, 0x18, 0x9f, 0x1a, 0x11, 0x68, 0xb6, 0xee, 0xea, 0xaf, 0xa4, 0xf6,
+	0x9d, 0x4b, 0xf3, 0xd7, 0x85, 0x22, 0xad, 0x2e, 0xf7, 0x96, 0x12, 0xec, 0x4f, 0x64, 0xc8, 0x27,
+	0x72, 0xa2, 0x31, 0xb4, 0xa7, 0x7f, 0xf6, 0x9b, 0x47, 0x2a, 0xb7, 0x1a, 0x4d, 0x5f, 0x92, 0x92,
+	0x7d, 0x4f, 0xdd, 0x68, 0x66, 0xd7, 0xd8, 0xc7, 0x59, 0xad, 0x2b, 0x77, 0x6b, 0x3d, 0x90, 0x66,
+	0xa6, 0xca, 0x03, 0x69, 0x7a, 0xc5, 0x48, 0x48, 0x37, 0xb9, 0xb4, 0x4b, 0x8a, 0x97, 0x33, 0x97,
+	0xff, 0x1e, 0xbb, 0x42, 0x7c, 0xb4, 0x49, 0x0a, 0x56, 0x56, 0x5a, 0x26, 0xa5, 0xee, 0x37, 0x9d,
+	0x5d, 0xbe, 0x73, 0xd0, 0xed, 0x54, 0xe7, 0xe8, 0x02, 0x29, 0xba, 0xed, 0x6e, 0x77, 0xa7, 0xea,
+	0xb5, 0x0f, 0xde, 0x9c, 0xd7, 0xbc, 0xb7, 0xe7, 0x35, 0xef, 0x9f, 0xf3, 0x9a, 0xf7, 0xeb, 0x45,
+	0x6d, 0xee, 0xed, 0x45, 0x6d, 0xee, 0xaf, 0x8b, 0xda, 0xdc, 0x8f, 0xad, 0x81, 0x30, 0xc3, 0x89,
+	0xdf, 0x0c, 0x54, 0xd4, 0xd2, 0x23, 0x11, 0xbf, 0x88, 0x70, 0x3a, 0xf3, 0x77, 0x70, 0x32, 0xb3,
+	0x36, 0xa7, 0x31, 0x6a, 0x7f, 0xde, 0xdd, 0xee, 0x9f, 0xfe, 0x3b, 0x00, 0xa3, 0x52, 0x2a, 0xee,
+	0x4d, 0x08, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -199,6 +432,135 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RecordAnalytics {
+		i--
+		if m.RecordAnalytics {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xd0
+	}
+	{
+		size := m.BurnFraction.Size()
+		i -= size
+		if _, err := m.BurnFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xca
+	if m.Mode != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Mode))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
+	if m.CompactBaseGasPriceHistory {
+		i--
+		if m.CompactBaseGasPriceHistory {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb8
+	}
+	if m.BaseGasPriceHistorySize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.BaseGasPriceHistorySize))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	{
+		size := m.MaxRateChangePerBlock.Size()
+		i -= size
+		if _, err := m.MaxRateChangePerBlock.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if len(m.FeeDiscountTiers) > 0 {
+		for iNdEx := len(m.FeeDiscountTiers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDiscountTiers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if m.DistributionEpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DistributionEpochBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.AccountFeeSpendWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AccountFeeSpendWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size := m.TargetDeadBand.Size()
+		i -= size
+		if _, err := m.TargetDeadBand.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.RejectGasAboveBlockLimit {
+		i--
+		if m.RejectGasAboveBlockLimit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.EffectiveMinLearningRate.Size()
+		i -= size
+		if _, err := m.EffectiveMinLearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.WarmupBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.WarmupBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
 	if m.DistributeFees {
 		i--
 		if m.DistributeFees {
@@ -309,6 +671,49 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDiscountTier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDiscountTier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDiscountTier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Discount.Size()
+		i -= size
+		if _, err := m.Discount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MinSpend.Size()
+		i -= size
+		if _, err := m.MinSpend.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	offset -= sovParams(v)
 	base := offset
@@ -356,6 +761,57 @@ func (m *Params) Size() (n int) {
 	if m.DistributeFees {
 		n += 2
 	}
+	if m.WarmupBlocks != 0 {
+		n += 1 + sovParams(uint64(m.WarmupBlocks))
+	}
+	l = m.EffectiveMinLearningRate.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.RejectGasAboveBlockLimit {
+		n += 2
+	}
+	l = m.TargetDeadBand.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.AccountFeeSpendWindow != 0 {
+		n += 2 + sovParams(uint64(m.AccountFeeSpendWindow))
+	}
+	if m.DistributionEpochBlocks != 0 {
+		n += 2 + sovParams(uint64(m.DistributionEpochBlocks))
+	}
+	if len(m.FeeDiscountTiers) > 0 {
+		for _, e := range m.FeeDiscountTiers {
+			l = e.Size()
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.MaxRateChangePerBlock.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.BaseGasPriceHistorySize != 0 {
+		n += 2 + sovParams(uint64(m.BaseGasPriceHistorySize))
+	}
+	if m.CompactBaseGasPriceHistory {
+		n += 3
+	}
+	if m.Mode != 0 {
+		n += 2 + sovParams(uint64(m.Mode))
+	}
+	l = m.BurnFraction.Size()
+	n += 2 + l + sovParams(uint64(l))
+	if m.RecordAnalytics {
+		n += 3
+	}
+	return n
+}
+
+func (m *FeeDiscountTier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MinSpend.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.Discount.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -742,6 +1198,449 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.DistributeFees = bool(v != 0)
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarmupBlocks", wireType)
+			}
+			m.WarmupBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WarmupBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveMinLearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EffectiveMinLearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectGasAboveBlockLimit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RejectGasAboveBlockLimit = bool(v != 0)
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetDeadBand", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TargetDeadBand.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AccountFeeSpendWindow", wireType)
+			}
+			m.AccountFeeSpendWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AccountFeeSpendWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionEpochBlocks", wireType)
+			}
+			m.DistributionEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DistributionEpochBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDiscountTiers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDiscountTiers = append(m.FeeDiscountTiers, FeeDiscountTier{})
+			if err := m.FeeDiscountTiers[len(m.FeeDiscountTiers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRateChangePerBlock", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxRateChangePerBlock.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPriceHistorySize", wireType)
+			}
+			m.BaseGasPriceHistorySize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseGasPriceHistorySize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompactBaseGasPriceHistory", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CompactBaseGasPriceHistory = bool(v != 0)
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mode", wireType)
+			}
+			m.Mode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Mode |= Mode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 26:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecordAnalytics", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RecordAnalytics = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDiscountTier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDiscountTier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDiscountTier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSpend", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinSpend.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Discount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Discount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -239,6 +239,7 @@ func TestParams(t *testing.T) {
 				MinLearningRate:     math.LegacyMustNewDecFromStr("0.1"),
 				MaxLearningRate:     math.LegacyMustNewDecFromStr("-0.1"),
 				FeeDenom:            types.DefaultFeeDenom,
+				Enabled:             true,
 			},
 			expectedErr: true,
 		},
@@ -255,6 +256,7 @@ func TestParams(t *testing.T) {
 				MinLearningRate:     math.LegacyMustNewDecFromStr("0.1"),
 				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
 				FeeDenom:            types.DefaultFeeDenom,
+				Enabled:             true,
 			},
 			expectedErr: true,
 		},
@@ -273,6 +275,440 @@ func TestParams(t *testing.T) {
 			},
 			expectedErr: true,
 		},
+		{
+			name: "effective min learning rate is negative",
+			p: types.Params{
+				Window:                   1,
+				Alpha:                    math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                     math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                    math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                    math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:      3,
+				MinBaseGasPrice:          math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:          math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:          math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:                 types.DefaultFeeDenom,
+				EffectiveMinLearningRate: math.LegacyMustNewDecFromStr("-0.01"),
+				Enabled:                  true,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "effective min learning rate is greater than max learning rate",
+			p: types.Params{
+				Window:                   1,
+				Alpha:                    math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                     math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                    math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                    math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:      3,
+				MinBaseGasPrice:          math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:          math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:          math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:                 types.DefaultFeeDenom,
+				EffectiveMinLearningRate: math.LegacyMustNewDecFromStr("0.1"),
+				Enabled:                  true,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid effective min learning rate",
+			p: types.Params{
+				Window:                   1,
+				Alpha:                    math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                     math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                    math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                    math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:      3,
+				MinBaseGasPrice:          math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:          math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:          math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:                 types.DefaultFeeDenom,
+				EffectiveMinLearningRate: math.LegacyMustNewDecFromStr("0.05"),
+			},
+			expectedErr: false,
+		},
+		{
+			name: "max rate change per block is negative",
+			p: types.Params{
+				Window:                1,
+				Alpha:                 math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                  math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                 math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                 math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:   3,
+				MinBaseGasPrice:       math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:       math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:       math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:              types.DefaultFeeDenom,
+				MaxRateChangePerBlock: math.LegacyMustNewDecFromStr("-0.1"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "max rate change per block is positive",
+			p: types.Params{
+				Window:                1,
+				Alpha:                 math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                  math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                 math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                 math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:   3,
+				MinBaseGasPrice:       math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:       math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:       math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:              types.DefaultFeeDenom,
+				MaxRateChangePerBlock: math.LegacyMustNewDecFromStr("0.1"),
+			},
+			expectedErr: false,
+		},
+		{
+			name: "base gas price history size at the max",
+			p: types.Params{
+				Window:                  1,
+				Alpha:                   math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                    math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                   math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                   math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:     3,
+				MinBaseGasPrice:         math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:         math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:         math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:                types.DefaultFeeDenom,
+				BaseGasPriceHistorySize: types.MaxBaseGasPriceHistorySize,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "base gas price history size above the max",
+			p: types.Params{
+				Window:                  1,
+				Alpha:                   math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                    math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                   math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                   math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:     3,
+				MinBaseGasPrice:         math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:         math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:         math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:                types.DefaultFeeDenom,
+				BaseGasPriceHistorySize: types.MaxBaseGasPriceHistorySize + 1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "target dead band is negative",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				TargetDeadBand:      math.LegacyMustNewDecFromStr("-0.1"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "target dead band is greater than 1",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				TargetDeadBand:      math.LegacyMustNewDecFromStr("1.1"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid target dead band",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				TargetDeadBand:      math.LegacyMustNewDecFromStr("0.1"),
+			},
+			expectedErr: false,
+		},
+		{
+			name: "burn fraction is negative",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				BurnFraction:        math.LegacyMustNewDecFromStr("-0.1"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "burn fraction is greater than 1",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				BurnFraction:        math.LegacyMustNewDecFromStr("1.1"),
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid burn fraction",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				BurnFraction:        math.LegacyMustNewDecFromStr("1"),
+			},
+			expectedErr: false,
+		},
+		{
+			name: "account fee spend window too large",
+			p: types.Params{
+				Window:                1,
+				Alpha:                 math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                  math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                 math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                 math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:   3,
+				MinBaseGasPrice:       math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:       math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:       math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:              types.DefaultFeeDenom,
+				AccountFeeSpendWindow: types.MaxAccountFeeSpendWindow + 1,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid fee discount tiers",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				FeeDiscountTiers: []types.FeeDiscountTier{
+					{MinSpend: math.NewInt(100), Discount: math.LegacyMustNewDecFromStr("0.1")},
+					{MinSpend: math.NewInt(1000), Discount: math.LegacyMustNewDecFromStr("0.2")},
+				},
+			},
+			expectedErr: false,
+		},
+		{
+			name: "fee discount tiers are not sorted",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				FeeDiscountTiers: []types.FeeDiscountTier{
+					{MinSpend: math.NewInt(1000), Discount: math.LegacyMustNewDecFromStr("0.1")},
+					{MinSpend: math.NewInt(100), Discount: math.LegacyMustNewDecFromStr("0.2")},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "fee discount is 1",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				FeeDiscountTiers: []types.FeeDiscountTier{
+					{MinSpend: math.NewInt(100), Discount: math.LegacyOneDec()},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "fee discount tier min spend is negative",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				FeeDiscountTiers: []types.FeeDiscountTier{
+					{MinSpend: math.NewInt(-1), Discount: math.LegacyMustNewDecFromStr("0.1")},
+				},
+			},
+			expectedErr: true,
+		},
+		{
+			name: "disabled with min learning rate greater than max learning rate",
+			p: types.Params{
+				Window:                   1,
+				Alpha:                    math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                     math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:                    math.LegacyMustNewDecFromStr("0.1"),
+				Delta:                    math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization:      3,
+				MinBaseGasPrice:          math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:          math.LegacyMustNewDecFromStr("0.1"),
+				MaxLearningRate:          math.LegacyMustNewDecFromStr("-0.05"),
+				FeeDenom:                 types.DefaultFeeDenom,
+				EffectiveMinLearningRate: math.LegacyMustNewDecFromStr("0.2"),
+			},
+			expectedErr: false,
+		},
+		{
+			name: "disabled with nil learning rate bounds",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				FeeDenom:            types.DefaultFeeDenom,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "valid EMA mode",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				Mode:                types.Mode_MODE_EMA,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "EMA mode with zero alpha",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				Mode:                types.Mode_MODE_EMA,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "EMA mode with alpha greater than one",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("1.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				Mode:                types.Mode_MODE_EMA,
+			},
+			expectedErr: true,
+		},
+		{
+			name: "AIMD mode with alpha greater than one",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("1.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				Mode:                types.Mode_MODE_AIMD,
+			},
+			expectedErr: false,
+		},
+		{
+			name: "unknown mode",
+			p: types.Params{
+				Window:              1,
+				Alpha:               math.LegacyMustNewDecFromStr("0.1"),
+				Beta:                math.LegacyMustNewDecFromStr("0.1"),
+				Gamma:               math.LegacyMustNewDecFromStr("0.1"),
+				Delta:               math.LegacyMustNewDecFromStr("0.1"),
+				MaxBlockUtilization: 3,
+				MinBaseGasPrice:     math.LegacyMustNewDecFromStr("1.0"),
+				MinLearningRate:     math.LegacyMustNewDecFromStr("0.01"),
+				MaxLearningRate:     math.LegacyMustNewDecFromStr("0.05"),
+				FeeDenom:            types.DefaultFeeDenom,
+				Mode:                types.Mode(2),
+			},
+			expectedErr: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -286,3 +722,106 @@ func TestParams(t *testing.T) {
 		})
 	}
 }
+
+func TestWarmupFactor(t *testing.T) {
+	t.Run("warmup disabled returns one", func(t *testing.T) {
+		p := types.DefaultAIMDParams()
+		require.Equal(t, math.LegacyOneDec(), p.WarmupFactor(0))
+	})
+
+	t.Run("warmup ramps linearly to one", func(t *testing.T) {
+		p := types.DefaultAIMDParams()
+		p.WarmupBlocks = 4
+
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.25"), p.WarmupFactor(0))
+		require.Equal(t, math.LegacyMustNewDecFromStr("0.5"), p.WarmupFactor(1))
+		require.Equal(t, math.LegacyMustNewDecFromStr("1.0"), p.WarmupFactor(3))
+		require.Equal(t, math.LegacyOneDec(), p.WarmupFactor(4))
+		require.Equal(t, math.LegacyOneDec(), p.WarmupFactor(100))
+	})
+}
+
+func TestEIP1559Equivalent(t *testing.T) {
+	t.Run("default params map exactly to ethereum constants", func(t *testing.T) {
+		p := types.DefaultParams()
+		resp := p.EIP1559Equivalent()
+
+		// Ethereum uses BASE_FEE_MAX_CHANGE_DENOMINATOR = 8 and ELASTICITY_MULTIPLIER = 2.
+		require.Equal(t, math.LegacyNewDec(8), resp.BaseFeeChangeDenominator)
+		require.Equal(t, math.LegacyNewDec(2), resp.ElasticityMultiplier)
+		require.True(t, resp.Exact)
+		require.Empty(t, resp.Approximations)
+	})
+
+	t.Run("default aimd params are approximate", func(t *testing.T) {
+		p := types.DefaultAIMDParams()
+		resp := p.EIP1559Equivalent()
+
+		require.Equal(t, math.LegacyNewDec(2), resp.BaseFeeChangeDenominator)
+		require.Equal(t, math.LegacyNewDec(2), resp.ElasticityMultiplier)
+		require.False(t, resp.Exact)
+		require.Len(t, resp.Approximations, 1)
+	})
+
+	t.Run("each non eip-1559 feature is reported", func(t *testing.T) {
+		p := types.DefaultParams()
+		p.Delta = math.LegacyMustNewDecFromStr("0.1")
+		p.TargetDeadBand = math.LegacyMustNewDecFromStr("0.05")
+		p.WarmupBlocks = 10
+		p.EffectiveMinLearningRate = math.LegacyMustNewDecFromStr("0.5")
+		resp := p.EIP1559Equivalent()
+
+		require.False(t, resp.Exact)
+		require.Len(t, resp.Approximations, 4)
+	})
+
+	t.Run("ema mode is approximate", func(t *testing.T) {
+		p := types.DefaultParams()
+		p.Mode = types.Mode_MODE_EMA
+		resp := p.EIP1559Equivalent()
+
+		require.Equal(t, math.LegacyNewDec(8), resp.BaseFeeChangeDenominator)
+		require.False(t, resp.Exact)
+		require.Len(t, resp.Approximations, 1)
+	})
+
+	t.Run("zero max learning rate has no denominator", func(t *testing.T) {
+		p := types.DefaultParams()
+		p.MinLearningRate = math.LegacyZeroDec()
+		p.MaxLearningRate = math.LegacyZeroDec()
+		resp := p.EIP1559Equivalent()
+
+		require.True(t, resp.BaseFeeChangeDenominator.IsZero())
+		require.False(t, resp.Exact)
+	})
+}
+
+func TestIsDistributionEpochEnd(t *testing.T) {
+	params := types.DefaultParams()
+
+	// Without epochs, every block ends one.
+	require.True(t, params.IsDistributionEpochEnd(7))
+
+	params.DistributionEpochBlocks = 5
+	require.False(t, params.IsDistributionEpochEnd(7))
+	require.True(t, params.IsDistributionEpochEnd(10))
+
+	// Fees are only accumulated if they are distributed at all.
+	require.False(t, params.AccumulatesFees())
+	params.DistributeFees = true
+	require.True(t, params.AccumulatesFees())
+}
+
+func TestFeeDiscount(t *testing.T) {
+	params := types.DefaultParams()
+	require.True(t, params.FeeDiscount(math.NewInt(1_000_000)).IsZero())
+
+	params.FeeDiscountTiers = []types.FeeDiscountTier{
+		{MinSpend: math.NewInt(100), Discount: math.LegacyMustNewDecFromStr("0.1")},
+		{MinSpend: math.NewInt(1000), Discount: math.LegacyMustNewDecFromStr("0.2")},
+	}
+	require.True(t, params.FeeDiscount(math.NewInt(99)).IsZero())
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.1"), params.FeeDiscount(math.NewInt(100)))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.1"), params.FeeDiscount(math.NewInt(999)))
+	require.Equal(t, math.LegacyMustNewDecFromStr("0.2"), params.FeeDiscount(math.NewInt(5000)))
+}
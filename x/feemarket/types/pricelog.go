@@ -0,0 +1,53 @@
+package types
+
+import (
+	"encoding/json"
+
+	"cosmossdk.io/math"
+)
+
+// PriceUpdateLogEvent identifies the structured price update log line so that log-based
+// alerts can match on it.
+const PriceUpdateLogEvent = "feemarket_price_update"
+
+// PriceUpdateLog is the payload of the structured log line emitted for each EndBlock
+// price update. All decimal values are encoded as strings to preserve precision.
+type PriceUpdateLog struct {
+	Event                string `json:"event"`
+	Height               int64  `json:"height"`
+	FeeDenom             string `json:"fee_denom"`
+	PreviousBaseGasPrice string `json:"previous_base_gas_price"`
+	BaseGasPrice         string `json:"base_gas_price"`
+	MinBaseGasPrice      string `json:"min_base_gas_price"`
+	LearningRate         string `json:"learning_rate"`
+	AverageUtilization   string `json:"average_utilization"`
+}
+
+// NewPriceUpdateLog returns the structured price update log for the given update.
+func NewPriceUpdateLog(
+	height int64,
+	params Params,
+	previousBaseGasPrice math.LegacyDec,
+	state State,
+) PriceUpdateLog {
+	return PriceUpdateLog{
+		Event:                PriceUpdateLogEvent,
+		Height:               height,
+		FeeDenom:             params.FeeDenom,
+		PreviousBaseGasPrice: previousBaseGasPrice.String(),
+		BaseGasPrice:         state.BaseGasPrice.String(),
+		MinBaseGasPrice:      params.MinBaseGasPrice.String(),
+		LearningRate:         state.LearningRate.String(),
+		AverageUtilization:   state.GetAverageUtilization(params).String(),
+	}
+}
+
+// JSON returns the log encoded as a single line of JSON.
+func (l PriceUpdateLog) JSON() (string, error) {
+	bz, err := json.Marshal(l)
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
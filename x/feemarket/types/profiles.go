@@ -0,0 +1,91 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+const (
+	// ChainProfileConsumer is a profile for consumer chains with low to moderate
+	// traffic that secure their blocks through a provider chain.
+	ChainProfileConsumer = "consumer"
+	// ChainProfileDeFi is a profile for DeFi chains with bursty demand where the
+	// fee market should react quickly to congestion.
+	ChainProfileDeFi = "defi"
+	// ChainProfileHighThroughput is a profile for chains with large blocks and
+	// sustained high demand where prices should move smoothly.
+	ChainProfileHighThroughput = "high-throughput"
+)
+
+// ChainProfiles returns the names of all chain profiles supported by
+// GenerateGenesisTemplate.
+func ChainProfiles() []string {
+	return []string{
+		ChainProfileConsumer,
+		ChainProfileDeFi,
+		ChainProfileHighThroughput,
+	}
+}
+
+// GenerateGenesisTemplate returns a recommended genesis state for the given chain
+// profile. The fee denom defaults to DefaultFeeDenom and is expected to be replaced
+// by the chain's fee denom.
+func GenerateGenesisTemplate(chainProfile string) (*GenesisState, error) {
+	var params Params
+
+	switch chainProfile {
+	case ChainProfileConsumer:
+		params = NewParams(
+			8,
+			math.LegacyMustNewDecFromStr("0.025"),
+			math.LegacyMustNewDecFromStr("0.95"),
+			math.LegacyMustNewDecFromStr("0.25"),
+			math.LegacyZeroDec(),
+			10_000_000,
+			math.LegacyMustNewDecFromStr("0.005"),
+			math.LegacyMustNewDecFromStr("0.01"),
+			math.LegacyMustNewDecFromStr("0.5"),
+			DefaultFeeDenom,
+			true,
+		)
+		params.WarmupBlocks = 8
+	case ChainProfileDeFi:
+		params = NewParams(
+			16,
+			math.LegacyMustNewDecFromStr("0.05"),
+			math.LegacyMustNewDecFromStr("0.9"),
+			math.LegacyMustNewDecFromStr("0.2"),
+			math.LegacyZeroDec(),
+			50_000_000,
+			math.LegacyMustNewDecFromStr("0.025"),
+			math.LegacyMustNewDecFromStr("0.02"),
+			math.LegacyMustNewDecFromStr("0.75"),
+			DefaultFeeDenom,
+			true,
+		)
+		params.EffectiveMinLearningRate = math.LegacyMustNewDecFromStr("0.1")
+		params.RejectGasAboveBlockLimit = true
+	case ChainProfileHighThroughput:
+		params = NewParams(
+			32,
+			math.LegacyMustNewDecFromStr("0.01"),
+			math.LegacyMustNewDecFromStr("0.98"),
+			math.LegacyMustNewDecFromStr("0.3"),
+			math.LegacyZeroDec(),
+			120_000_000,
+			math.LegacyMustNewDecFromStr("0.001"),
+			math.LegacyMustNewDecFromStr("0.005"),
+			math.LegacyMustNewDecFromStr("0.25"),
+			DefaultFeeDenom,
+			true,
+		)
+		params.RejectGasAboveBlockLimit = true
+	default:
+		return nil, fmt.Errorf("unknown chain profile %q; expected one of %v", chainProfile, ChainProfiles())
+	}
+
+	state := NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
+
+	return NewGenesisState(params, state), nil
+}
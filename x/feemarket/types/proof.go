@@ -0,0 +1,55 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+
+	"cosmossdk.io/store/rootmulti"
+	"github.com/cometbft/cometbft/crypto/merkle"
+	cmtcrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+)
+
+// StateProof is the fee market state committed at a height along with a Merkle proof of the
+// state against the app hash of that height. Relayers can submit it to a remote chain to
+// prove the fee market price.
+type StateProof struct {
+	// Height is the height at which the state was committed. Note that the app hash for
+	// this height is included in the header of the following block.
+	Height int64
+	// State is the decoded fee market state.
+	State State
+	// Value is the protobuf encoded state, as stored under KeyState.
+	Value []byte
+	// Proof is the chain of proof operations from the value to the app hash: an IAVL
+	// proof of the value in the module store, followed by a simple Merkle proof of the
+	// module store root in the multistore.
+	Proof *cmtcrypto.ProofOps
+}
+
+// StateKeyPath returns the Merkle key path of the fee market state in the module store
+// with the given name, i.e. "/<storeName>/x:<hex(KeyState)>".
+func StateKeyPath(storeName string) string {
+	return merkle.KeyPath{}.
+		AppendKey([]byte(storeName), merkle.KeyEncodingURL).
+		AppendKey(KeyState, merkle.KeyEncodingHex).
+		String()
+}
+
+// Verify verifies that the proof proves the encoded state against the given app hash, for
+// the module store with the given name, and that the encoded state matches the decoded
+// state.
+func (p StateProof) Verify(appHash []byte, storeName string) error {
+	if p.Proof == nil {
+		return fmt.Errorf("state proof is empty")
+	}
+
+	bz, err := p.State.Marshal()
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(bz, p.Value) {
+		return fmt.Errorf("state does not match the proven value")
+	}
+
+	return rootmulti.DefaultProofRuntime().VerifyValue(p.Proof, appHash, StateKeyPath(storeName), p.Value)
+}
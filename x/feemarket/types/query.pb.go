@@ -5,7 +5,9 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
@@ -245,7 +247,12 @@ func (m *GasPriceRequest) GetDenom() string {
 // GasPriceResponse is the response type for the Query/GasPrice RPC method.
 // Returns a gas price in specified denom.
 type GasPriceResponse struct {
+	// price is the gas price, rounded up to the precision of the denom.
 	Price types.DecCoin `protobuf:"bytes,1,opt,name=price,proto3" json:"price"`
+	// precision is the number of decimal places of the price that are
+	// meaningful for the denom. It is derived from the denom's metadata and
+	// defaults to 18 if the denom has no registered metadata.
+	Precision uint32 `protobuf:"varint,2,opt,name=precision,proto3" json:"precision,omitempty"`
 }
 
 func (m *GasPriceResponse) Reset()         { *m = GasPriceResponse{} }
@@ -288,8 +295,18 @@ func (m *GasPriceResponse) GetPrice() types.DecCoin {
 	return types.DecCoin{}
 }
 
+func (m *GasPriceResponse) GetPrecision() uint32 {
+	if m != nil {
+		return m.Precision
+	}
+	return 0
+}
+
 // GasPriceRequest is the request type for the Query/GasPrices RPC method.
 type GasPricesRequest struct {
+	// denoms we are querying gas prices in. If empty, the gas prices in the fee
+	// denom and all extra denoms of the denom resolver are returned.
+	Denoms []string `protobuf:"bytes,1,rep,name=denoms,proto3" json:"denoms,omitempty"`
 }
 
 func (m *GasPricesRequest) Reset()         { *m = GasPricesRequest{} }
@@ -325,6 +342,13 @@ func (m *GasPricesRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GasPricesRequest proto.InternalMessageInfo
 
+func (m *GasPricesRequest) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
+	}
+	return nil
+}
+
 // GasPricesResponse is the response type for the Query/GasPrices RPC method.
 // Returns a gas price in all available denoms.
 type GasPricesResponse struct {
@@ -371,602 +395,4340 @@ func (m *GasPricesResponse) GetPrices() github_com_cosmos_cosmos_sdk_types.DecCo
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*ParamsRequest)(nil), "feemarket.feemarket.v1.ParamsRequest")
-	proto.RegisterType((*ParamsResponse)(nil), "feemarket.feemarket.v1.ParamsResponse")
-	proto.RegisterType((*StateRequest)(nil), "feemarket.feemarket.v1.StateRequest")
-	proto.RegisterType((*StateResponse)(nil), "feemarket.feemarket.v1.StateResponse")
-	proto.RegisterType((*GasPriceRequest)(nil), "feemarket.feemarket.v1.GasPriceRequest")
-	proto.RegisterType((*GasPriceResponse)(nil), "feemarket.feemarket.v1.GasPriceResponse")
-	proto.RegisterType((*GasPricesRequest)(nil), "feemarket.feemarket.v1.GasPricesRequest")
-	proto.RegisterType((*GasPricesResponse)(nil), "feemarket.feemarket.v1.GasPricesResponse")
+// MinGasPriceConfigRequest is the request type for the Query/MinGasPriceConfig
+// RPC method.
+type MinGasPriceConfigRequest struct {
+	// denom we are querying the minimum gas price in. Defaults to the fee denom
+	// if empty.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("feemarket/feemarket/v1/query.proto", fileDescriptor_d683b3b0d8494138)
+func (m *MinGasPriceConfigRequest) Reset()         { *m = MinGasPriceConfigRequest{} }
+func (m *MinGasPriceConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*MinGasPriceConfigRequest) ProtoMessage()    {}
+func (*MinGasPriceConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{8}
 }
-
-var fileDescriptor_d683b3b0d8494138 = []byte{
-	// 565 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0x6e, 0x18, 0xad, 0xa8, 0x61, 0x1b, 0x33, 0x65, 0x9a, 0x42, 0x49, 0x47, 0xd8, 0xe8, 0x00,
-	0x2d, 0x56, 0xc7, 0x05, 0x24, 0xb8, 0x14, 0x24, 0x04, 0x07, 0xb4, 0x95, 0x1b, 0x17, 0xe4, 0x66,
-	0x26, 0x44, 0x25, 0x76, 0x1a, 0xbb, 0x15, 0x13, 0xe2, 0x32, 0x24, 0xce, 0x48, 0xfc, 0x09, 0xc4,
-	0x89, 0x9f, 0xb1, 0xe3, 0x24, 0x2e, 0x9c, 0x00, 0xb5, 0x48, 0xfc, 0x0c, 0x50, 0xec, 0x97, 0xb6,
-	0x4c, 0xcb, 0xc2, 0xa5, 0x7d, 0x7e, 0xfe, 0xde, 0xf7, 0x3d, 0xbf, 0xf7, 0xb5, 0xc8, 0x7d, 0xc1,
-	0x58, 0x44, 0x93, 0x1e, 0x53, 0x64, 0x1a, 0x0d, 0x5b, 0xa4, 0x3f, 0x60, 0xc9, 0x9e, 0x17, 0x27,
-	0x42, 0x09, 0xbc, 0x3c, 0xb9, 0xf1, 0xa6, 0xd1, 0xb0, 0x65, 0xd7, 0x02, 0x11, 0x08, 0x0d, 0x21,
-	0x69, 0x64, 0xd0, 0x76, 0x3d, 0x10, 0x22, 0x78, 0xc5, 0x08, 0x8d, 0x43, 0x42, 0x39, 0x17, 0x8a,
-	0xaa, 0x50, 0x70, 0x09, 0xb7, 0x8e, 0x2f, 0x64, 0x24, 0x24, 0xe9, 0x52, 0xc9, 0xc8, 0xb0, 0xd5,
-	0x65, 0x8a, 0xb6, 0x88, 0x2f, 0x42, 0x0e, 0xf7, 0x4b, 0x34, 0x0a, 0xb9, 0x20, 0xfa, 0x13, 0x52,
-	0x57, 0x73, 0x5a, 0x8c, 0x69, 0x42, 0xa3, 0x8c, 0x77, 0x2d, 0x07, 0x14, 0x30, 0xce, 0x64, 0x08,
-	0x28, 0x77, 0x11, 0xcd, 0x6f, 0xeb, 0xaa, 0x0e, 0xeb, 0x0f, 0x98, 0x54, 0xee, 0x13, 0xb4, 0x90,
-	0x25, 0x64, 0x2c, 0xb8, 0x64, 0xf8, 0x2e, 0xaa, 0x18, 0xe2, 0x15, 0x6b, 0xd5, 0xda, 0x38, 0xbb,
-	0xe5, 0x78, 0xc7, 0xbf, 0xde, 0x33, 0x75, 0xed, 0xd3, 0x07, 0xdf, 0x1b, 0xa5, 0x0e, 0xd4, 0xb8,
-	0x0b, 0xe8, 0xdc, 0x53, 0x45, 0x15, 0xcb, 0xf8, 0x1f, 0xa3, 0x79, 0x38, 0x03, 0xfd, 0x1d, 0x54,
-	0x96, 0x69, 0x02, 0xd8, 0x2f, 0xe7, 0xb1, 0xeb, 0x2a, 0x20, 0x37, 0x15, 0x6e, 0x13, 0x2d, 0x3e,
-	0xa4, 0x72, 0x3b, 0x09, 0xfd, 0x8c, 0x1e, 0xd7, 0x50, 0x79, 0x97, 0x71, 0x11, 0x69, 0xb6, 0x6a,
-	0xc7, 0x1c, 0xdc, 0x1d, 0x74, 0x7e, 0x0a, 0x04, 0xdd, 0x7b, 0xa8, 0x1c, 0xa7, 0x09, 0xd0, 0xad,
-	0x7b, 0x66, 0x0f, 0x5e, 0xba, 0x07, 0x0f, 0xf6, 0xe0, 0x3d, 0x60, 0xfe, 0x7d, 0x11, 0xf2, 0x76,
-	0x35, 0x95, 0xfd, 0xf4, 0xfb, 0xcb, 0x0d, 0xab, 0x63, 0xaa, 0x5c, 0x3c, 0xa5, 0x9c, 0xcc, 0xee,
-	0x9d, 0x85, 0x96, 0x66, 0x92, 0x20, 0xc4, 0x51, 0x45, 0x97, 0xa4, 0xf3, 0x9b, 0x2b, 0x54, 0xba,
-	0x9d, 0x2a, 0x7d, 0xfe, 0xd1, 0xb8, 0x19, 0x84, 0xea, 0xe5, 0xa0, 0xeb, 0xf9, 0x22, 0x22, 0xe0,
-	0x10, 0xf3, 0xb5, 0x29, 0x77, 0x7b, 0x44, 0xed, 0xc5, 0x4c, 0x66, 0x35, 0xd2, 0x34, 0x06, 0x2a,
-	0x5b, 0x7f, 0xe6, 0x50, 0x79, 0x27, 0x35, 0x2b, 0x1e, 0xa0, 0x8a, 0xd9, 0x09, 0x5e, 0x3f, 0x79,
-	0x67, 0xf0, 0x00, 0xfb, 0x5a, 0x11, 0xcc, 0x3c, 0xc9, 0xad, 0xef, 0x7f, 0xfd, 0xf5, 0xf1, 0xd4,
-	0x32, 0xae, 0x1d, 0xe7, 0x3f, 0xdc, 0x47, 0x65, 0xbd, 0x2c, 0xbc, 0x76, 0xe2, 0x2e, 0x33, 0xd1,
-	0xf5, 0x02, 0x14, 0x68, 0x5e, 0xd2, 0x9a, 0x17, 0xf1, 0x85, 0x7f, 0x35, 0xb5, 0x13, 0xf0, 0x7b,
-	0x0b, 0x9d, 0xc9, 0x26, 0x8f, 0x9b, 0x79, 0x84, 0x47, 0xcc, 0x62, 0x6f, 0x14, 0x03, 0x41, 0xbc,
-	0xa9, 0xc5, 0xaf, 0xe0, 0xc6, 0x91, 0xdf, 0x12, 0x95, 0xcf, 0xf5, 0xd4, 0xc9, 0x1b, 0x6d, 0xb4,
-	0xb7, 0x78, 0xdf, 0x42, 0xd5, 0x89, 0x05, 0x70, 0xa1, 0xc0, 0x64, 0xf2, 0xd7, 0xff, 0x03, 0x09,
-	0xbd, 0xac, 0xea, 0x5e, 0x6c, 0xbc, 0x92, 0xd3, 0x8b, 0x6c, 0x3f, 0x3a, 0x18, 0x39, 0xd6, 0xe1,
-	0xc8, 0xb1, 0x7e, 0x8e, 0x1c, 0xeb, 0xc3, 0xd8, 0x29, 0x1d, 0x8e, 0x9d, 0xd2, 0xb7, 0xb1, 0x53,
-	0x7a, 0x46, 0x66, 0x5c, 0x25, 0x7b, 0x61, 0xbc, 0x19, 0xb1, 0xe1, 0x0c, 0xcd, 0xeb, 0x99, 0x58,
-	0x5b, 0xac, 0x5b, 0xd1, 0x7f, 0x13, 0xb7, 0xfe, 0x06, 0x00, 0x00, 0xff, 0xff, 0x1e, 0x87, 0x38,
-	0x2d, 0x16, 0x05, 0x00, 0x00,
+func (m *MinGasPriceConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinGasPriceConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinGasPriceConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinGasPriceConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinGasPriceConfigRequest.Merge(m, src)
+}
+func (m *MinGasPriceConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinGasPriceConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinGasPriceConfigRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MinGasPriceConfigRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Params returns the current feemarket module parameters.
-	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
-	// State returns the current feemarket module state.
-	State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error)
-	// GasPrice returns the current feemarket module gas price
-	// for specified denom.
-	GasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
-	// GasPrices returns the current feemarket module list of gas prices
-	// in all available denoms.
-	GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error)
+func (m *MinGasPriceConfigRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// MinGasPriceConfigResponse is the response type for the
+// Query/MinGasPriceConfig RPC method.
+type MinGasPriceConfigResponse struct {
+	// minimum_gas_prices is the minimum gas price formatted as a cosmos-sdk
+	// gas-price string, e.g. 0.025uatom.
+	MinimumGasPrices string `protobuf:"bytes,1,opt,name=minimum_gas_prices,json=minimumGasPrices,proto3" json:"minimum_gas_prices,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *MinGasPriceConfigResponse) Reset()         { *m = MinGasPriceConfigResponse{} }
+func (m *MinGasPriceConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*MinGasPriceConfigResponse) ProtoMessage()    {}
+func (*MinGasPriceConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{9}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
-	out := new(ParamsResponse)
-	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MinGasPriceConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinGasPriceConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinGasPriceConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MinGasPriceConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinGasPriceConfigResponse.Merge(m, src)
+}
+func (m *MinGasPriceConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinGasPriceConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinGasPriceConfigResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error) {
-	out := new(StateResponse)
-	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/State", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MinGasPriceConfigResponse proto.InternalMessageInfo
+
+func (m *MinGasPriceConfigResponse) GetMinimumGasPrices() string {
+	if m != nil {
+		return m.MinimumGasPrices
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
-	out := new(GasPriceResponse)
-	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/GasPrice", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// RevenueByMsgTypeRequest is the request type for the Query/RevenueByMsgType
+// RPC method.
+type RevenueByMsgTypeRequest struct {
 }
 
-func (c *queryClient) GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error) {
-	out := new(GasPricesResponse)
-	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/GasPrices", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *RevenueByMsgTypeRequest) Reset()         { *m = RevenueByMsgTypeRequest{} }
+func (m *RevenueByMsgTypeRequest) String() string { return proto.CompactTextString(m) }
+func (*RevenueByMsgTypeRequest) ProtoMessage()    {}
+func (*RevenueByMsgTypeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{10}
+}
+func (m *RevenueByMsgTypeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RevenueByMsgTypeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevenueByMsgTypeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Params returns the current feemarket module parameters.
-	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
-	// State returns the current feemarket module state.
-	State(context.Context, *StateRequest) (*StateResponse, error)
-	// GasPrice returns the current feemarket module gas price
-	// for specified denom.
-	GasPrice(context.Context, *GasPriceRequest) (*GasPriceResponse, error)
-	// GasPrices returns the current feemarket module list of gas prices
-	// in all available denoms.
-	GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error)
+func (m *RevenueByMsgTypeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevenueByMsgTypeRequest.Merge(m, src)
+}
+func (m *RevenueByMsgTypeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevenueByMsgTypeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevenueByMsgTypeRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_RevenueByMsgTypeRequest proto.InternalMessageInfo
+
+// RevenueByMsgTypeResponse is the response type for the Query/RevenueByMsgType
+// RPC method.
+type RevenueByMsgTypeResponse struct {
+	// revenue is the fee revenue collected over the window for each primary
+	// message type, sorted by message type URL.
+	Revenue []MsgTypeRevenue `protobuf:"bytes,1,rep,name=revenue,proto3" json:"revenue"`
+	// total is the total fee revenue collected over the window.
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *RevenueByMsgTypeResponse) Reset()         { *m = RevenueByMsgTypeResponse{} }
+func (m *RevenueByMsgTypeResponse) String() string { return proto.CompactTextString(m) }
+func (*RevenueByMsgTypeResponse) ProtoMessage()    {}
+func (*RevenueByMsgTypeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{11}
 }
-func (*UnimplementedQueryServer) State(ctx context.Context, req *StateRequest) (*StateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
+func (m *RevenueByMsgTypeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GasPrice(ctx context.Context, req *GasPriceRequest) (*GasPriceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GasPrice not implemented")
+func (m *RevenueByMsgTypeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RevenueByMsgTypeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GasPrices(ctx context.Context, req *GasPricesRequest) (*GasPricesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
+func (m *RevenueByMsgTypeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevenueByMsgTypeResponse.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *RevenueByMsgTypeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RevenueByMsgTypeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevenueByMsgTypeResponse.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/feemarket.feemarket.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+var xxx_messageInfo_RevenueByMsgTypeResponse proto.InternalMessageInfo
+
+func (m *RevenueByMsgTypeResponse) GetRevenue() []MsgTypeRevenue {
+	if m != nil {
+		return m.Revenue
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(StateRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).State(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/feemarket.feemarket.v1.Query/State",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).State(ctx, req.(*StateRequest))
+func (m *RevenueByMsgTypeResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GasPriceRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GasPrice(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/feemarket.feemarket.v1.Query/GasPrice",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GasPrice(ctx, req.(*GasPriceRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// AccountFeeSpendRequest is the request type for the Query/AccountFeeSpend RPC
+// method.
+type AccountFeeSpendRequest struct {
+	// address is the account we are querying the fee spend of.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _Query_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GasPricesRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GasPrices(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/feemarket.feemarket.v1.Query/GasPrices",
+func (m *AccountFeeSpendRequest) Reset()         { *m = AccountFeeSpendRequest{} }
+func (m *AccountFeeSpendRequest) String() string { return proto.CompactTextString(m) }
+func (*AccountFeeSpendRequest) ProtoMessage()    {}
+func (*AccountFeeSpendRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{12}
+}
+func (m *AccountFeeSpendRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountFeeSpendRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountFeeSpendRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GasPrices(ctx, req.(*GasPricesRequest))
+}
+func (m *AccountFeeSpendRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountFeeSpendRequest.Merge(m, src)
+}
+func (m *AccountFeeSpendRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountFeeSpendRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountFeeSpendRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountFeeSpendRequest proto.InternalMessageInfo
+
+func (m *AccountFeeSpendRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "feemarket.feemarket.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "State",
-			Handler:    _Query_State_Handler,
-		},
-		{
-			MethodName: "GasPrice",
-			Handler:    _Query_GasPrice_Handler,
-		},
-		{
-			MethodName: "GasPrices",
-			Handler:    _Query_GasPrices_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "feemarket/feemarket/v1/query.proto",
+// AccountFeeSpendResponse is the response type for the Query/AccountFeeSpend
+// RPC method.
+type AccountFeeSpendResponse struct {
+	// fees are the fees paid by the account over the account fee spend window.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
 }
 
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *AccountFeeSpendResponse) Reset()         { *m = AccountFeeSpendResponse{} }
+func (m *AccountFeeSpendResponse) String() string { return proto.CompactTextString(m) }
+func (*AccountFeeSpendResponse) ProtoMessage()    {}
+func (*AccountFeeSpendResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{13}
+}
+func (m *AccountFeeSpendResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountFeeSpendResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountFeeSpendResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AccountFeeSpendResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountFeeSpendResponse.Merge(m, src)
 }
-
-func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *AccountFeeSpendResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountFeeSpendResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountFeeSpendResponse.DiscardUnknown(m)
 }
 
-func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AccountFeeSpendResponse proto.InternalMessageInfo
+
+func (m *AccountFeeSpendResponse) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EIP1559EquivalentRequest is the request type for the Query/EIP1559Equivalent
+// RPC method.
+type EIP1559EquivalentRequest struct {
 }
 
-func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *EIP1559EquivalentRequest) Reset()         { *m = EIP1559EquivalentRequest{} }
+func (m *EIP1559EquivalentRequest) String() string { return proto.CompactTextString(m) }
+func (*EIP1559EquivalentRequest) ProtoMessage()    {}
+func (*EIP1559EquivalentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{14}
+}
+func (m *EIP1559EquivalentRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EIP1559EquivalentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EIP1559EquivalentRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *StateRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EIP1559EquivalentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EIP1559EquivalentRequest.Merge(m, src)
 }
-
-func (m *StateRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EIP1559EquivalentRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *StateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *EIP1559EquivalentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_EIP1559EquivalentRequest.DiscardUnknown(m)
 }
 
-func (m *StateResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_EIP1559EquivalentRequest proto.InternalMessageInfo
 
-func (m *StateResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// EIP1559EquivalentResponse is the response type for the
+// Query/EIP1559Equivalent RPC method.
+type EIP1559EquivalentResponse struct {
+	// base_fee_change_denominator is the EIP-1559 base fee change denominator
+	// equivalent to the maximum learning rate, i.e. 1 / max_learning_rate.
+	BaseFeeChangeDenominator cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_fee_change_denominator,json=baseFeeChangeDenominator,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee_change_denominator"`
+	// elasticity_multiplier is the EIP-1559 elasticity multiplier, i.e. the ratio
+	// of the maximum block utilization to the target block utilization.
+	ElasticityMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=elasticity_multiplier,json=elasticityMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"elasticity_multiplier"`
+	// exact is true if the parameters behave identically to EIP-1559 with the
+	// above constants, and false if the mapping is only approximate.
+	Exact bool `protobuf:"varint,3,opt,name=exact,proto3" json:"exact,omitempty"`
+	// approximations lists the parameters that cause the mapping to be
+	// approximate. It is empty if exact is true.
+	Approximations []string `protobuf:"bytes,4,rep,name=approximations,proto3" json:"approximations,omitempty"`
 }
 
-func (m *StateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+func (m *EIP1559EquivalentResponse) Reset()         { *m = EIP1559EquivalentResponse{} }
+func (m *EIP1559EquivalentResponse) String() string { return proto.CompactTextString(m) }
+func (*EIP1559EquivalentResponse) ProtoMessage()    {}
+func (*EIP1559EquivalentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{15}
+}
+func (m *EIP1559EquivalentResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EIP1559EquivalentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EIP1559EquivalentResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
 }
-
-func (m *GasPriceRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *EIP1559EquivalentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EIP1559EquivalentResponse.Merge(m, src)
 }
-
-func (m *GasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EIP1559EquivalentResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *EIP1559EquivalentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_EIP1559EquivalentResponse.DiscardUnknown(m)
 }
 
-func (m *GasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_EIP1559EquivalentResponse proto.InternalMessageInfo
+
+func (m *EIP1559EquivalentResponse) GetExact() bool {
+	if m != nil {
+		return m.Exact
 	}
-	return len(dAtA) - i, nil
+	return false
 }
 
-func (m *GasPriceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EIP1559EquivalentResponse) GetApproximations() []string {
+	if m != nil {
+		return m.Approximations
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BaseGasPriceHistoryRequest is the request type for the
+// Query/BaseGasPriceHistory RPC method.
+type BaseGasPriceHistoryRequest struct {
+	// limit is the maximum number of the most recent records to return. A value
+	// of zero returns all retained records.
+	Limit uint64 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 }
 
-func (m *GasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+func (m *BaseGasPriceHistoryRequest) Reset()         { *m = BaseGasPriceHistoryRequest{} }
+func (m *BaseGasPriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*BaseGasPriceHistoryRequest) ProtoMessage()    {}
+func (*BaseGasPriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{16}
+}
+func (m *BaseGasPriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseGasPriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseGasPriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *BaseGasPriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseGasPriceHistoryRequest.Merge(m, src)
+}
+func (m *BaseGasPriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseGasPriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseGasPriceHistoryRequest.DiscardUnknown(m)
 }
 
-func (m *GasPricesRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BaseGasPriceHistoryRequest proto.InternalMessageInfo
+
+func (m *BaseGasPriceHistoryRequest) GetLimit() uint64 {
+	if m != nil {
+		return m.Limit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *GasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// BaseGasPriceHistoryResponse is the response type for the
+// Query/BaseGasPriceHistory RPC method.
+type BaseGasPriceHistoryResponse struct {
+	// history is the base gas price at the end of each of the most recent
+	// blocks, ordered by height.
+	History []BaseGasPriceRecord `protobuf:"bytes,1,rep,name=history,proto3" json:"history"`
 }
 
-func (m *GasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *BaseGasPriceHistoryResponse) Reset()         { *m = BaseGasPriceHistoryResponse{} }
+func (m *BaseGasPriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*BaseGasPriceHistoryResponse) ProtoMessage()    {}
+func (*BaseGasPriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{17}
+}
+func (m *BaseGasPriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BaseGasPriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BaseGasPriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BaseGasPriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BaseGasPriceHistoryResponse.Merge(m, src)
+}
+func (m *BaseGasPriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *BaseGasPriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BaseGasPriceHistoryResponse.DiscardUnknown(m)
 }
 
-func (m *GasPricesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_BaseGasPriceHistoryResponse proto.InternalMessageInfo
+
+func (m *BaseGasPriceHistoryResponse) GetHistory() []BaseGasPriceRecord {
+	if m != nil {
+		return m.History
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *GasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// CommunityPoolContributionsRequest is the request type for the
+// Query/CommunityPoolContributions RPC method.
+type CommunityPoolContributionsRequest struct {
 }
 
-func (m *GasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
+func (m *CommunityPoolContributionsRequest) Reset()         { *m = CommunityPoolContributionsRequest{} }
+func (m *CommunityPoolContributionsRequest) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolContributionsRequest) ProtoMessage()    {}
+func (*CommunityPoolContributionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{18}
+}
+func (m *CommunityPoolContributionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolContributionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolContributionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolContributionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolContributionsRequest.Merge(m, src)
+}
+func (m *CommunityPoolContributionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolContributionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolContributionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolContributionsRequest proto.InternalMessageInfo
+
+// CommunityPoolContributionsResponse is the response type for the
+// Query/CommunityPoolContributions RPC method.
+type CommunityPoolContributionsResponse struct {
+	// contributions are the cumulative contributions since the last reset.
+	Contributions CommunityPoolContributions `protobuf:"bytes,1,opt,name=contributions,proto3" json:"contributions"`
+	// window is the amount contributed over the blocks of the window.
+	Window github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,2,rep,name=window,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"window"`
+}
+
+func (m *CommunityPoolContributionsResponse) Reset()         { *m = CommunityPoolContributionsResponse{} }
+func (m *CommunityPoolContributionsResponse) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolContributionsResponse) ProtoMessage()    {}
+func (*CommunityPoolContributionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{19}
+}
+func (m *CommunityPoolContributionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolContributionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolContributionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolContributionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolContributionsResponse.Merge(m, src)
+}
+func (m *CommunityPoolContributionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolContributionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolContributionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolContributionsResponse proto.InternalMessageInfo
+
+func (m *CommunityPoolContributionsResponse) GetContributions() CommunityPoolContributions {
+	if m != nil {
+		return m.Contributions
+	}
+	return CommunityPoolContributions{}
+}
+
+func (m *CommunityPoolContributionsResponse) GetWindow() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Window
+	}
+	return nil
+}
+
+// UtilizationRequest is the request type for the Query/Utilization RPC method.
+type UtilizationRequest struct {
+}
+
+func (m *UtilizationRequest) Reset()         { *m = UtilizationRequest{} }
+func (m *UtilizationRequest) String() string { return proto.CompactTextString(m) }
+func (*UtilizationRequest) ProtoMessage()    {}
+func (*UtilizationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{20}
+}
+func (m *UtilizationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UtilizationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UtilizationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UtilizationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtilizationRequest.Merge(m, src)
+}
+func (m *UtilizationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *UtilizationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtilizationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UtilizationRequest proto.InternalMessageInfo
+
+// UtilizationResponse is the response type for the Query/Utilization RPC
+// method.
+type UtilizationResponse struct {
+	// utilization is the gas used over the window divided by the window's
+	// capacity, i.e. the max block utilization times the window length, in
+	// [0, 1].
+	Utilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=utilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"utilization"`
+	// window_gas is the gas used over the blocks of the window.
+	WindowGas uint64 `protobuf:"varint,2,opt,name=window_gas,json=windowGas,proto3" json:"window_gas,omitempty"`
+	// window is the number of blocks in the window.
+	Window uint64 `protobuf:"varint,3,opt,name=window,proto3" json:"window,omitempty"`
+}
+
+func (m *UtilizationResponse) Reset()         { *m = UtilizationResponse{} }
+func (m *UtilizationResponse) String() string { return proto.CompactTextString(m) }
+func (*UtilizationResponse) ProtoMessage()    {}
+func (*UtilizationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{21}
+}
+func (m *UtilizationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UtilizationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UtilizationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UtilizationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UtilizationResponse.Merge(m, src)
+}
+func (m *UtilizationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *UtilizationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UtilizationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UtilizationResponse proto.InternalMessageInfo
+
+func (m *UtilizationResponse) GetWindowGas() uint64 {
+	if m != nil {
+		return m.WindowGas
+	}
+	return 0
+}
+
+func (m *UtilizationResponse) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+// ProjectGasPriceRequest is the request type for the Query/ProjectGasPrice RPC
+// method.
+type ProjectGasPriceRequest struct {
+	// blocks is the number of blocks to project the fee market forward by. It
+	// must be at most 10000.
+	Blocks uint64 `protobuf:"varint,1,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// assumed_utilization is the utilization of every projected block, as a
+	// fraction of the max block utilization in [0, 1].
+	AssumedUtilization string `protobuf:"bytes,2,opt,name=assumed_utilization,json=assumedUtilization,proto3" json:"assumed_utilization,omitempty"`
+}
+
+func (m *ProjectGasPriceRequest) Reset()         { *m = ProjectGasPriceRequest{} }
+func (m *ProjectGasPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*ProjectGasPriceRequest) ProtoMessage()    {}
+func (*ProjectGasPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{22}
+}
+func (m *ProjectGasPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectGasPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectGasPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectGasPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectGasPriceRequest.Merge(m, src)
+}
+func (m *ProjectGasPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectGasPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectGasPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectGasPriceRequest proto.InternalMessageInfo
+
+func (m *ProjectGasPriceRequest) GetBlocks() uint64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *ProjectGasPriceRequest) GetAssumedUtilization() string {
+	if m != nil {
+		return m.AssumedUtilization
+	}
+	return ""
+}
+
+// ProjectGasPriceResponse is the response type for the Query/ProjectGasPrice
+// RPC method.
+type ProjectGasPriceResponse struct {
+	// base_gas_price is the projected base gas price after the given number of
+	// blocks.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// learning_rate is the projected learning rate after the given number of
+	// blocks.
+	LearningRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=learning_rate,json=learningRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"learning_rate"`
+}
+
+func (m *ProjectGasPriceResponse) Reset()         { *m = ProjectGasPriceResponse{} }
+func (m *ProjectGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*ProjectGasPriceResponse) ProtoMessage()    {}
+func (*ProjectGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{23}
+}
+func (m *ProjectGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ProjectGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ProjectGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ProjectGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProjectGasPriceResponse.Merge(m, src)
+}
+func (m *ProjectGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *ProjectGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProjectGasPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProjectGasPriceResponse proto.InternalMessageInfo
+
+// MarketInfoRequest is the request type for the Query/MarketInfo RPC method.
+type MarketInfoRequest struct {
+}
+
+func (m *MarketInfoRequest) Reset()         { *m = MarketInfoRequest{} }
+func (m *MarketInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*MarketInfoRequest) ProtoMessage()    {}
+func (*MarketInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{24}
+}
+func (m *MarketInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketInfoRequest.Merge(m, src)
+}
+func (m *MarketInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketInfoRequest proto.InternalMessageInfo
+
+// MarketInfoResponse is the response type for the Query/MarketInfo RPC method.
+type MarketInfoResponse struct {
+	// params are the current feemarket module parameters.
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// state is the current feemarket module state.
+	State State `protobuf:"bytes,2,opt,name=state,proto3" json:"state"`
+	// min_gas_price is the current minimum gas price in the fee denom.
+	MinGasPrice types.DecCoin `protobuf:"bytes,3,opt,name=min_gas_price,json=minGasPrice,proto3" json:"min_gas_price"`
+	// congestion_multiplier is the current base gas price as a multiple of the min
+	// base gas price. It is zero if the min base gas price is zero.
+	CongestionMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=congestion_multiplier,json=congestionMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"congestion_multiplier"`
+}
+
+func (m *MarketInfoResponse) Reset()         { *m = MarketInfoResponse{} }
+func (m *MarketInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*MarketInfoResponse) ProtoMessage()    {}
+func (*MarketInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d683b3b0d8494138, []int{25}
+}
+func (m *MarketInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MarketInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MarketInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MarketInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarketInfoResponse.Merge(m, src)
+}
+func (m *MarketInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MarketInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarketInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarketInfoResponse proto.InternalMessageInfo
+
+func (m *MarketInfoResponse) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *MarketInfoResponse) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return State{}
+}
+
+func (m *MarketInfoResponse) GetMinGasPrice() types.DecCoin {
+	if m != nil {
+		return m.MinGasPrice
+	}
+	return types.DecCoin{}
+}
+
+func init() {
+	proto.RegisterType((*ParamsRequest)(nil), "feemarket.feemarket.v1.ParamsRequest")
+	proto.RegisterType((*ParamsResponse)(nil), "feemarket.feemarket.v1.ParamsResponse")
+	proto.RegisterType((*StateRequest)(nil), "feemarket.feemarket.v1.StateRequest")
+	proto.RegisterType((*StateResponse)(nil), "feemarket.feemarket.v1.StateResponse")
+	proto.RegisterType((*GasPriceRequest)(nil), "feemarket.feemarket.v1.GasPriceRequest")
+	proto.RegisterType((*GasPriceResponse)(nil), "feemarket.feemarket.v1.GasPriceResponse")
+	proto.RegisterType((*GasPricesRequest)(nil), "feemarket.feemarket.v1.GasPricesRequest")
+	proto.RegisterType((*GasPricesResponse)(nil), "feemarket.feemarket.v1.GasPricesResponse")
+	proto.RegisterType((*MinGasPriceConfigRequest)(nil), "feemarket.feemarket.v1.MinGasPriceConfigRequest")
+	proto.RegisterType((*MinGasPriceConfigResponse)(nil), "feemarket.feemarket.v1.MinGasPriceConfigResponse")
+	proto.RegisterType((*RevenueByMsgTypeRequest)(nil), "feemarket.feemarket.v1.RevenueByMsgTypeRequest")
+	proto.RegisterType((*RevenueByMsgTypeResponse)(nil), "feemarket.feemarket.v1.RevenueByMsgTypeResponse")
+	proto.RegisterType((*AccountFeeSpendRequest)(nil), "feemarket.feemarket.v1.AccountFeeSpendRequest")
+	proto.RegisterType((*AccountFeeSpendResponse)(nil), "feemarket.feemarket.v1.AccountFeeSpendResponse")
+	proto.RegisterType((*EIP1559EquivalentRequest)(nil), "feemarket.feemarket.v1.EIP1559EquivalentRequest")
+	proto.RegisterType((*EIP1559EquivalentResponse)(nil), "feemarket.feemarket.v1.EIP1559EquivalentResponse")
+	proto.RegisterType((*BaseGasPriceHistoryRequest)(nil), "feemarket.feemarket.v1.BaseGasPriceHistoryRequest")
+	proto.RegisterType((*BaseGasPriceHistoryResponse)(nil), "feemarket.feemarket.v1.BaseGasPriceHistoryResponse")
+	proto.RegisterType((*CommunityPoolContributionsRequest)(nil), "feemarket.feemarket.v1.CommunityPoolContributionsRequest")
+	proto.RegisterType((*CommunityPoolContributionsResponse)(nil), "feemarket.feemarket.v1.CommunityPoolContributionsResponse")
+	proto.RegisterType((*UtilizationRequest)(nil), "feemarket.feemarket.v1.UtilizationRequest")
+	proto.RegisterType((*UtilizationResponse)(nil), "feemarket.feemarket.v1.UtilizationResponse")
+	proto.RegisterType((*ProjectGasPriceRequest)(nil), "feemarket.feemarket.v1.ProjectGasPriceRequest")
+	proto.RegisterType((*ProjectGasPriceResponse)(nil), "feemarket.feemarket.v1.ProjectGasPriceResponse")
+	proto.RegisterType((*MarketInfoRequest)(nil), "feemarket.feemarket.v1.MarketInfoRequest")
+	proto.RegisterType((*MarketInfoResponse)(nil), "feemarket.feemarket.v1.MarketInfoResponse")
+}
+
+func init() {
+	proto.RegisterFile("feemarket/feemarket/v1/query.proto", fileDescriptor_d683b3b0d8494138)
+}
+
+var fileDescriptor_d683b3b0d8494138 = []byte{
+	// 1570 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x58, 0x4d, 0x6f, 0x1b, 0x45,
+	0x18, 0xce, 0x3a, 0x1f, 0x6d, 0xde, 0x34, 0x49, 0x33, 0x49, 0x53, 0x67, 0x93, 0x3a, 0xc9, 0x36,
+	0x4d, 0xdd, 0xb4, 0xf5, 0xd6, 0xa9, 0x2a, 0x51, 0x04, 0x42, 0x24, 0x69, 0x4b, 0x0a, 0x41, 0xc1,
+	0xe5, 0x43, 0xe2, 0xc0, 0x6a, 0xbd, 0x1e, 0x3b, 0x43, 0xbc, 0x3b, 0x9b, 0x9d, 0x75, 0x5a, 0x53,
+	0x55, 0x48, 0x45, 0x42, 0xe2, 0x86, 0xc4, 0x91, 0x0b, 0x20, 0x90, 0x10, 0xe2, 0x80, 0x04, 0xbf,
+	0xa0, 0x17, 0x2a, 0x4e, 0x15, 0x5c, 0x10, 0x87, 0x82, 0x5a, 0x24, 0xfe, 0x00, 0x3f, 0x00, 0xed,
+	0xcc, 0xac, 0x77, 0xfd, 0xb1, 0x89, 0x13, 0x95, 0x4b, 0xbb, 0x33, 0xf3, 0x7e, 0x3c, 0xf3, 0xcc,
+	0x3b, 0xef, 0x3c, 0x0e, 0x68, 0x65, 0x8c, 0x6d, 0xd3, 0xdb, 0xc6, 0xbe, 0x1e, 0x7d, 0xed, 0xe6,
+	0xf5, 0x9d, 0x1a, 0xf6, 0xea, 0x39, 0xd7, 0xa3, 0x3e, 0x45, 0x93, 0x8d, 0x95, 0x5c, 0xf4, 0xb5,
+	0x9b, 0x57, 0x27, 0x2a, 0xb4, 0x42, 0xb9, 0x89, 0x1e, 0x7c, 0x09, 0x6b, 0x75, 0xca, 0xa2, 0xcc,
+	0xa6, 0xcc, 0x10, 0x0b, 0x62, 0x20, 0x97, 0x66, 0x2a, 0x94, 0x56, 0xaa, 0x58, 0x37, 0x5d, 0xa2,
+	0x9b, 0x8e, 0x43, 0x7d, 0xd3, 0x27, 0xd4, 0x09, 0x57, 0x33, 0xc2, 0x56, 0x2f, 0x9a, 0x0c, 0xeb,
+	0xbb, 0xf9, 0x22, 0xf6, 0xcd, 0xbc, 0x6e, 0x51, 0xe2, 0xc8, 0xf5, 0x31, 0xd3, 0x26, 0x0e, 0xd5,
+	0xf9, 0xbf, 0x72, 0xea, 0x74, 0x02, 0x7a, 0xd7, 0xf4, 0x4c, 0x3b, 0x8c, 0xbb, 0x90, 0x60, 0x54,
+	0xc1, 0x0e, 0x66, 0x64, 0x3f, 0x2b, 0x0f, 0xef, 0x62, 0xa7, 0x86, 0xa5, 0x55, 0x36, 0xc1, 0x8a,
+	0x16, 0x19, 0xf6, 0x76, 0xf9, 0x76, 0x84, 0xa5, 0x36, 0x0a, 0xc3, 0x9b, 0x1c, 0x45, 0x01, 0xef,
+	0xd4, 0x30, 0xf3, 0xb5, 0xd7, 0x61, 0x24, 0x9c, 0x60, 0x2e, 0x75, 0x18, 0x46, 0x2f, 0xc0, 0x80,
+	0x00, 0x9a, 0x56, 0xe6, 0x94, 0xec, 0xd0, 0x72, 0x26, 0xd7, 0x99, 0xe8, 0x9c, 0xf0, 0x5b, 0xe9,
+	0x7b, 0xf8, 0x78, 0xb6, 0xa7, 0x20, 0x7d, 0xb4, 0x11, 0x38, 0x76, 0xcb, 0x37, 0x7d, 0x1c, 0xc6,
+	0xbf, 0x09, 0xc3, 0x72, 0x2c, 0xc3, 0x5f, 0x85, 0x7e, 0x16, 0x4c, 0xc8, 0xe8, 0xa7, 0x92, 0xa2,
+	0x73, 0x2f, 0x19, 0x5c, 0x78, 0x68, 0x67, 0x61, 0xf4, 0x86, 0xc9, 0x36, 0x3d, 0x62, 0x85, 0xe1,
+	0xd1, 0x04, 0xf4, 0x97, 0xb0, 0x43, 0x6d, 0x1e, 0x6d, 0xb0, 0x20, 0x06, 0x1a, 0x85, 0xe3, 0x91,
+	0xa1, 0xcc, 0xfb, 0x22, 0xf4, 0xbb, 0xc1, 0x84, 0xcc, 0x3b, 0x93, 0x93, 0x35, 0x10, 0x9c, 0x6b,
+	0x4e, 0x9e, 0x6b, 0x6e, 0x0d, 0x5b, 0xab, 0x94, 0x38, 0x2b, 0x83, 0x41, 0xda, 0x6f, 0xff, 0xf9,
+	0x61, 0x49, 0x29, 0x08, 0x2f, 0x34, 0x03, 0x83, 0xae, 0x87, 0x2d, 0xc2, 0x08, 0x75, 0xd2, 0xa9,
+	0x39, 0x25, 0x3b, 0x5c, 0x88, 0x26, 0xb4, 0xa5, 0x28, 0x61, 0xc8, 0x2c, 0x9a, 0x84, 0x01, 0x8e,
+	0x26, 0xe0, 0xb1, 0x37, 0x3b, 0x58, 0x90, 0x23, 0xed, 0x23, 0x05, 0xc6, 0x62, 0xc6, 0x12, 0x9e,
+	0x03, 0x03, 0x3c, 0x91, 0xb0, 0xde, 0x0f, 0xdf, 0x73, 0x01, 0xbe, 0xef, 0xfe, 0x9c, 0x3d, 0x5f,
+	0x21, 0xfe, 0x56, 0xad, 0x98, 0xb3, 0xa8, 0x2d, 0x6b, 0x5a, 0xfe, 0x77, 0x91, 0x95, 0xb6, 0x75,
+	0xbf, 0xee, 0x62, 0x16, 0xfa, 0x30, 0xb1, 0x1d, 0x99, 0x45, 0xbb, 0x04, 0xe9, 0x0d, 0xe2, 0x84,
+	0x38, 0x56, 0xa9, 0x53, 0x26, 0x95, 0xbd, 0x49, 0x5d, 0x87, 0xa9, 0x0e, 0x1e, 0x12, 0xfe, 0x05,
+	0x40, 0x36, 0x71, 0x88, 0x5d, 0xb3, 0x8d, 0x8a, 0xc9, 0x0c, 0x91, 0x44, 0xfa, 0x1f, 0x97, 0x2b,
+	0x8d, 0x4d, 0x6b, 0x53, 0x70, 0xb2, 0x20, 0x0a, 0x78, 0xa5, 0xbe, 0xc1, 0x2a, 0x6f, 0xd6, 0xdd,
+	0x46, 0xbd, 0xfc, 0xa2, 0x40, 0xba, 0x7d, 0x4d, 0x66, 0xb9, 0x0e, 0x47, 0x64, 0xe1, 0x4b, 0x96,
+	0x16, 0x93, 0xaa, 0xa7, 0xe1, 0x29, 0x22, 0x89, 0x32, 0x0a, 0x9d, 0x51, 0x19, 0xfa, 0x7d, 0xea,
+	0x9b, 0xd5, 0x74, 0x8a, 0x47, 0x99, 0xea, 0xc8, 0x35, 0x27, 0xfa, 0x8a, 0x24, 0x3a, 0xdb, 0x05,
+	0xd1, 0x31, 0x96, 0x45, 0x78, 0x6d, 0x19, 0x26, 0x5f, 0xb6, 0x2c, 0x5a, 0x73, 0xfc, 0xeb, 0x18,
+	0xdf, 0x72, 0xb1, 0x53, 0x0a, 0x29, 0x4e, 0xc3, 0x11, 0xb3, 0x54, 0xf2, 0x30, 0x0b, 0x49, 0x0a,
+	0x87, 0xda, 0x87, 0x70, 0xb2, 0xcd, 0x47, 0x6e, 0xbf, 0x04, 0x7d, 0x65, 0xdc, 0xa8, 0x90, 0x67,
+	0x8f, 0x9a, 0x47, 0xd7, 0x54, 0x48, 0x5f, 0x5b, 0xdf, 0xcc, 0x5f, 0xb9, 0x72, 0xf5, 0xda, 0x4e,
+	0x8d, 0xec, 0x9a, 0x55, 0xec, 0xf8, 0xe1, 0xe9, 0xfc, 0x98, 0x82, 0xa9, 0x0e, 0x8b, 0x12, 0x9f,
+	0x0b, 0xd3, 0x01, 0x16, 0xa3, 0x8c, 0xb1, 0x61, 0x6d, 0x99, 0x4e, 0x05, 0x1b, 0xbc, 0x74, 0x88,
+	0x63, 0xfa, 0xd4, 0x13, 0x1b, 0x5d, 0xc9, 0x07, 0xd8, 0xfe, 0x78, 0x3c, 0x3b, 0x2d, 0x90, 0xb0,
+	0xd2, 0x76, 0x8e, 0x50, 0xdd, 0x36, 0xfd, 0xad, 0xdc, 0x6b, 0xb8, 0x62, 0x5a, 0xf5, 0x35, 0x6c,
+	0xfd, 0xfa, 0xd3, 0x45, 0x90, 0x9b, 0x5b, 0xc3, 0x56, 0x21, 0x1d, 0x44, 0xbd, 0x8e, 0xf1, 0x2a,
+	0x8f, 0xb9, 0x16, 0x85, 0x44, 0x65, 0x38, 0x81, 0xab, 0x26, 0xf3, 0x89, 0x45, 0xfc, 0xba, 0x61,
+	0xd7, 0xaa, 0x3e, 0x71, 0xab, 0x04, 0x7b, 0xe9, 0xd4, 0x61, 0x73, 0x4d, 0x44, 0xf1, 0x36, 0x1a,
+	0xe1, 0x82, 0x1b, 0x81, 0xef, 0x98, 0x96, 0x9f, 0xee, 0x9d, 0x53, 0xb2, 0x47, 0x0b, 0x62, 0x80,
+	0x16, 0x61, 0xc4, 0x74, 0x5d, 0x8f, 0xde, 0x21, 0xb6, 0x78, 0x32, 0xd2, 0x7d, 0xfc, 0xa6, 0xb7,
+	0xcc, 0x6a, 0xcb, 0xa0, 0xae, 0x98, 0x0c, 0x87, 0xf5, 0xff, 0x0a, 0x61, 0x3e, 0xf5, 0xea, 0xb1,
+	0xdb, 0x56, 0x25, 0x36, 0xf1, 0x39, 0x3f, 0x7d, 0x05, 0x31, 0xd0, 0x08, 0x4c, 0x77, 0xf4, 0x91,
+	0x54, 0xdf, 0x84, 0x23, 0x5b, 0x62, 0x4a, 0x56, 0xc3, 0x52, 0xd2, 0x4d, 0x88, 0x47, 0x29, 0x60,
+	0x8b, 0x7a, 0xa5, 0xf0, 0x36, 0xc8, 0x00, 0xda, 0x69, 0x98, 0x5f, 0xa5, 0xb6, 0x5d, 0x73, 0x88,
+	0x5f, 0xdf, 0xa4, 0xb4, 0xba, 0x4a, 0x1d, 0xdf, 0x23, 0xc5, 0x1a, 0x07, 0x1f, 0x9e, 0xfc, 0xbf,
+	0x0a, 0x68, 0x7b, 0x59, 0x49, 0x5c, 0xef, 0xc1, 0xb0, 0x15, 0x5f, 0x90, 0xdd, 0x76, 0x39, 0x09,
+	0x5d, 0x72, 0x48, 0x89, 0xb2, 0x39, 0x5c, 0xd0, 0x26, 0x6f, 0x13, 0xa7, 0x44, 0x6f, 0xa7, 0x53,
+	0xff, 0x6f, 0x9b, 0x14, 0x59, 0xb4, 0x09, 0x40, 0x6f, 0xf9, 0xa4, 0x4a, 0x3e, 0xe0, 0x47, 0x19,
+	0x92, 0xf1, 0xa5, 0x02, 0xe3, 0x4d, 0xd3, 0x72, 0xf7, 0xb7, 0x60, 0xa8, 0x16, 0x4d, 0x1f, 0xbe,
+	0xe0, 0xe3, 0x51, 0xd0, 0x29, 0x00, 0x01, 0x26, 0xe8, 0xac, 0xbc, 0xb0, 0xfb, 0x0a, 0x83, 0x62,
+	0xe6, 0x86, 0xc9, 0x82, 0x67, 0x46, 0x32, 0xd2, 0xcb, 0x97, 0x42, 0xe4, 0x3b, 0x30, 0xb9, 0xe9,
+	0xd1, 0xf7, 0xb1, 0xe5, 0xb7, 0xbe, 0x99, 0x93, 0x30, 0x50, 0xac, 0x52, 0x6b, 0x9b, 0xc9, 0x8a,
+	0x93, 0x23, 0xf4, 0x12, 0x8c, 0x9b, 0x8c, 0xd5, 0x6c, 0x5c, 0x32, 0xe2, 0xbb, 0x10, 0x57, 0x69,
+	0xa4, 0x05, 0x22, 0x92, 0xa6, 0x31, 0x1a, 0x82, 0xde, 0x7d, 0xb2, 0x2d, 0xa7, 0xa4, 0xe6, 0x1d,
+	0x18, 0xe1, 0xbd, 0xa1, 0xf1, 0x3a, 0x1c, 0x9e, 0x9d, 0x63, 0xc5, 0x58, 0x49, 0xa3, 0xb7, 0x61,
+	0xb8, 0x8a, 0x4d, 0xcf, 0x21, 0x4e, 0xc5, 0xf0, 0x02, 0x5d, 0x71, 0xe8, 0xab, 0x7f, 0x2c, 0x8c,
+	0x53, 0x08, 0xc4, 0xc6, 0x38, 0x8c, 0x6d, 0xf0, 0x32, 0x5d, 0x77, 0xca, 0x34, 0x3c, 0xf8, 0x07,
+	0x29, 0x40, 0xf1, 0xd9, 0x67, 0x21, 0x99, 0x22, 0x45, 0x94, 0x3a, 0xa8, 0x22, 0x42, 0xaf, 0xc2,
+	0xb0, 0x4d, 0x9c, 0x18, 0xa9, 0xbd, 0x07, 0x13, 0x37, 0x43, 0x76, 0xf4, 0xa2, 0x07, 0xcd, 0xd4,
+	0xa2, 0x4e, 0x05, 0xb3, 0xe0, 0x30, 0xe3, 0xcd, 0xb4, 0xef, 0xd0, 0xcd, 0x34, 0x8a, 0x17, 0x35,
+	0xd3, 0xe5, 0x07, 0xa3, 0xd0, 0xff, 0x46, 0x20, 0xe4, 0x51, 0x0d, 0x06, 0x04, 0x23, 0xe8, 0xcc,
+	0xde, 0x8c, 0x49, 0xfe, 0xd5, 0xc5, 0xfd, 0xcc, 0xc4, 0x81, 0x68, 0x33, 0xf7, 0x7f, 0xfb, 0xfb,
+	0xb3, 0xd4, 0x24, 0x9a, 0xe8, 0x24, 0xc0, 0xd1, 0x0e, 0xf4, 0x73, 0x2e, 0xd1, 0xc2, 0x9e, 0x54,
+	0x87, 0x49, 0xcf, 0xec, 0x63, 0x25, 0x73, 0x4e, 0xf3, 0x9c, 0x27, 0xd0, 0x78, 0x73, 0x4e, 0x71,
+	0x50, 0x1f, 0x2b, 0x70, 0xb4, 0x41, 0xf4, 0xd9, 0xa4, 0x80, 0x2d, 0x37, 0x55, 0xcd, 0xee, 0x6f,
+	0x28, 0x93, 0x9f, 0xe5, 0xc9, 0xe7, 0xd1, 0x6c, 0xcb, 0x8f, 0x89, 0xb0, 0x30, 0xf4, 0xbb, 0xfc,
+	0x25, 0xbe, 0x87, 0xee, 0x2b, 0x30, 0x18, 0x7a, 0x33, 0xb4, 0x6f, 0x82, 0x06, 0xf3, 0xe7, 0xba,
+	0xb0, 0x94, 0x58, 0xe6, 0x38, 0x16, 0x15, 0xa5, 0x13, 0xb0, 0x30, 0xf4, 0xb5, 0x02, 0x63, 0x6d,
+	0x5a, 0x12, 0x5d, 0x4a, 0x14, 0x73, 0x09, 0x42, 0x55, 0xcd, 0x1f, 0xc0, 0x43, 0x82, 0x5b, 0xe2,
+	0xe0, 0x16, 0x90, 0xd6, 0x0c, 0xae, 0xe9, 0x16, 0x19, 0x96, 0x00, 0xf4, 0x85, 0x02, 0xc7, 0x5b,
+	0xb5, 0x28, 0xd2, 0x93, 0x72, 0x26, 0x28, 0x5a, 0xf5, 0x52, 0xf7, 0x0e, 0x12, 0xe3, 0x39, 0x8e,
+	0xf1, 0x34, 0x9a, 0xef, 0xf8, 0x9b, 0xcf, 0x28, 0xd6, 0x0d, 0x9b, 0x55, 0x8c, 0xe0, 0xd9, 0x42,
+	0xdf, 0x28, 0x30, 0xda, 0x22, 0x17, 0x51, 0x2e, 0x29, 0x61, 0x67, 0x2d, 0xaa, 0xea, 0x5d, 0xdb,
+	0x4b, 0x7c, 0x79, 0x8e, 0xef, 0x3c, 0x3a, 0xd7, 0x8c, 0xcf, 0x14, 0xe6, 0x5c, 0xfe, 0xb1, 0xc0,
+	0x41, 0xbf, 0x2b, 0x45, 0xed, 0x3d, 0xf4, 0x95, 0x02, 0x63, 0x6d, 0xc2, 0x31, 0xf9, 0xc4, 0x93,
+	0x04, 0xa8, 0x9a, 0x3f, 0x80, 0x87, 0x44, 0x9b, 0xe5, 0x68, 0x35, 0x34, 0xd7, 0x8c, 0x16, 0x13,
+	0x37, 0x70, 0x30, 0x70, 0x04, 0xe7, 0x7b, 0x05, 0xc6, 0x3b, 0x88, 0x2e, 0xb4, 0xdc, 0x8d, 0xb6,
+	0x6a, 0x56, 0x75, 0xea, 0xe5, 0x03, 0xf9, 0x48, 0xa8, 0x17, 0x38, 0xd4, 0x45, 0xb4, 0xd0, 0x0c,
+	0xb5, 0xf9, 0xe1, 0x34, 0xa4, 0x6e, 0x43, 0x3f, 0x2b, 0xa0, 0x26, 0xeb, 0x27, 0x74, 0xf5, 0xe0,
+	0x9a, 0x2b, 0x04, 0xff, 0xfc, 0x61, 0x5c, 0xe5, 0x1e, 0x96, 0xf9, 0x1e, 0x2e, 0xa0, 0xa5, 0xe6,
+	0x3d, 0x58, 0xa1, 0xa7, 0xe1, 0x52, 0x5a, 0x35, 0x9a, 0x55, 0xdd, 0x27, 0x0a, 0x0c, 0xc5, 0x84,
+	0x04, 0x4a, 0x14, 0xb3, 0xed, 0x5a, 0x4c, 0x3d, 0xdf, 0x95, 0xad, 0x04, 0x37, 0xcf, 0xc1, 0x4d,
+	0xa3, 0xa9, 0x66, 0x70, 0x71, 0xb9, 0xf5, 0xb9, 0x02, 0xa3, 0x2d, 0x22, 0x26, 0xf9, 0x46, 0x75,
+	0x56, 0x58, 0xaa, 0xde, 0xb5, 0xfd, 0xde, 0xed, 0xdb, 0x15, 0xe6, 0xd1, 0xd9, 0x07, 0xef, 0x08,
+	0x44, 0x02, 0x04, 0x25, 0x76, 0xe5, 0x36, 0xe9, 0xa2, 0x2e, 0x75, 0x63, 0xba, 0x37, 0x4d, 0xe2,
+	0xcb, 0x20, 0x4e, 0x99, 0xae, 0xac, 0x3f, 0x7c, 0x92, 0x51, 0x1e, 0x3d, 0xc9, 0x28, 0x7f, 0x3d,
+	0xc9, 0x28, 0x9f, 0x3e, 0xcd, 0xf4, 0x3c, 0x7a, 0x9a, 0xe9, 0xf9, 0xfd, 0x69, 0xa6, 0xe7, 0x5d,
+	0x3d, 0x26, 0xb6, 0xd9, 0x36, 0x71, 0x2f, 0xda, 0x78, 0x37, 0x16, 0xe7, 0x4e, 0xec, 0x9b, 0x2b,
+	0xef, 0xe2, 0x00, 0xff, 0xd3, 0xd4, 0xe5, 0xff, 0x06, 0x00, 0xb3, 0x17, 0xb5, 0x1c, 0xf5, 0x13,
+	0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Params returns the current feemarket module parameters.
+	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// State returns the current feemarket module state.
+	State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error)
+	// GasPrice returns the current feemarket module gas price
+	// for specified denom.
+	GasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error)
+	// GasPrices returns the current feemarket module list of gas prices
+	// in all available denoms.
+	GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error)
+	// MinGasPriceConfig returns the current minimum gas price formatted as a
+	// cosmos-sdk minimum-gas-prices string that can be used in a node's config.
+	MinGasPriceConfig(ctx context.Context, in *MinGasPriceConfigRequest, opts ...grpc.CallOption) (*MinGasPriceConfigResponse, error)
+	// RevenueByMsgType returns the fee revenue collected over the window,
+	// attributed to the primary message type of each transaction.
+	RevenueByMsgType(ctx context.Context, in *RevenueByMsgTypeRequest, opts ...grpc.CallOption) (*RevenueByMsgTypeResponse, error)
+	// AccountFeeSpend returns the fees paid by an account over the account fee
+	// spend window.
+	AccountFeeSpend(ctx context.Context, in *AccountFeeSpendRequest, opts ...grpc.CallOption) (*AccountFeeSpendResponse, error)
+	// EIP1559Equivalent returns the current parameters translated into their
+	// EIP-1559 equivalents.
+	EIP1559Equivalent(ctx context.Context, in *EIP1559EquivalentRequest, opts ...grpc.CallOption) (*EIP1559EquivalentResponse, error)
+	// BaseGasPriceHistory returns the base gas prices of the most recent blocks
+	// retained by the base gas price history, ordered by height.
+	BaseGasPriceHistory(ctx context.Context, in *BaseGasPriceHistoryRequest, opts ...grpc.CallOption) (*BaseGasPriceHistoryResponse, error)
+	// CommunityPoolContributions returns the share of the fees distributed by the
+	// fee market that was allocated to the community pool, cumulatively and over
+	// the window.
+	CommunityPoolContributions(ctx context.Context, in *CommunityPoolContributionsRequest, opts ...grpc.CallOption) (*CommunityPoolContributionsResponse, error)
+	// Utilization returns how full the blocks of the window have been, as the
+	// ratio of the gas used over the window to the window's capacity.
+	Utilization(ctx context.Context, in *UtilizationRequest, opts ...grpc.CallOption) (*UtilizationResponse, error)
+	// ProjectGasPrice returns the base gas price and learning rate projected a
+	// number of blocks into the future, assuming every block has the same
+	// utilization.
+	ProjectGasPrice(ctx context.Context, in *ProjectGasPriceRequest, opts ...grpc.CallOption) (*ProjectGasPriceResponse, error)
+	// MarketInfo returns the current feemarket module parameters and state along
+	// with the current minimum gas price in the fee denom, so that a fee quote
+	// can be built from a single request.
+	MarketInfo(ctx context.Context, in *MarketInfoRequest, opts ...grpc.CallOption) (*MarketInfoResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error) {
+	out := new(ParamsResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) State(ctx context.Context, in *StateRequest, opts ...grpc.CallOption) (*StateResponse, error) {
+	out := new(StateResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/State", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasPrice(ctx context.Context, in *GasPriceRequest, opts ...grpc.CallOption) (*GasPriceResponse, error) {
+	out := new(GasPriceResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/GasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GasPrices(ctx context.Context, in *GasPricesRequest, opts ...grpc.CallOption) (*GasPricesResponse, error) {
+	out := new(GasPricesResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/GasPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MinGasPriceConfig(ctx context.Context, in *MinGasPriceConfigRequest, opts ...grpc.CallOption) (*MinGasPriceConfigResponse, error) {
+	out := new(MinGasPriceConfigResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/MinGasPriceConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RevenueByMsgType(ctx context.Context, in *RevenueByMsgTypeRequest, opts ...grpc.CallOption) (*RevenueByMsgTypeResponse, error) {
+	out := new(RevenueByMsgTypeResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/RevenueByMsgType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AccountFeeSpend(ctx context.Context, in *AccountFeeSpendRequest, opts ...grpc.CallOption) (*AccountFeeSpendResponse, error) {
+	out := new(AccountFeeSpendResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/AccountFeeSpend", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EIP1559Equivalent(ctx context.Context, in *EIP1559EquivalentRequest, opts ...grpc.CallOption) (*EIP1559EquivalentResponse, error) {
+	out := new(EIP1559EquivalentResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/EIP1559Equivalent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BaseGasPriceHistory(ctx context.Context, in *BaseGasPriceHistoryRequest, opts ...grpc.CallOption) (*BaseGasPriceHistoryResponse, error) {
+	out := new(BaseGasPriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/BaseGasPriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CommunityPoolContributions(ctx context.Context, in *CommunityPoolContributionsRequest, opts ...grpc.CallOption) (*CommunityPoolContributionsResponse, error) {
+	out := new(CommunityPoolContributionsResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/CommunityPoolContributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Utilization(ctx context.Context, in *UtilizationRequest, opts ...grpc.CallOption) (*UtilizationResponse, error) {
+	out := new(UtilizationResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/Utilization", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectGasPrice(ctx context.Context, in *ProjectGasPriceRequest, opts ...grpc.CallOption) (*ProjectGasPriceResponse, error) {
+	out := new(ProjectGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/ProjectGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MarketInfo(ctx context.Context, in *MarketInfoRequest, opts ...grpc.CallOption) (*MarketInfoResponse, error) {
+	out := new(MarketInfoResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Query/MarketInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Params returns the current feemarket module parameters.
+	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// State returns the current feemarket module state.
+	State(context.Context, *StateRequest) (*StateResponse, error)
+	// GasPrice returns the current feemarket module gas price
+	// for specified denom.
+	GasPrice(context.Context, *GasPriceRequest) (*GasPriceResponse, error)
+	// GasPrices returns the current feemarket module list of gas prices
+	// in all available denoms.
+	GasPrices(context.Context, *GasPricesRequest) (*GasPricesResponse, error)
+	// MinGasPriceConfig returns the current minimum gas price formatted as a
+	// cosmos-sdk minimum-gas-prices string that can be used in a node's config.
+	MinGasPriceConfig(context.Context, *MinGasPriceConfigRequest) (*MinGasPriceConfigResponse, error)
+	// RevenueByMsgType returns the fee revenue collected over the window,
+	// attributed to the primary message type of each transaction.
+	RevenueByMsgType(context.Context, *RevenueByMsgTypeRequest) (*RevenueByMsgTypeResponse, error)
+	// AccountFeeSpend returns the fees paid by an account over the account fee
+	// spend window.
+	AccountFeeSpend(context.Context, *AccountFeeSpendRequest) (*AccountFeeSpendResponse, error)
+	// EIP1559Equivalent returns the current parameters translated into their
+	// EIP-1559 equivalents.
+	EIP1559Equivalent(context.Context, *EIP1559EquivalentRequest) (*EIP1559EquivalentResponse, error)
+	// BaseGasPriceHistory returns the base gas prices of the most recent blocks
+	// retained by the base gas price history, ordered by height.
+	BaseGasPriceHistory(context.Context, *BaseGasPriceHistoryRequest) (*BaseGasPriceHistoryResponse, error)
+	// CommunityPoolContributions returns the share of the fees distributed by the
+	// fee market that was allocated to the community pool, cumulatively and over
+	// the window.
+	CommunityPoolContributions(context.Context, *CommunityPoolContributionsRequest) (*CommunityPoolContributionsResponse, error)
+	// Utilization returns how full the blocks of the window have been, as the
+	// ratio of the gas used over the window to the window's capacity.
+	Utilization(context.Context, *UtilizationRequest) (*UtilizationResponse, error)
+	// ProjectGasPrice returns the base gas price and learning rate projected a
+	// number of blocks into the future, assuming every block has the same
+	// utilization.
+	ProjectGasPrice(context.Context, *ProjectGasPriceRequest) (*ProjectGasPriceResponse, error)
+	// MarketInfo returns the current feemarket module parameters and state along
+	// with the current minimum gas price in the fee denom, so that a fee quote
+	// can be built from a single request.
+	MarketInfo(context.Context, *MarketInfoRequest) (*MarketInfoResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) State(ctx context.Context, req *StateRequest) (*StateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method State not implemented")
+}
+func (*UnimplementedQueryServer) GasPrice(ctx context.Context, req *GasPriceRequest) (*GasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrice not implemented")
+}
+func (*UnimplementedQueryServer) GasPrices(ctx context.Context, req *GasPricesRequest) (*GasPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GasPrices not implemented")
+}
+func (*UnimplementedQueryServer) MinGasPriceConfig(ctx context.Context, req *MinGasPriceConfigRequest) (*MinGasPriceConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MinGasPriceConfig not implemented")
+}
+func (*UnimplementedQueryServer) RevenueByMsgType(ctx context.Context, req *RevenueByMsgTypeRequest) (*RevenueByMsgTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevenueByMsgType not implemented")
+}
+func (*UnimplementedQueryServer) AccountFeeSpend(ctx context.Context, req *AccountFeeSpendRequest) (*AccountFeeSpendResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AccountFeeSpend not implemented")
+}
+func (*UnimplementedQueryServer) EIP1559Equivalent(ctx context.Context, req *EIP1559EquivalentRequest) (*EIP1559EquivalentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EIP1559Equivalent not implemented")
+}
+func (*UnimplementedQueryServer) BaseGasPriceHistory(ctx context.Context, req *BaseGasPriceHistoryRequest) (*BaseGasPriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseGasPriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) CommunityPoolContributions(ctx context.Context, req *CommunityPoolContributionsRequest) (*CommunityPoolContributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CommunityPoolContributions not implemented")
+}
+func (*UnimplementedQueryServer) Utilization(ctx context.Context, req *UtilizationRequest) (*UtilizationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Utilization not implemented")
+}
+func (*UnimplementedQueryServer) ProjectGasPrice(ctx context.Context, req *ProjectGasPriceRequest) (*ProjectGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectGasPrice not implemented")
+}
+func (*UnimplementedQueryServer) MarketInfo(ctx context.Context, req *MarketInfoRequest) (*MarketInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarketInfo not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*ParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_State_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(StateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).State(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/State",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).State(ctx, req.(*StateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/GasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPrice(ctx, req.(*GasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GasPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GasPricesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GasPrices(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/GasPrices",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GasPrices(ctx, req.(*GasPricesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MinGasPriceConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MinGasPriceConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MinGasPriceConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/MinGasPriceConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MinGasPriceConfig(ctx, req.(*MinGasPriceConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RevenueByMsgType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevenueByMsgTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RevenueByMsgType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/RevenueByMsgType",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RevenueByMsgType(ctx, req.(*RevenueByMsgTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AccountFeeSpend_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AccountFeeSpendRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AccountFeeSpend(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/AccountFeeSpend",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AccountFeeSpend(ctx, req.(*AccountFeeSpendRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EIP1559Equivalent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(EIP1559EquivalentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EIP1559Equivalent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/EIP1559Equivalent",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EIP1559Equivalent(ctx, req.(*EIP1559EquivalentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BaseGasPriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BaseGasPriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseGasPriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/BaseGasPriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseGasPriceHistory(ctx, req.(*BaseGasPriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CommunityPoolContributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CommunityPoolContributionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CommunityPoolContributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/CommunityPoolContributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CommunityPoolContributions(ctx, req.(*CommunityPoolContributionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Utilization_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UtilizationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Utilization(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/Utilization",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Utilization(ctx, req.(*UtilizationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProjectGasPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/ProjectGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectGasPrice(ctx, req.(*ProjectGasPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MarketInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarketInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MarketInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Query/MarketInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MarketInfo(ctx, req.(*MarketInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "feemarket.feemarket.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "State",
+			Handler:    _Query_State_Handler,
+		},
+		{
+			MethodName: "GasPrice",
+			Handler:    _Query_GasPrice_Handler,
+		},
+		{
+			MethodName: "GasPrices",
+			Handler:    _Query_GasPrices_Handler,
+		},
+		{
+			MethodName: "MinGasPriceConfig",
+			Handler:    _Query_MinGasPriceConfig_Handler,
+		},
+		{
+			MethodName: "RevenueByMsgType",
+			Handler:    _Query_RevenueByMsgType_Handler,
+		},
+		{
+			MethodName: "AccountFeeSpend",
+			Handler:    _Query_AccountFeeSpend_Handler,
+		},
+		{
+			MethodName: "EIP1559Equivalent",
+			Handler:    _Query_EIP1559Equivalent_Handler,
+		},
+		{
+			MethodName: "BaseGasPriceHistory",
+			Handler:    _Query_BaseGasPriceHistory_Handler,
+		},
+		{
+			MethodName: "CommunityPoolContributions",
+			Handler:    _Query_CommunityPoolContributions_Handler,
+		},
+		{
+			MethodName: "Utilization",
+			Handler:    _Query_Utilization_Handler,
+		},
+		{
+			MethodName: "ProjectGasPrice",
+			Handler:    _Query_ProjectGasPrice_Handler,
+		},
+		{
+			MethodName: "MarketInfo",
+			Handler:    _Query_MarketInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feemarket/feemarket/v1/query.proto",
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *ParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *StateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Precision != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Precision))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Price.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GasPricesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GasPricesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GasPricesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
 		for iNdEx := len(m.Prices) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Prices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinGasPriceConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinGasPriceConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinGasPriceConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinGasPriceConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinGasPriceConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinGasPriceConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MinimumGasPrices) > 0 {
+		i -= len(m.MinimumGasPrices)
+		copy(dAtA[i:], m.MinimumGasPrices)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MinimumGasPrices)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RevenueByMsgTypeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevenueByMsgTypeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevenueByMsgTypeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RevenueByMsgTypeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RevenueByMsgTypeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RevenueByMsgTypeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Revenue) > 0 {
+		for iNdEx := len(m.Revenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountFeeSpendRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountFeeSpendRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountFeeSpendRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountFeeSpendResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountFeeSpendResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountFeeSpendResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EIP1559EquivalentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EIP1559EquivalentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EIP1559EquivalentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *EIP1559EquivalentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EIP1559EquivalentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EIP1559EquivalentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Approximations) > 0 {
+		for iNdEx := len(m.Approximations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approximations[iNdEx])
+			copy(dAtA[i:], m.Approximations[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Approximations[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Exact {
+		i--
+		if m.Exact {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.ElasticityMultiplier.Size()
+		i -= size
+		if _, err := m.ElasticityMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseFeeChangeDenominator.Size()
+		i -= size
+		if _, err := m.BaseFeeChangeDenominator.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseGasPriceHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseGasPriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseGasPriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BaseGasPriceHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BaseGasPriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BaseGasPriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for iNdEx := len(m.History) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.History[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolContributionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolContributionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolContributionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolContributionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolContributionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolContributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Window) > 0 {
+		for iNdEx := len(m.Window) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Window[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Contributions.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *UtilizationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UtilizationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UtilizationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *UtilizationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UtilizationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UtilizationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Window != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowGas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowGas))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Utilization.Size()
+		i -= size
+		if _, err := m.Utilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectGasPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectGasPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectGasPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AssumedUtilization) > 0 {
+		i -= len(m.AssumedUtilization)
+		copy(dAtA[i:], m.AssumedUtilization)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AssumedUtilization)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ProjectGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ProjectGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ProjectGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.LearningRate.Size()
+		i -= size
+		if _, err := m.LearningRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MarketInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MarketInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MarketInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CongestionMultiplier.Size()
+		i -= size
+		if _, err := m.CongestionMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MinGasPrice.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *StateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *StateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *GasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *GasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Precision != 0 {
+		n += 1 + sovQuery(uint64(m.Precision))
+	}
+	return n
+}
+
+func (m *GasPricesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GasPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MinGasPriceConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MinGasPriceConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MinimumGasPrices)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RevenueByMsgTypeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RevenueByMsgTypeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Revenue) > 0 {
+		for _, e := range m.Revenue {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountFeeSpendRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *AccountFeeSpendResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EIP1559EquivalentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *EIP1559EquivalentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFeeChangeDenominator.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ElasticityMultiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Exact {
+		n += 2
+	}
+	if len(m.Approximations) > 0 {
+		for _, s := range m.Approximations {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *BaseGasPriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *BaseGasPriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.History) > 0 {
+		for _, e := range m.History {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityPoolContributionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *CommunityPoolContributionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Contributions.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.Window) > 0 {
+		for _, e := range m.Window {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *UtilizationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *UtilizationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Utilization.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.WindowGas != 0 {
+		n += 1 + sovQuery(uint64(m.WindowGas))
+	}
+	if m.Window != 0 {
+		n += 1 + sovQuery(uint64(m.Window))
+	}
+	return n
+}
+
+func (m *ProjectGasPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Blocks != 0 {
+		n += 1 + sovQuery(uint64(m.Blocks))
+	}
+	l = len(m.AssumedUtilization)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *ProjectGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.LearningRate.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *MarketInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MarketInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.State.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CongestionMultiplier.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *StateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: StateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: StateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Precision", wireType)
+			}
+			m.Precision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Precision |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasPricesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prices = append(m.Prices, types.DecCoin{})
+			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinGasPriceConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinGasPriceConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinGasPriceConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinGasPriceConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinGasPriceConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinGasPriceConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinimumGasPrices", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinimumGasPrices = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevenueByMsgTypeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevenueByMsgTypeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevenueByMsgTypeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RevenueByMsgTypeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RevenueByMsgTypeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RevenueByMsgTypeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revenue = append(m.Revenue, MsgTypeRevenue{})
+			if err := m.Revenue[len(m.Revenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountFeeSpendRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountFeeSpendRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountFeeSpendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountFeeSpendResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountFeeSpendResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountFeeSpendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EIP1559EquivalentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EIP1559EquivalentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EIP1559EquivalentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EIP1559EquivalentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EIP1559EquivalentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EIP1559EquivalentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeChangeDenominator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFeeChangeDenominator.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ElasticityMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ElasticityMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Exact", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Exact = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approximations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
 			}
-			i--
-			dAtA[i] = 0xa
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approximations = append(m.Approximations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func (m *StateRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *StateResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.State.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *GasPriceRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *GasPriceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *BaseGasPriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseGasPriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseGasPriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	l = m.Price.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
 
-func (m *GasPricesRequest) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *GasPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+func (m *BaseGasPriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BaseGasPriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BaseGasPriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field History", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.History = append(m.History, BaseGasPriceRecord{})
+			if err := m.History[len(m.History)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *CommunityPoolContributionsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -989,10 +4751,10 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommunityPoolContributionsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommunityPoolContributionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1016,7 +4778,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *CommunityPoolContributionsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1039,15 +4801,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: CommunityPoolContributionsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: CommunityPoolContributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Contributions", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1074,7 +4836,41 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Contributions.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Window = append(m.Window, types.DecCoin{})
+			if err := m.Window[len(m.Window)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1099,7 +4895,7 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StateRequest) Unmarshal(dAtA []byte) error {
+func (m *UtilizationRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1122,10 +4918,10 @@ func (m *StateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: UtilizationRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UtilizationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1149,7 +4945,7 @@ func (m *StateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *StateResponse) Unmarshal(dAtA []byte) error {
+func (m *UtilizationResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1172,17 +4968,17 @@ func (m *StateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: StateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: UtilizationResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: StateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: UtilizationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Utilization", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1192,25 +4988,64 @@ func (m *StateResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Utilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowGas", wireType)
+			}
+			m.WindowGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowGas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1232,7 +5067,7 @@ func (m *StateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
+func (m *ProjectGasPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1255,15 +5090,34 @@ func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPriceRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectGasPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectGasPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssumedUtilization", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1291,7 +5145,7 @@ func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.AssumedUtilization = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1314,7 +5168,7 @@ func (m *GasPriceRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *ProjectGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1337,17 +5191,17 @@ func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: ProjectGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ProjectGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1357,22 +5211,57 @@ func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LearningRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LearningRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1397,7 +5286,7 @@ func (m *GasPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
+func (m *MarketInfoRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1420,10 +5309,10 @@ func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPricesRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketInfoRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPricesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1447,7 +5336,7 @@ func (m *GasPricesRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *MarketInfoResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1470,15 +5359,15 @@ func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MarketInfoResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MarketInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Prices", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1505,8 +5394,107 @@ func (m *GasPricesResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Prices = append(m.Prices, types.DecCoin{})
-			if err := m.Prices[len(m.Prices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CongestionMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CongestionMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,1140 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: feemarket/feemarket/v1/report.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Report is a snapshot of the fee market at a height, consolidating the params,
+// state, derived metrics, revenue and health score for audits.
+type Report struct {
+	// Height is the height the report was produced at.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Params are the fee market params at the height.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// State is the fee market state at the height.
+	State State `protobuf:"bytes,3,opt,name=state,proto3" json:"state"`
+	// BaseGasPrice is the base gas price in the fee denom.
+	BaseGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=base_gas_price,json=baseGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_gas_price"`
+	// CongestionMultiplier is the base gas price as a multiple of the min base
+	// gas price. It is zero if the min base gas price is zero.
+	CongestionMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,5,opt,name=congestion_multiplier,json=congestionMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"congestion_multiplier"`
+	// WarmupFactor is the factor scaling the learning rate adjustment at the
+	// height.
+	WarmupFactor cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=warmup_factor,json=warmupFactor,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"warmup_factor"`
+	// AverageUtilization is the average utilization of the blocks in the window.
+	AverageUtilization cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=average_utilization,json=averageUtilization,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"average_utilization"`
+	// MinGasPrices are the min gas prices in every accepted denom, sorted by
+	// denom.
+	MinGasPrices github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,8,rep,name=min_gas_prices,json=minGasPrices,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"min_gas_prices"`
+	// Revenue is the fee revenue recorded over the window, by primary message
+	// type and sorted by message type URL.
+	Revenue []MsgTypeRevenue `protobuf:"bytes,9,rep,name=revenue,proto3" json:"revenue"`
+	// TotalRevenue is the total fee revenue recorded over the window.
+	TotalRevenue github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,10,rep,name=total_revenue,json=totalRevenue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_revenue"`
+	// HealthScore is the overall health score in [0, 100].
+	HealthScore uint32 `protobuf:"varint,11,opt,name=health_score,json=healthScore,proto3" json:"health_score,omitempty"`
+	// HealthComponents are the health score components, sorted by name.
+	HealthComponents []HealthComponent `protobuf:"bytes,12,rep,name=health_components,json=healthComponents,proto3" json:"health_components"`
+}
+
+func (m *Report) Reset()         { *m = Report{} }
+func (m *Report) String() string { return proto.CompactTextString(m) }
+func (*Report) ProtoMessage()    {}
+func (*Report) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bab3002839ff743, []int{0}
+}
+func (m *Report) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Report) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Report.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Report) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Report.Merge(m, src)
+}
+func (m *Report) XXX_Size() int {
+	return m.Size()
+}
+func (m *Report) XXX_DiscardUnknown() {
+	xxx_messageInfo_Report.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Report proto.InternalMessageInfo
+
+func (m *Report) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Report) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *Report) GetState() State {
+	if m != nil {
+		return m.State
+	}
+	return State{}
+}
+
+func (m *Report) GetMinGasPrices() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.MinGasPrices
+	}
+	return nil
+}
+
+func (m *Report) GetRevenue() []MsgTypeRevenue {
+	if m != nil {
+		return m.Revenue
+	}
+	return nil
+}
+
+func (m *Report) GetTotalRevenue() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.TotalRevenue
+	}
+	return nil
+}
+
+func (m *Report) GetHealthScore() uint32 {
+	if m != nil {
+		return m.HealthScore
+	}
+	return 0
+}
+
+func (m *Report) GetHealthComponents() []HealthComponent {
+	if m != nil {
+		return m.HealthComponents
+	}
+	return nil
+}
+
+// HealthComponent is the score of a single component of the health score.
+type HealthComponent struct {
+	// Name is the name of the component.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Score is the score of the component in [0, 100].
+	Score uint32 `protobuf:"varint,2,opt,name=score,proto3" json:"score,omitempty"`
+}
+
+func (m *HealthComponent) Reset()         { *m = HealthComponent{} }
+func (m *HealthComponent) String() string { return proto.CompactTextString(m) }
+func (*HealthComponent) ProtoMessage()    {}
+func (*HealthComponent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6bab3002839ff743, []int{1}
+}
+func (m *HealthComponent) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HealthComponent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HealthComponent.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HealthComponent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HealthComponent.Merge(m, src)
+}
+func (m *HealthComponent) XXX_Size() int {
+	return m.Size()
+}
+func (m *HealthComponent) XXX_DiscardUnknown() {
+	xxx_messageInfo_HealthComponent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HealthComponent proto.InternalMessageInfo
+
+func (m *HealthComponent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *HealthComponent) GetScore() uint32 {
+	if m != nil {
+		return m.Score
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Report)(nil), "feemarket.feemarket.v1.Report")
+	proto.RegisterType((*HealthComponent)(nil), "feemarket.feemarket.v1.HealthComponent")
+}
+
+func init() {
+	proto.RegisterFile("feemarket/feemarket/v1/report.proto", fileDescriptor_6bab3002839ff743)
+}
+
+var fileDescriptor_6bab3002839ff743 = []byte{
+	// 651 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0x4d, 0x4f, 0x13, 0x41,
+	0x18, 0xee, 0xf2, 0x51, 0x64, 0x5a, 0x50, 0x46, 0x24, 0x0b, 0xea, 0x52, 0xd1, 0x68, 0xa3, 0x61,
+	0x37, 0xc5, 0x98, 0x68, 0xf4, 0x54, 0x08, 0x6a, 0x22, 0x09, 0x59, 0xfc, 0x48, 0xb8, 0x6c, 0xa6,
+	0xcb, 0xcb, 0xee, 0xa4, 0x9d, 0x9d, 0xcd, 0xce, 0xb4, 0x8a, 0xf1, 0x47, 0xf8, 0x33, 0x8c, 0x27,
+	0x0f, 0xfe, 0x08, 0x8e, 0x84, 0x93, 0xf1, 0x80, 0x06, 0x0e, 0xfe, 0x0d, 0xb3, 0x33, 0xb3, 0xb4,
+	0x1a, 0x1a, 0x0d, 0x97, 0xf6, 0x9d, 0x99, 0xe7, 0x79, 0xe6, 0x7d, 0x9e, 0x7d, 0x77, 0xd1, 0xcd,
+	0x5d, 0x00, 0x46, 0xb2, 0x36, 0x48, 0xaf, 0x5f, 0xf5, 0x1a, 0x5e, 0x06, 0x29, 0xcf, 0xa4, 0x9b,
+	0x66, 0x5c, 0x72, 0x3c, 0x77, 0x7a, 0xe4, 0xf6, 0xab, 0x5e, 0x63, 0x61, 0x36, 0xe2, 0x11, 0x57,
+	0x10, 0x2f, 0xaf, 0x34, 0x7a, 0x61, 0x86, 0x30, 0x9a, 0x70, 0x4f, 0xfd, 0x9a, 0xad, 0xf9, 0x90,
+	0x0b, 0xc6, 0x45, 0xa0, 0xb1, 0x7a, 0x61, 0x8e, 0x1c, 0xbd, 0xf2, 0x5a, 0x44, 0x80, 0xd7, 0x6b,
+	0xb4, 0x40, 0x92, 0x86, 0x17, 0x72, 0x9a, 0x98, 0xf3, 0x5b, 0x43, 0x1a, 0x8c, 0x20, 0x01, 0x41,
+	0x0b, 0x95, 0x61, 0x36, 0x52, 0x92, 0x11, 0x26, 0xfe, 0x21, 0x95, 0x41, 0x0f, 0x92, 0x2e, 0x68,
+	0xd4, 0xd2, 0xe1, 0x04, 0x2a, 0xfb, 0xca, 0x3d, 0x9e, 0x43, 0xe5, 0x18, 0x68, 0x14, 0x4b, 0xdb,
+	0xaa, 0x59, 0xf5, 0x51, 0xdf, 0xac, 0xf0, 0x13, 0x54, 0xd6, 0xc2, 0xf6, 0x48, 0xcd, 0xaa, 0x57,
+	0x56, 0x1c, 0xf7, 0xec, 0x80, 0xdc, 0x4d, 0x85, 0x6a, 0x8e, 0xed, 0x1f, 0x2d, 0x96, 0x7c, 0xc3,
+	0xc1, 0x8f, 0xd0, 0xb8, 0x90, 0x44, 0x82, 0x3d, 0xaa, 0xc8, 0xd7, 0x87, 0x91, 0xb7, 0x72, 0x90,
+	0xe1, 0x6a, 0x06, 0x7e, 0x83, 0xa6, 0xf3, 0x9c, 0x82, 0x88, 0xe4, 0x59, 0xd2, 0x10, 0xec, 0xb1,
+	0x9a, 0x55, 0x9f, 0x6c, 0x36, 0x72, 0xd0, 0xf7, 0xa3, 0xc5, 0xab, 0x3a, 0x4c, 0xb1, 0xd3, 0x76,
+	0x29, 0xf7, 0x18, 0x91, 0xb1, 0xfb, 0x02, 0x22, 0x12, 0xee, 0xad, 0x41, 0x78, 0xf8, 0x75, 0x19,
+	0x99, 0xe4, 0xd7, 0x20, 0xf4, 0xab, 0xb9, 0xd0, 0x53, 0x22, 0x36, 0x73, 0x19, 0xbc, 0x8b, 0xae,
+	0x84, 0x3c, 0x89, 0x40, 0x48, 0xca, 0x93, 0x80, 0x75, 0x3b, 0x92, 0xa6, 0x1d, 0x0a, 0x99, 0x3d,
+	0x7e, 0x5e, 0xfd, 0xd9, 0xbe, 0xde, 0xc6, 0xa9, 0x1c, 0x7e, 0x8d, 0xa6, 0xde, 0x92, 0x8c, 0x75,
+	0xd3, 0x60, 0x97, 0x84, 0x92, 0x67, 0x76, 0xf9, 0xdc, 0xfd, 0x6b, 0x9d, 0x75, 0x25, 0x83, 0x5b,
+	0xe8, 0x32, 0xe9, 0x41, 0x46, 0x22, 0x08, 0xba, 0x92, 0x76, 0xe8, 0x7b, 0x92, 0x5f, 0x6c, 0x4f,
+	0x9c, 0x57, 0x1d, 0x1b, 0xb5, 0x57, 0x7d, 0x31, 0xfc, 0x01, 0x4d, 0x33, 0x9a, 0xf4, 0xb3, 0x17,
+	0xf6, 0x85, 0xda, 0x68, 0xbd, 0xb2, 0x72, 0xcd, 0x35, 0xc4, 0x3c, 0x51, 0xd7, 0x8c, 0x70, 0xae,
+	0xb2, 0xca, 0x69, 0xd2, 0x7c, 0x98, 0x5f, 0xfe, 0xf9, 0xc7, 0xe2, 0xbd, 0x88, 0xca, 0xb8, 0xdb,
+	0x72, 0x43, 0xce, 0xcc, 0x0b, 0x60, 0xfe, 0x96, 0xc5, 0x4e, 0xdb, 0x93, 0x7b, 0x29, 0x88, 0x82,
+	0x23, 0x3e, 0xfd, 0xfa, 0x72, 0xd7, 0xf2, 0xab, 0x8c, 0x26, 0xc5, 0x03, 0x12, 0x78, 0x1d, 0x4d,
+	0x98, 0x39, 0xb5, 0x27, 0xd5, 0xb5, 0xb7, 0x87, 0xcd, 0xcd, 0x86, 0x88, 0x5e, 0xee, 0xa5, 0xe0,
+	0x6b, 0xb4, 0x19, 0xa0, 0x82, 0x8c, 0xbb, 0x68, 0x4a, 0x72, 0x49, 0x3a, 0x41, 0xa1, 0x86, 0x94,
+	0xda, 0xfc, 0x99, 0x26, 0x94, 0x83, 0x07, 0xc6, 0x41, 0xfd, 0x3f, 0x1c, 0x0c, 0xb6, 0xaf, 0xae,
+	0x31, 0x5d, 0xe0, 0x1b, 0xa8, 0x1a, 0x03, 0xe9, 0xc8, 0x38, 0x10, 0x21, 0xcf, 0xc0, 0xae, 0xd4,
+	0xac, 0xfa, 0x94, 0x5f, 0xd1, 0x7b, 0x5b, 0xf9, 0x16, 0xde, 0x46, 0x33, 0x06, 0x12, 0x72, 0x96,
+	0xf2, 0x04, 0x12, 0x29, 0xec, 0xaa, 0xea, 0xee, 0xce, 0x30, 0xaf, 0xcf, 0x14, 0x61, 0xb5, 0xc0,
+	0x1b, 0xb3, 0x97, 0xe2, 0x3f, 0xb7, 0xc5, 0xd2, 0x63, 0x74, 0xf1, 0x2f, 0x28, 0xc6, 0x68, 0x2c,
+	0x21, 0x0c, 0xd4, 0xab, 0x3d, 0xe9, 0xab, 0x1a, 0xcf, 0xa2, 0x71, 0xdd, 0xde, 0x88, 0x6a, 0x4f,
+	0x2f, 0x9a, 0xcf, 0xf7, 0x8f, 0x1d, 0xeb, 0xe0, 0xd8, 0xb1, 0x7e, 0x1e, 0x3b, 0xd6, 0xc7, 0x13,
+	0xa7, 0x74, 0x70, 0xe2, 0x94, 0xbe, 0x9d, 0x38, 0xa5, 0x6d, 0x6f, 0x20, 0x12, 0xd1, 0xa6, 0xe9,
+	0x32, 0x83, 0xde, 0xc0, 0xb7, 0xe5, 0xdd, 0x40, 0xad, 0xf2, 0x69, 0x95, 0xd5, 0x37, 0xe6, 0xfe,
+	0xef, 0x01, 0x00, 0xa6, 0x14, 0x46, 0x0c, 0x77, 0x05, 0x00, 0x00,
+}
+
+func (m *Report) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Report) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Report) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.HealthComponents) > 0 {
+		for iNdEx := len(m.HealthComponents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.HealthComponents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.HealthScore != 0 {
+		i = encodeVarintReport(dAtA, i, uint64(m.HealthScore))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.TotalRevenue) > 0 {
+		for iNdEx := len(m.TotalRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.Revenue) > 0 {
+		for iNdEx := len(m.Revenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Revenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.MinGasPrices) > 0 {
+		for iNdEx := len(m.MinGasPrices) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinGasPrices[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintReport(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.AverageUtilization.Size()
+		i -= size
+		if _, err := m.AverageUtilization.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.WarmupFactor.Size()
+		i -= size
+		if _, err := m.WarmupFactor.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.CongestionMultiplier.Size()
+		i -= size
+		if _, err := m.CongestionMultiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size := m.BaseGasPrice.Size()
+		i -= size
+		if _, err := m.BaseGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintReport(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintReport(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HealthComponent) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HealthComponent) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HealthComponent) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Score != 0 {
+		i = encodeVarintReport(dAtA, i, uint64(m.Score))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintReport(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintReport(dAtA []byte, offset int, v uint64) int {
+	offset -= sovReport(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Report) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovReport(uint64(m.Height))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovReport(uint64(l))
+	l = m.State.Size()
+	n += 1 + l + sovReport(uint64(l))
+	l = m.BaseGasPrice.Size()
+	n += 1 + l + sovReport(uint64(l))
+	l = m.CongestionMultiplier.Size()
+	n += 1 + l + sovReport(uint64(l))
+	l = m.WarmupFactor.Size()
+	n += 1 + l + sovReport(uint64(l))
+	l = m.AverageUtilization.Size()
+	n += 1 + l + sovReport(uint64(l))
+	if len(m.MinGasPrices) > 0 {
+		for _, e := range m.MinGasPrices {
+			l = e.Size()
+			n += 1 + l + sovReport(uint64(l))
+		}
+	}
+	if len(m.Revenue) > 0 {
+		for _, e := range m.Revenue {
+			l = e.Size()
+			n += 1 + l + sovReport(uint64(l))
+		}
+	}
+	if len(m.TotalRevenue) > 0 {
+		for _, e := range m.TotalRevenue {
+			l = e.Size()
+			n += 1 + l + sovReport(uint64(l))
+		}
+	}
+	if m.HealthScore != 0 {
+		n += 1 + sovReport(uint64(m.HealthScore))
+	}
+	if len(m.HealthComponents) > 0 {
+		for _, e := range m.HealthComponents {
+			l = e.Size()
+			n += 1 + l + sovReport(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *HealthComponent) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovReport(uint64(l))
+	}
+	if m.Score != 0 {
+		n += 1 + sovReport(uint64(m.Score))
+	}
+	return n
+}
+
+func sovReport(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozReport(x uint64) (n int) {
+	return sovReport(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Report) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Report: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CongestionMultiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CongestionMultiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WarmupFactor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.WarmupFactor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AverageUtilization", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AverageUtilization.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrices", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinGasPrices = append(m.MinGasPrices, types.DecCoin{})
+			if err := m.MinGasPrices[len(m.MinGasPrices)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Revenue = append(m.Revenue, MsgTypeRevenue{})
+			if err := m.Revenue[len(m.Revenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRevenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRevenue = append(m.TotalRevenue, types.Coin{})
+			if err := m.TotalRevenue[len(m.TotalRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthScore", wireType)
+			}
+			m.HealthScore = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HealthScore |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HealthComponents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HealthComponents = append(m.HealthComponents, HealthComponent{})
+			if err := m.HealthComponents[len(m.HealthComponents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HealthComponent) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowReport
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HealthComponent: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HealthComponent: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthReport
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthReport
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Score", wireType)
+			}
+			m.Score = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Score |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipReport(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthReport
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipReport(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowReport
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowReport
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthReport
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupReport
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthReport
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthReport        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowReport          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupReport = fmt.Errorf("proto: unexpected end of group")
+)
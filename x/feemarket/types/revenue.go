@@ -0,0 +1,17 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// PrimaryMsgTypeURL returns the type URL of the first message in the transaction, which
+// is used to attribute the transaction's fee revenue. An empty string is returned if the
+// transaction contains no messages.
+func PrimaryMsgTypeURL(tx sdk.Tx) string {
+	msgs := tx.GetMsgs()
+	if len(msgs) == 0 {
+		return ""
+	}
+
+	return sdk.MsgTypeURL(msgs[0])
+}
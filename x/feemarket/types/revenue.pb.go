@@ -0,0 +1,1177 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: feemarket/feemarket/v1/revenue.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgTypeRevenue is the fee revenue collected from transactions whose primary
+// (first) message is of the given type.
+type MsgTypeRevenue struct {
+	// MsgTypeUrl is the type URL of the primary message of the transactions.
+	MsgTypeUrl string `protobuf:"bytes,1,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Fees are the fees collected from the transactions, excluding tips.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *MsgTypeRevenue) Reset()         { *m = MsgTypeRevenue{} }
+func (m *MsgTypeRevenue) String() string { return proto.CompactTextString(m) }
+func (*MsgTypeRevenue) ProtoMessage()    {}
+func (*MsgTypeRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0ab3349c84c6ed8, []int{0}
+}
+func (m *MsgTypeRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTypeRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTypeRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTypeRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTypeRevenue.Merge(m, src)
+}
+func (m *MsgTypeRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTypeRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTypeRevenue.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTypeRevenue proto.InternalMessageInfo
+
+func (m *MsgTypeRevenue) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+func (m *MsgTypeRevenue) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// AccountFeeSpend is the fees paid by an account.
+type AccountFeeSpend struct {
+	// Fees are the fees paid by the account, including tips.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *AccountFeeSpend) Reset()         { *m = AccountFeeSpend{} }
+func (m *AccountFeeSpend) String() string { return proto.CompactTextString(m) }
+func (*AccountFeeSpend) ProtoMessage()    {}
+func (*AccountFeeSpend) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0ab3349c84c6ed8, []int{1}
+}
+func (m *AccountFeeSpend) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccountFeeSpend) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccountFeeSpend.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccountFeeSpend) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccountFeeSpend.Merge(m, src)
+}
+func (m *AccountFeeSpend) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccountFeeSpend) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccountFeeSpend.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccountFeeSpend proto.InternalMessageInfo
+
+func (m *AccountFeeSpend) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// AccumulatedFees is the fees held in the module account for distribution at
+// the end of the current distribution epoch.
+type AccumulatedFees struct {
+	// Fees are the accumulated fees.
+	Fees github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=fees,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fees"`
+}
+
+func (m *AccumulatedFees) Reset()         { *m = AccumulatedFees{} }
+func (m *AccumulatedFees) String() string { return proto.CompactTextString(m) }
+func (*AccumulatedFees) ProtoMessage()    {}
+func (*AccumulatedFees) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0ab3349c84c6ed8, []int{2}
+}
+func (m *AccumulatedFees) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AccumulatedFees) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AccumulatedFees.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AccumulatedFees) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AccumulatedFees.Merge(m, src)
+}
+func (m *AccumulatedFees) XXX_Size() int {
+	return m.Size()
+}
+func (m *AccumulatedFees) XXX_DiscardUnknown() {
+	xxx_messageInfo_AccumulatedFees.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AccumulatedFees proto.InternalMessageInfo
+
+func (m *AccumulatedFees) GetFees() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Fees
+	}
+	return nil
+}
+
+// CommunityPoolContributions is the share of the fees distributed by the fee
+// market that the distribution module allocates to the community pool, i.e. the
+// distributed fees multiplied by the community tax.
+type CommunityPoolContributions struct {
+	// Amount is the cumulative amount contributed to the community pool.
+	Amount github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
+	// SinceHeight is the height of the last reset of the cumulative amount, or
+	// zero if it was never reset, in which case the amount covers all fees
+	// distributed since contributions were first tracked.
+	SinceHeight int64 `protobuf:"varint,2,opt,name=since_height,json=sinceHeight,proto3" json:"since_height,omitempty"`
+}
+
+func (m *CommunityPoolContributions) Reset()         { *m = CommunityPoolContributions{} }
+func (m *CommunityPoolContributions) String() string { return proto.CompactTextString(m) }
+func (*CommunityPoolContributions) ProtoMessage()    {}
+func (*CommunityPoolContributions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0ab3349c84c6ed8, []int{3}
+}
+func (m *CommunityPoolContributions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CommunityPoolContributions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CommunityPoolContributions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *CommunityPoolContributions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CommunityPoolContributions.Merge(m, src)
+}
+func (m *CommunityPoolContributions) XXX_Size() int {
+	return m.Size()
+}
+func (m *CommunityPoolContributions) XXX_DiscardUnknown() {
+	xxx_messageInfo_CommunityPoolContributions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CommunityPoolContributions proto.InternalMessageInfo
+
+func (m *CommunityPoolContributions) GetAmount() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func (m *CommunityPoolContributions) GetSinceHeight() int64 {
+	if m != nil {
+		return m.SinceHeight
+	}
+	return 0
+}
+
+// BlockCommunityPoolContribution is the amount contributed to the community
+// pool from the fees distributed at a height.
+type BlockCommunityPoolContribution struct {
+	// Amount is the amount contributed to the community pool.
+	Amount github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"amount"`
+}
+
+func (m *BlockCommunityPoolContribution) Reset()         { *m = BlockCommunityPoolContribution{} }
+func (m *BlockCommunityPoolContribution) String() string { return proto.CompactTextString(m) }
+func (*BlockCommunityPoolContribution) ProtoMessage()    {}
+func (*BlockCommunityPoolContribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d0ab3349c84c6ed8, []int{4}
+}
+func (m *BlockCommunityPoolContribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockCommunityPoolContribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockCommunityPoolContribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockCommunityPoolContribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockCommunityPoolContribution.Merge(m, src)
+}
+func (m *BlockCommunityPoolContribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockCommunityPoolContribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockCommunityPoolContribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockCommunityPoolContribution proto.InternalMessageInfo
+
+func (m *BlockCommunityPoolContribution) GetAmount() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.Amount
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MsgTypeRevenue)(nil), "feemarket.feemarket.v1.MsgTypeRevenue")
+	proto.RegisterType((*AccountFeeSpend)(nil), "feemarket.feemarket.v1.AccountFeeSpend")
+	proto.RegisterType((*AccumulatedFees)(nil), "feemarket.feemarket.v1.AccumulatedFees")
+	proto.RegisterType((*CommunityPoolContributions)(nil), "feemarket.feemarket.v1.CommunityPoolContributions")
+	proto.RegisterType((*BlockCommunityPoolContribution)(nil), "feemarket.feemarket.v1.BlockCommunityPoolContribution")
+}
+
+func init() {
+	proto.RegisterFile("feemarket/feemarket/v1/revenue.proto", fileDescriptor_d0ab3349c84c6ed8)
+}
+
+var fileDescriptor_d0ab3349c84c6ed8 = []byte{
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x41, 0x8b, 0x13, 0x31,
+	0x14, 0xc7, 0x9b, 0x5d, 0x59, 0x30, 0xbb, 0x28, 0x0e, 0x22, 0xb5, 0x48, 0xb6, 0x16, 0x0f, 0x45,
+	0xd9, 0x09, 0x55, 0x04, 0xaf, 0xb6, 0xb2, 0xe8, 0x41, 0x90, 0xaa, 0x17, 0x2f, 0x65, 0x26, 0x7d,
+	0x3b, 0x0d, 0x33, 0xc9, 0x1b, 0x26, 0xc9, 0x68, 0xbf, 0xc5, 0x1e, 0xfd, 0x08, 0xe2, 0x45, 0x3f,
+	0xc6, 0x1e, 0xf7, 0xe8, 0x49, 0xa5, 0x3d, 0xf8, 0x35, 0x64, 0x32, 0x61, 0xb7, 0x07, 0x85, 0x3d,
+	0xf5, 0x32, 0xf3, 0xe7, 0xe5, 0xe5, 0xfd, 0x7e, 0x04, 0x1e, 0x7d, 0x70, 0x02, 0xa0, 0x92, 0x2a,
+	0x07, 0xcb, 0x2f, 0x53, 0x3d, 0xe2, 0x15, 0xd4, 0xa0, 0x1d, 0xc4, 0x65, 0x85, 0x16, 0xa3, 0x3b,
+	0x17, 0x67, 0xf1, 0x65, 0xaa, 0x47, 0xbd, 0xdb, 0x19, 0x66, 0xe8, 0x5b, 0x78, 0x93, 0xda, 0xee,
+	0xde, 0xad, 0x44, 0x49, 0x8d, 0xdc, 0x7f, 0x43, 0x89, 0x09, 0x34, 0x0a, 0x0d, 0x4f, 0x13, 0x03,
+	0xbc, 0x1e, 0xa5, 0x60, 0x93, 0x11, 0x17, 0x28, 0x75, 0x7b, 0x3e, 0xf8, 0x4c, 0xe8, 0x8d, 0xd7,
+	0x26, 0x7b, 0xb7, 0x2c, 0x61, 0xda, 0x92, 0xa3, 0x3e, 0x3d, 0x50, 0x26, 0x9b, 0xd9, 0x65, 0x09,
+	0x33, 0x57, 0x15, 0x5d, 0xd2, 0x27, 0xc3, 0xeb, 0x53, 0xaa, 0xda, 0xae, 0xf7, 0x55, 0x11, 0xcd,
+	0xe9, 0xb5, 0x13, 0x00, 0xd3, 0xdd, 0xe9, 0xef, 0x0e, 0xf7, 0x1f, 0xdf, 0x8d, 0x5b, 0x46, 0xdc,
+	0x30, 0xe2, 0xc0, 0x88, 0x27, 0x28, 0xf5, 0xf8, 0xe9, 0xd9, 0xcf, 0xc3, 0xce, 0xd7, 0x5f, 0x87,
+	0xc3, 0x4c, 0xda, 0x85, 0x4b, 0x63, 0x81, 0x8a, 0x07, 0xa1, 0xf6, 0x77, 0x64, 0xe6, 0x39, 0x6f,
+	0x48, 0xc6, 0x5f, 0x30, 0x5f, 0xfe, 0x7c, 0x7f, 0x48, 0xa6, 0x7e, 0xfa, 0xe0, 0x23, 0xbd, 0xf9,
+	0x5c, 0x08, 0x74, 0xda, 0x1e, 0x03, 0xbc, 0x2d, 0x41, 0xcf, 0x2f, 0xc0, 0x64, 0x0b, 0x60, 0xa7,
+	0x5c, 0x91, 0x58, 0x98, 0x1f, 0x03, 0x98, 0x2d, 0x81, 0xbf, 0x11, 0xda, 0x9b, 0xa0, 0x52, 0x4e,
+	0x4b, 0xbb, 0x7c, 0x83, 0x58, 0x4c, 0x50, 0xdb, 0x4a, 0xa6, 0xce, 0x4a, 0xd4, 0x26, 0xd2, 0x74,
+	0x2f, 0x51, 0xcd, 0x7b, 0x04, 0x8d, 0x7b, 0xff, 0xd4, 0x78, 0x01, 0xc2, 0x9b, 0x3c, 0x0b, 0x26,
+	0x8f, 0xae, 0x60, 0x12, 0xee, 0x04, 0x99, 0x40, 0x89, 0xee, 0xd3, 0x03, 0x23, 0xb5, 0x80, 0xd9,
+	0x02, 0x64, 0xb6, 0xb0, 0xdd, 0x9d, 0x3e, 0x19, 0xee, 0x4e, 0xf7, 0x7d, 0xed, 0xa5, 0x2f, 0x0d,
+	0x4e, 0x09, 0x65, 0xe3, 0x02, 0x45, 0xfe, 0x5f, 0xed, 0x6d, 0x5b, 0x8f, 0x5f, 0x9d, 0xad, 0x18,
+	0x39, 0x5f, 0x31, 0xf2, 0x7b, 0xc5, 0xc8, 0xe9, 0x9a, 0x75, 0xce, 0xd7, 0xac, 0xf3, 0x63, 0xcd,
+	0x3a, 0x1f, 0xf8, 0xc6, 0x4c, 0x93, 0xcb, 0xf2, 0x48, 0x41, 0xbd, 0xb1, 0x7c, 0x9f, 0x36, 0xb2,
+	0x07, 0xa4, 0x7b, 0x7e, 0x47, 0x9e, 0xfc, 0x1d, 0x00, 0xd9, 0xa3, 0x3a, 0x10, 0xac, 0x03, 0x00,
+	0x00,
+}
+
+func (m *MsgTypeRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTypeRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTypeRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRevenue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintRevenue(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccountFeeSpend) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccountFeeSpend) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccountFeeSpend) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRevenue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AccumulatedFees) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AccumulatedFees) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AccumulatedFees) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for iNdEx := len(m.Fees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Fees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRevenue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CommunityPoolContributions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CommunityPoolContributions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CommunityPoolContributions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SinceHeight != 0 {
+		i = encodeVarintRevenue(dAtA, i, uint64(m.SinceHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRevenue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockCommunityPoolContribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockCommunityPoolContribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockCommunityPoolContribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintRevenue(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRevenue(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRevenue(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgTypeRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovRevenue(uint64(l))
+	}
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovRevenue(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccountFeeSpend) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovRevenue(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *AccumulatedFees) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Fees) > 0 {
+		for _, e := range m.Fees {
+			l = e.Size()
+			n += 1 + l + sovRevenue(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CommunityPoolContributions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovRevenue(uint64(l))
+		}
+	}
+	if m.SinceHeight != 0 {
+		n += 1 + sovRevenue(uint64(m.SinceHeight))
+	}
+	return n
+}
+
+func (m *BlockCommunityPoolContribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovRevenue(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovRevenue(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRevenue(x uint64) (n int) {
+	return sovRevenue(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgTypeRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTypeRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTypeRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccountFeeSpend) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccountFeeSpend: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccountFeeSpend: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AccumulatedFees) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AccumulatedFees: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AccumulatedFees: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fees = append(m.Fees, types.Coin{})
+			if err := m.Fees[len(m.Fees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CommunityPoolContributions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CommunityPoolContributions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CommunityPoolContributions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SinceHeight", wireType)
+			}
+			m.SinceHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SinceHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockCommunityPoolContribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockCommunityPoolContribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockCommunityPoolContribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.DecCoin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRevenue(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRevenue
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRevenue(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRevenue
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRevenue
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRevenue
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRevenue
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRevenue
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRevenue        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRevenue          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRevenue = fmt.Errorf("proto: unexpected end of group")
+)
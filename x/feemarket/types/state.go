@@ -32,21 +32,86 @@ func (s *State) Update(gas uint64, params Params) error {
 	}
 
 	s.Window[s.Index] = update
+	s.WindowSum += gas
 	return nil
 }
 
+// SetCurrentUtilization sets the block utilization for the current height to the
+// given gas, keeping the running window sum in step.
+func (s *State) SetCurrentUtilization(gas uint64) {
+	s.WindowSum = s.WindowSum - s.Window[s.Index] + gas
+	s.Window[s.Index] = gas
+}
+
 // IncrementHeight increments the current height of the state.
 func (s *State) IncrementHeight() {
 	s.Index = (s.Index + 1) % uint64(len(s.Window))
+
+	// Guard against underflow if the running sum has drifted below the window.
+	if s.Window[s.Index] > s.WindowSum {
+		s.ReconcileWindowSum()
+	}
+
+	s.WindowSum -= s.Window[s.Index]
 	s.Window[s.Index] = 0
 }
 
+// ResizeWindow resizes the window to the given size, keeping the utilization of the most
+// recent blocks. The window is laid out oldest first with the current block last, so the
+// blocks dropped from a shrinking window are the oldest ones and a growing window is padded
+// with empty blocks that precede them. A window of the given size is left unchanged. The
+// window size must be positive.
+func (s *State) ResizeWindow(windowSize uint64) {
+	n := uint64(len(s.Window))
+	if n == windowSize {
+		return
+	}
+
+	window := make([]uint64, windowSize)
+	for i := uint64(0); i < windowSize && i < n; i++ {
+		window[windowSize-1-i] = s.Window[(s.Index+n-i)%n]
+	}
+
+	s.Window = window
+	s.Index = windowSize - 1
+	s.ReconcileWindowSum()
+}
+
+// SumWindow returns the sum of the block utilization values in the window, computed
+// from scratch.
+func (s *State) SumWindow() uint64 {
+	var total uint64
+	for _, utilization := range s.Window {
+		total += utilization
+	}
+
+	return total
+}
+
+// ReconcileWindowSum recomputes the running window sum from the window, and returns
+// true if the running sum had drifted from it.
+func (s *State) ReconcileWindowSum() bool {
+	total := s.SumWindow()
+	drifted := total != s.WindowSum
+	s.WindowSum = total
+
+	return drifted
+}
+
 // UpdateBaseGasPrice updates the learning rate and base gas price based on the AIMD
 // learning rate adjustment algorithm. The learning rate is updated
 // based on the average utilization of the block window. The base gas price is
 // update using the new learning rate and the delta adjustment. Please
 // see the EIP-1559 specification for more details.
 func (s *State) UpdateBaseGasPrice(params Params) (gasPrice math.LegacyDec) {
+	return s.UpdateBaseGasPriceScaled(params, math.LegacyOneDec())
+}
+
+// UpdateBaseGasPriceScaled updates the base gas price in the same manner as
+// UpdateBaseGasPrice, but scales the learning rate adjustment by the given
+// factor. This is utilized to dampen price movements while the fee market is
+// warming up.
+func (s *State) UpdateBaseGasPriceScaled(params Params, scale math.LegacyDec) (gasPrice math.LegacyDec) {
 	// Panic catch in case there is an overflow
 	defer func() {
 		if rec := recover(); rec != nil {
@@ -55,16 +120,25 @@ func (s *State) UpdateBaseGasPrice(params Params) (gasPrice math.LegacyDec) {
 		}
 	}()
 
-	// Calculate the new base gasPrice with the learning rate adjustment.
+	// Calculate the new base gasPrice with the learning rate adjustment. In EMA mode the
+	// price moves by the smoothed utilization of the window rather than the current block.
 	currentBlockSize := math.LegacyNewDecFromInt(math.NewIntFromUint64(s.Window[s.Index]))
+	if params.IsEMA() {
+		currentBlockSize = s.GetEMAUtilization(params)
+	}
 	targetBlockSize := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.TargetBlockUtilization()))
 	utilization := (currentBlockSize.Sub(targetBlockSize)).Quo(targetBlockSize)
 
+	// Hold the price steady if the utilization is within the dead-band around the target.
+	if !params.TargetDeadBand.IsNil() && params.TargetDeadBand.IsPositive() && utilization.Abs().LTE(params.TargetDeadBand) {
+		return s.BaseGasPrice
+	}
+
 	// Truncate the learning rate adjustment to an integer.
 	//
 	// This is equivalent to
 	// 1 + (learningRate * (currentBlockSize - targetBlockSize) / targetBlockSize)
-	learningRateAdjustment := math.LegacyOneDec().Add(s.LearningRate.Mul(utilization))
+	learningRateAdjustment := math.LegacyOneDec().Add(s.effectiveLearningRate(params).Mul(scale).Mul(utilization))
 
 	// Calculate the delta adjustment.
 	net := math.LegacyNewDecFromInt(s.GetNetUtilization(params)).Mul(params.Delta)
@@ -81,6 +155,28 @@ func (s *State) UpdateBaseGasPrice(params Params) (gasPrice math.LegacyDec) {
 	return s.BaseGasPrice
 }
 
+// effectiveLearningRate returns the learning rate that is applied to the base gas
+// price adjustment. If an effective minimum learning rate is configured and the
+// current block's utilization is within gamma of empty or full, the learning rate
+// is raised to at least that minimum so that the price can still respond to
+// extreme demand.
+func (s *State) effectiveLearningRate(params Params) math.LegacyDec {
+	floor := params.EffectiveMinLearningRate
+	if floor.IsNil() || !floor.IsPositive() || s.LearningRate.GTE(floor) || params.MaxBlockUtilization == 0 {
+		return s.LearningRate
+	}
+
+	currentBlockSize := math.LegacyNewDecFromInt(math.NewIntFromUint64(s.Window[s.Index]))
+	maxBlockSize := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.MaxBlockUtilization))
+	utilization := currentBlockSize.Quo(maxBlockSize)
+
+	if utilization.LTE(params.Gamma) || utilization.GTE(math.LegacyOneDec().Sub(params.Gamma)) {
+		return floor
+	}
+
+	return s.LearningRate
+}
+
 // UpdateLearningRate updates the learning rate based on the AIMD
 // learning rate adjustment algorithm. The learning rate is updated
 // based on the average utilization of the block window. There are
@@ -94,6 +190,9 @@ func (s *State) UpdateBaseGasPrice(params Params) (gasPrice math.LegacyDec) {
 //     when blocks are relatively close to the target block utilization.
 //
 // For more details, please see the EIP-1559 specification.
+//
+// In EMA mode the learning rate is not adjusted, as Alpha is the smoothing factor, and is
+// only kept within the learning rate bounds.
 func (s *State) UpdateLearningRate(params Params) (lr math.LegacyDec) {
 	// Panic catch in case there is an overflow
 	defer func() {
@@ -103,6 +202,11 @@ func (s *State) UpdateLearningRate(params Params) (lr math.LegacyDec) {
 		}
 	}()
 
+	if params.IsEMA() {
+		s.LearningRate = math.LegacyMaxDec(params.MinLearningRate, math.LegacyMinDec(s.LearningRate, params.MaxLearningRate))
+		return s.LearningRate
+	}
+
 	// Calculate the average utilization of the block window.
 	avg := s.GetAverageUtilization(params)
 
@@ -125,28 +229,41 @@ func (s *State) UpdateLearningRate(params Params) (lr math.LegacyDec) {
 	return s.LearningRate
 }
 
-// GetNetUtilization returns the net utilization of the block window.
-func (s *State) GetNetUtilization(params Params) math.Int {
-	net := math.NewInt(0)
+// GetEMAUtilization returns the exponential moving average of the block utilization over
+// the window, from the oldest block to the current one, with Alpha as the smoothing factor:
+//
+//	ema = alpha * utilization + (1 - alpha) * ema
+//
+// The average is seeded with the utilization of the oldest block in the window.
+func (s *State) GetEMAUtilization(params Params) math.LegacyDec {
+	size := uint64(len(s.Window))
+	if size == 0 {
+		return math.LegacyZeroDec()
+	}
 
-	targetUtilization := math.NewIntFromUint64(params.TargetBlockUtilization())
-	for _, utilization := range s.Window {
-		diff := math.NewIntFromUint64(utilization).Sub(targetUtilization)
-		net = net.Add(diff)
+	oneMinusAlpha := math.LegacyOneDec().Sub(params.Alpha)
+
+	oldest := (s.Index + 1) % size
+	ema := math.LegacyNewDecFromInt(math.NewIntFromUint64(s.Window[oldest]))
+	for i := uint64(1); i < size; i++ {
+		utilization := math.LegacyNewDecFromInt(math.NewIntFromUint64(s.Window[(oldest+i)%size]))
+		ema = params.Alpha.Mul(utilization).Add(oneMinusAlpha.Mul(ema))
 	}
 
-	return net
+	return ema
+}
+
+// GetNetUtilization returns the net utilization of the block window, computed from
+// the running window sum.
+func (s *State) GetNetUtilization(params Params) math.Int {
+	target := math.NewIntFromUint64(params.TargetBlockUtilization()).MulRaw(int64(len(s.Window)))
+	return math.NewIntFromUint64(s.WindowSum).Sub(target)
 }
 
 // GetAverageUtilization returns the average utilization of the block
-// window.
+// window, computed from the running window sum.
 func (s *State) GetAverageUtilization(params Params) math.LegacyDec {
-	var total uint64
-	for _, utilization := range s.Window {
-		total += utilization
-	}
-
-	sum := math.LegacyNewDecFromInt(math.NewIntFromUint64(total))
+	sum := math.LegacyNewDecFromInt(math.NewIntFromUint64(s.WindowSum))
 
 	multiple := math.LegacyNewDecFromInt(math.NewIntFromUint64(uint64(len(s.Window))))
 	divisor := math.LegacyNewDecFromInt(math.NewIntFromUint64(params.MaxBlockUtilization)).Mul(multiple)
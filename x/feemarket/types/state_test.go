@@ -143,6 +143,7 @@ func TestState_UpdateBaseGasPrice(t *testing.T) {
 
 		state.Window[0] = params.TargetBlockUtilization()
 
+		state.ReconcileWindowSum()
 		newBaseGasPrice := state.UpdateBaseGasPrice(params)
 		expectedBaseGasPrice := math.LegacyMustNewDecFromStr("1000")
 		require.True(t, expectedBaseGasPrice.Equal(newBaseGasPrice))
@@ -157,6 +158,7 @@ func TestState_UpdateBaseGasPrice(t *testing.T) {
 
 		state.Window[0] = params.MaxBlockUtilization
 
+		state.ReconcileWindowSum()
 		newBaseGasPrice := state.UpdateBaseGasPrice(params)
 		expectedBaseGasPrice := math.LegacyMustNewDecFromStr("1125")
 		require.True(t, expectedBaseGasPrice.Equal(newBaseGasPrice))
@@ -189,6 +191,7 @@ func TestState_UpdateBaseGasPrice(t *testing.T) {
 			state.Window[i] = params.TargetBlockUtilization()
 		}
 
+		state.ReconcileWindowSum()
 		state.UpdateLearningRate(params)
 		newBaseGasPrice := state.UpdateBaseGasPrice(params)
 
@@ -208,6 +211,7 @@ func TestState_UpdateBaseGasPrice(t *testing.T) {
 			state.Window[i] = params.MaxBlockUtilization
 		}
 
+		state.ReconcileWindowSum()
 		state.UpdateLearningRate(params)
 		newBaseGasPrice := state.UpdateBaseGasPrice(params)
 
@@ -276,6 +280,7 @@ func TestState_UpdateBaseGasPrice(t *testing.T) {
 			state.Window[i] = params.MaxBlockUtilization
 		}
 
+		state.ReconcileWindowSum()
 		lr := state.UpdateLearningRate(params)
 		bgs := state.UpdateBaseGasPrice(params)
 
@@ -285,6 +290,7 @@ func TestState_UpdateBaseGasPrice(t *testing.T) {
 			state.Window[i] = params.MaxBlockUtilization
 		}
 
+		state.ReconcileWindowSum()
 		lrWithDelta := state.UpdateLearningRate(paramsWithDelta)
 		bgsWithDelta := state.UpdateBaseGasPrice(paramsWithDelta)
 
@@ -310,6 +316,7 @@ func TestState_UpdateBaseGasPrice(t *testing.T) {
 			state.Window[i] = params.TargetBlockUtilization()
 		}
 
+		state.ReconcileWindowSum()
 		lr := state.UpdateLearningRate(params)
 		bgs := state.UpdateBaseGasPrice(params)
 
@@ -319,6 +326,7 @@ func TestState_UpdateBaseGasPrice(t *testing.T) {
 			state.Window[i] = params.TargetBlockUtilization()
 		}
 
+		state.ReconcileWindowSum()
 		lrWithDelta := state.UpdateLearningRate(paramsWithDelta)
 		bgsWithDelta := state.UpdateBaseGasPrice(paramsWithDelta)
 
@@ -344,6 +352,7 @@ func TestState_UpdateBaseGasPrice(t *testing.T) {
 		state.Window[0] = params.TargetBlockUtilization() / 2
 
 		prevLR := state.LearningRate
+		state.ReconcileWindowSum()
 		lr := state.UpdateLearningRate(params)
 		bgs := state.UpdateBaseGasPrice(params)
 
@@ -374,6 +383,7 @@ func TestState_UpdateBaseGasPrice(t *testing.T) {
 		state.Window[0] = params.MaxBlockUtilization / 4 * 3
 
 		prevLR := state.LearningRate
+		state.ReconcileWindowSum()
 		lr := state.UpdateLearningRate(params)
 		bgs := state.UpdateBaseGasPrice(params)
 
@@ -387,6 +397,141 @@ func TestState_UpdateBaseGasPrice(t *testing.T) {
 		require.Equal(t, expectedLR, lr)
 		require.Equal(t, expectedGasPrice, bgs)
 	})
+
+	t.Run("effective min learning rate applies at full utilization", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+		params.EffectiveMinLearningRate = math.LegacyMustNewDecFromStr("0.05")
+		state.LearningRate = math.LegacyZeroDec()
+		prevBGS := state.BaseGasPrice
+
+		state.Window[state.Index] = params.MaxBlockUtilization
+		state.ReconcileWindowSum()
+		bgs := state.UpdateBaseGasPrice(params)
+
+		// 1 + (0.05 * (max - target) / target) = 1.05
+		expectedLRAdjustment := math.LegacyMustNewDecFromStr("1.05")
+		expectedGasPrice := prevBGS.Mul(expectedLRAdjustment)
+		require.Equal(t, expectedGasPrice, bgs)
+		require.True(t, bgs.GT(prevBGS))
+	})
+
+	t.Run("effective min learning rate does not apply at moderate utilization", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+		params.EffectiveMinLearningRate = math.LegacyMustNewDecFromStr("0.05")
+		state.LearningRate = math.LegacyZeroDec()
+		prevBGS := state.BaseGasPrice
+
+		state.Window[state.Index] = params.MaxBlockUtilization * 3 / 5
+		state.ReconcileWindowSum()
+		bgs := state.UpdateBaseGasPrice(params)
+		require.Equal(t, prevBGS, bgs)
+	})
+}
+
+func TestState_UpdateBaseGasPriceDeadBand(t *testing.T) {
+	t.Run("utilization just inside the dead-band leaves the price unchanged", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+		params.TargetDeadBand = math.LegacyMustNewDecFromStr("0.1")
+		prevBGS := state.BaseGasPrice
+
+		// 5% above the target.
+		state.Window[state.Index] = params.TargetBlockUtilization() * 105 / 100
+		state.ReconcileWindowSum()
+		bgs := state.UpdateBaseGasPrice(params)
+		require.Equal(t, prevBGS, bgs)
+
+		// 5% below the target.
+		state.Window[state.Index] = params.TargetBlockUtilization() * 95 / 100
+		state.ReconcileWindowSum()
+		bgs = state.UpdateBaseGasPrice(params)
+		require.Equal(t, prevBGS, bgs)
+	})
+
+	t.Run("utilization just outside the dead-band moves the price", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+		params.TargetDeadBand = math.LegacyMustNewDecFromStr("0.1")
+		state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(2)
+		prevBGS := state.BaseGasPrice
+
+		// 15% above the target.
+		state.Window[state.Index] = params.TargetBlockUtilization() * 115 / 100
+		state.ReconcileWindowSum()
+		bgs := state.UpdateBaseGasPrice(params)
+		require.True(t, bgs.GT(prevBGS))
+
+		// 15% below the target.
+		prevBGS = bgs
+		state.Window[state.Index] = params.TargetBlockUtilization() * 85 / 100
+		state.ReconcileWindowSum()
+		bgs = state.UpdateBaseGasPrice(params)
+		require.True(t, bgs.LT(prevBGS))
+	})
+}
+
+func TestState_UpdateBaseGasPriceEMA(t *testing.T) {
+	t.Run("smooths the window utilization from the oldest block", func(t *testing.T) {
+		state := types.NewState(3, math.LegacyOneDec(), math.LegacyOneDec())
+		params := types.DefaultAIMDParams()
+		params.Mode = types.Mode_MODE_EMA
+		params.Alpha = math.LegacyMustNewDecFromStr("0.5")
+
+		// The oldest block is the one after the current index: 100, then 200, then 400.
+		state.Index = 1
+		state.Window = []uint64{200, 400, 100}
+
+		// 100 -> 0.5 * 200 + 0.5 * 100 = 150 -> 0.5 * 400 + 0.5 * 150 = 275.
+		require.True(t, math.LegacyNewDec(275).Equal(state.GetEMAUtilization(params)))
+	})
+
+	t.Run("a single full block moves the price less than in AIMD mode", func(t *testing.T) {
+		params := types.DefaultAIMDParams()
+		params.Delta = math.LegacyZeroDec()
+
+		newState := func() types.State {
+			state := types.DefaultAIMDState()
+			state.BaseGasPrice = params.MinBaseGasPrice.MulInt64(10)
+			state.LearningRate = params.MaxLearningRate
+			for i := range state.Window {
+				state.Window[i] = params.TargetBlockUtilization()
+			}
+			state.Window[state.Index] = params.MaxBlockUtilization
+			state.ReconcileWindowSum()
+
+			return state
+		}
+
+		aimd := newState()
+		aimdPrice := aimd.UpdateBaseGasPrice(params)
+
+		params.Mode = types.Mode_MODE_EMA
+		params.Alpha = math.LegacyMustNewDecFromStr("0.5")
+		ema := newState()
+		emaPrice := ema.UpdateBaseGasPrice(params)
+
+		// The smoothed utilization is halfway between the target and a full block.
+		require.True(t, emaPrice.GT(params.MinBaseGasPrice.MulInt64(10)))
+		require.True(t, emaPrice.LT(aimdPrice))
+		require.True(t, params.MinBaseGasPrice.MulInt64(10).Mul(math.LegacyOneDec().Add(params.MaxLearningRate.QuoInt64(2))).Equal(emaPrice))
+	})
+
+	t.Run("holds the learning rate steady", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		params := types.DefaultAIMDParams()
+		params.Mode = types.Mode_MODE_EMA
+		params.Alpha = math.LegacyMustNewDecFromStr("0.5")
+		state.LearningRate = math.LegacyMustNewDecFromStr("0.1")
+
+		// An empty window raises the learning rate by alpha in AIMD mode.
+		require.True(t, math.LegacyMustNewDecFromStr("0.1").Equal(state.UpdateLearningRate(params)))
+
+		// The learning rate is still kept within its bounds.
+		state.LearningRate = params.MaxLearningRate.MulInt64(2)
+		require.True(t, params.MaxLearningRate.Equal(state.UpdateLearningRate(params)))
+	})
 }
 
 func TestState_UpdateLearningRate(t *testing.T) {
@@ -405,6 +550,7 @@ func TestState_UpdateLearningRate(t *testing.T) {
 
 		state.Window[0] = params.TargetBlockUtilization()
 
+		state.ReconcileWindowSum()
 		state.UpdateLearningRate(params)
 		expectedLearningRate := math.LegacyMustNewDecFromStr("0.125")
 		require.True(t, expectedLearningRate.Equal(state.LearningRate))
@@ -416,6 +562,7 @@ func TestState_UpdateLearningRate(t *testing.T) {
 
 		state.Window[0] = params.MaxBlockUtilization
 
+		state.ReconcileWindowSum()
 		state.UpdateLearningRate(params)
 		expectedLearningRate := math.LegacyMustNewDecFromStr("0.125")
 		require.True(t, expectedLearningRate.Equal(state.LearningRate))
@@ -427,6 +574,7 @@ func TestState_UpdateLearningRate(t *testing.T) {
 
 		state.Window[0] = 50000
 
+		state.ReconcileWindowSum()
 		state.UpdateLearningRate(params)
 		expectedLearningRate := math.LegacyMustNewDecFromStr("0.125")
 		require.True(t, expectedLearningRate.Equal(state.LearningRate))
@@ -438,6 +586,7 @@ func TestState_UpdateLearningRate(t *testing.T) {
 
 		state.Window[0] = params.TargetBlockUtilization() + 50000
 
+		state.ReconcileWindowSum()
 		state.UpdateLearningRate(params)
 		expectedLearningRate := math.LegacyMustNewDecFromStr("0.125")
 		require.True(t, expectedLearningRate.Equal(state.LearningRate))
@@ -450,6 +599,7 @@ func TestState_UpdateLearningRate(t *testing.T) {
 		randomValue := rand.Int63n(1000000000)
 		state.Window[0] = uint64(randomValue)
 
+		state.ReconcileWindowSum()
 		state.UpdateLearningRate(params)
 		expectedLearningRate := math.LegacyMustNewDecFromStr("0.125")
 		require.True(t, expectedLearningRate.Equal(state.LearningRate))
@@ -475,6 +625,7 @@ func TestState_UpdateLearningRate(t *testing.T) {
 			state.Window[i] = params.TargetBlockUtilization()
 		}
 
+		state.ReconcileWindowSum()
 		state.UpdateLearningRate(params)
 		expectedLearningRate := defaultLR.Mul(params.Beta)
 		require.True(t, expectedLearningRate.Equal(state.LearningRate))
@@ -491,6 +642,7 @@ func TestState_UpdateLearningRate(t *testing.T) {
 			state.Window[i] = params.MaxBlockUtilization
 		}
 
+		state.ReconcileWindowSum()
 		state.UpdateLearningRate(params)
 		expectedLearningRate := defaultLR.Add(params.Alpha)
 		require.True(t, expectedLearningRate.Equal(state.LearningRate))
@@ -511,6 +663,7 @@ func TestState_UpdateLearningRate(t *testing.T) {
 			}
 		}
 
+		state.ReconcileWindowSum()
 		state.UpdateLearningRate(params)
 		expectedLearningRate := defaultLR.Mul(params.Beta)
 		require.True(t, expectedLearningRate.Equal(state.LearningRate))
@@ -531,6 +684,7 @@ func TestState_UpdateLearningRate(t *testing.T) {
 			}
 		}
 
+		state.ReconcileWindowSum()
 		state.UpdateLearningRate(params)
 		expectedLearningRate := defaultLR.Add(params.Alpha)
 		require.True(t, expectedLearningRate.Equal(state.LearningRate))
@@ -553,6 +707,7 @@ func TestState_GetNetUtilization(t *testing.T) {
 
 		state.Window[0] = params.TargetBlockUtilization()
 
+		state.ReconcileWindowSum()
 		netUtilization := state.GetNetUtilization(params)
 		expectedUtilization := math.NewInt(0)
 		require.True(t, expectedUtilization.Equal(netUtilization))
@@ -564,6 +719,7 @@ func TestState_GetNetUtilization(t *testing.T) {
 
 		state.Window[0] = params.MaxBlockUtilization
 
+		state.ReconcileWindowSum()
 		netUtilization := state.GetNetUtilization(params)
 		expectedUtilization := math.NewIntFromUint64(params.MaxBlockUtilization - params.TargetBlockUtilization())
 		require.True(t, expectedUtilization.Equal(netUtilization))
@@ -588,6 +744,7 @@ func TestState_GetNetUtilization(t *testing.T) {
 			state.Window[i] = params.MaxBlockUtilization
 		}
 
+		state.ReconcileWindowSum()
 		netUtilization := state.GetNetUtilization(params)
 
 		multiple := math.NewIntFromUint64(uint64(len(state.Window)))
@@ -607,6 +764,7 @@ func TestState_GetNetUtilization(t *testing.T) {
 			}
 		}
 
+		state.ReconcileWindowSum()
 		netUtilization := state.GetNetUtilization(params)
 		expectedUtilization := math.ZeroInt()
 		require.True(t, expectedUtilization.Equal(netUtilization))
@@ -624,6 +782,7 @@ func TestState_GetNetUtilization(t *testing.T) {
 			}
 		}
 
+		state.ReconcileWindowSum()
 		netUtilization := state.GetNetUtilization(params)
 		first := math.NewIntFromUint64(params.MaxBlockUtilization).Mul(math.NewIntFromUint64(params.Window / 2))
 		second := math.NewIntFromUint64(params.TargetBlockUtilization()).Mul(math.NewIntFromUint64(params.Window / 2))
@@ -643,6 +802,7 @@ func TestState_GetNetUtilization(t *testing.T) {
 		state.Window[2] = 0
 		state.Window[3] = 50
 
+		state.ReconcileWindowSum()
 		netUtilization := state.GetNetUtilization(params)
 		expectedUtilization := math.NewIntFromUint64(50).Mul(math.NewInt(-1))
 		require.True(t, expectedUtilization.Equal(netUtilization))
@@ -660,12 +820,95 @@ func TestState_GetNetUtilization(t *testing.T) {
 		state.Window[2] = 50
 		state.Window[3] = 75
 
+		state.ReconcileWindowSum()
 		netUtilization := state.GetNetUtilization(params)
 		expectedUtilization := math.NewIntFromUint64(250).Mul(math.NewInt(-1))
 		require.True(t, expectedUtilization.Equal(netUtilization))
 	})
 }
 
+func TestState_WindowSum(t *testing.T) {
+	t.Run("incremental sum matches a full recompute", func(t *testing.T) {
+		params := types.DefaultAIMDParams()
+		params.Window = 64
+		state := types.NewState(params.Window, params.MinBaseGasPrice, params.MinLearningRate)
+
+		r := rand.New(rand.NewSource(1))
+		for block := 0; block < 500; block++ {
+			for tx := 0; tx < r.Intn(5); tx++ {
+				gas := uint64(r.Int63n(int64(params.MaxBlockUtilization / 5)))
+				require.NoError(t, state.Update(gas, params))
+			}
+
+			require.Equal(t, state.SumWindow(), state.WindowSum)
+			state.IncrementHeight()
+			require.Equal(t, state.SumWindow(), state.WindowSum)
+		}
+	})
+
+	t.Run("setting the current utilization keeps the sum in step", func(t *testing.T) {
+		params := types.DefaultAIMDParams()
+		state := types.DefaultAIMDState()
+
+		require.NoError(t, state.Update(100, params))
+		state.SetCurrentUtilization(40)
+		require.Equal(t, uint64(40), state.Window[state.Index])
+		require.Equal(t, state.SumWindow(), state.WindowSum)
+	})
+
+	t.Run("reconcile corrects drift", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		state.Window[1] = 100
+		state.WindowSum = 10
+
+		require.True(t, state.ReconcileWindowSum())
+		require.Equal(t, uint64(100), state.WindowSum)
+		require.False(t, state.ReconcileWindowSum())
+	})
+
+	t.Run("increment height does not underflow a drifted sum", func(t *testing.T) {
+		state := types.DefaultAIMDState()
+		state.Window[1] = 100
+
+		state.IncrementHeight()
+		require.Equal(t, uint64(0), state.WindowSum)
+		require.Equal(t, state.SumWindow(), state.WindowSum)
+	})
+}
+
+func TestState_ResizeWindow(t *testing.T) {
+	// blocks 1 to 4, with block 4 the current one
+	newState := func() types.State {
+		state := types.NewState(4, math.LegacyOneDec(), math.LegacyOneDec())
+		state.Window = []uint64{3, 4, 1, 2}
+		state.Index = 1
+		state.ReconcileWindowSum()
+		return state
+	}
+
+	t.Run("shrinking keeps the most recent blocks", func(t *testing.T) {
+		state := newState()
+		state.ResizeWindow(2)
+		require.Equal(t, []uint64{3, 4}, state.Window)
+		require.Equal(t, uint64(1), state.Index)
+		require.Equal(t, uint64(7), state.WindowSum)
+	})
+
+	t.Run("growing pads the oldest blocks", func(t *testing.T) {
+		state := newState()
+		state.ResizeWindow(6)
+		require.Equal(t, []uint64{0, 0, 1, 2, 3, 4}, state.Window)
+		require.Equal(t, uint64(5), state.Index)
+		require.Equal(t, uint64(10), state.WindowSum)
+	})
+
+	t.Run("the same size is left unchanged", func(t *testing.T) {
+		state := newState()
+		state.ResizeWindow(4)
+		require.Equal(t, newState(), state)
+	})
+}
+
 func TestState_GetAverageUtilization(t *testing.T) {
 	t.Run("empty block with default eip-1559", func(t *testing.T) {
 		state := types.DefaultState()
@@ -682,6 +925,7 @@ func TestState_GetAverageUtilization(t *testing.T) {
 
 		state.Window[0] = params.TargetBlockUtilization()
 
+		state.ReconcileWindowSum()
 		avgUtilization := state.GetAverageUtilization(params)
 		expectedUtilization := math.LegacyMustNewDecFromStr("0.5")
 		require.True(t, expectedUtilization.Equal(avgUtilization))
@@ -693,6 +937,7 @@ func TestState_GetAverageUtilization(t *testing.T) {
 
 		state.Window[0] = params.MaxBlockUtilization
 
+		state.ReconcileWindowSum()
 		avgUtilization := state.GetAverageUtilization(params)
 		expectedUtilization := math.LegacyMustNewDecFromStr("1.0")
 		require.True(t, expectedUtilization.Equal(avgUtilization))
@@ -715,6 +960,7 @@ func TestState_GetAverageUtilization(t *testing.T) {
 			state.Window[i] = params.TargetBlockUtilization()
 		}
 
+		state.ReconcileWindowSum()
 		avgUtilization := state.GetAverageUtilization(params)
 		expectedUtilization := math.LegacyMustNewDecFromStr("0.5")
 		require.True(t, expectedUtilization.Equal(avgUtilization))
@@ -728,6 +974,7 @@ func TestState_GetAverageUtilization(t *testing.T) {
 			state.Window[i] = params.MaxBlockUtilization
 		}
 
+		state.ReconcileWindowSum()
 		avgUtilization := state.GetAverageUtilization(params)
 		expectedUtilization := math.LegacyMustNewDecFromStr("1.0")
 		require.True(t, expectedUtilization.Equal(avgUtilization))
@@ -745,6 +992,7 @@ func TestState_GetAverageUtilization(t *testing.T) {
 			}
 		}
 
+		state.ReconcileWindowSum()
 		avgUtilization := state.GetAverageUtilization(params)
 		expectedUtilization := math.LegacyMustNewDecFromStr("0.5")
 		require.True(t, expectedUtilization.Equal(avgUtilization))
@@ -762,6 +1010,7 @@ func TestState_GetAverageUtilization(t *testing.T) {
 			}
 		}
 
+		state.ReconcileWindowSum()
 		avgUtilization := state.GetAverageUtilization(params)
 		expectedUtilization := math.LegacyMustNewDecFromStr("0.75")
 		require.True(t, expectedUtilization.Equal(avgUtilization))
@@ -779,6 +1028,7 @@ func TestState_GetAverageUtilization(t *testing.T) {
 		state.Window[2] = 0
 		state.Window[3] = 50
 
+		state.ReconcileWindowSum()
 		avgUtilization := state.GetAverageUtilization(params)
 		expectedUtilization := math.LegacyMustNewDecFromStr("0.4375")
 		require.True(t, expectedUtilization.Equal(avgUtilization))
@@ -797,6 +1047,7 @@ func TestState_GetAverageUtilization(t *testing.T) {
 		state.Window[2] = 50
 		state.Window[3] = 75
 
+		state.ReconcileWindowSum()
 		avgUtilization := state.GetAverageUtilization(params)
 		expectedUtilization := math.LegacyMustNewDecFromStr("0.1875")
 		require.True(t, expectedUtilization.Equal(avgUtilization))
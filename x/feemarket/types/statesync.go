@@ -0,0 +1,29 @@
+package types
+
+// StateSyncReconcileMode determines how ReconcileAfterStateSync reconciles the fee market
+// window when the current height does not directly follow the last synced height.
+type StateSyncReconcileMode uint8
+
+const (
+	// StateSyncReconcileNone leaves the window untouched.
+	StateSyncReconcileNone StateSyncReconcileMode = iota
+	// StateSyncReconcileClearWindow resets every entry in the window to zero.
+	StateSyncReconcileClearWindow
+	// StateSyncReconcileAdvanceWindow advances the window by the number of missed
+	// blocks, treating each of them as empty.
+	StateSyncReconcileAdvanceWindow
+)
+
+// String implements fmt.Stringer.
+func (m StateSyncReconcileMode) String() string {
+	switch m {
+	case StateSyncReconcileNone:
+		return "none"
+	case StateSyncReconcileClearWindow:
+		return "clear"
+	case StateSyncReconcileAdvanceWindow:
+		return "advance"
+	default:
+		return "unknown"
+	}
+}
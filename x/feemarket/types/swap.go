@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// SwapLeg is a single leg of a multi-leg cross-chain operation such as an atomic swap.
+type SwapLeg struct {
+	// Gas is the gas consumed by the leg's local tx.
+	Gas uint64
+	// Channel is the IBC channel the leg is relayed over. It is empty for a leg that
+	// is not relayed.
+	Channel string
+}
+
+// SwapFeeResult contains the fees of a multi-leg cross-chain operation, priced in a
+// single settlement denom.
+type SwapFeeResult struct {
+	// LocalFee is the dynamic fee for the combined gas of the local txs.
+	LocalFee sdk.Coin
+	// RelayFee is the combined estimated fee for relaying the IBC legs.
+	RelayFee sdk.Coin
+	// Total is the sum of the local and relay fees.
+	Total sdk.Coin
+}
+
+// RelayFeeEstimator estimates the fee for relaying a packet over an IBC channel, priced
+// in the given denom.
+type RelayFeeEstimator interface {
+	EstimateRelayFee(ctx sdk.Context, channel string, denom string) (sdk.Coin, error)
+}
@@ -0,0 +1,14 @@
+package types
+
+const (
+	// MetricKeyBaseGasPrice is the name of the gauge reporting the base gas price.
+	MetricKeyBaseGasPrice = "base_gas_price"
+	// MetricKeyLearningRate is the name of the gauge reporting the learning rate.
+	MetricKeyLearningRate = "learning_rate"
+	// MetricKeyBlockUtilization is the name of the gauge reporting the ratio of the gas
+	// consumed over the window to the maximum gas the window can hold.
+	MetricKeyBlockUtilization = "block_utilization"
+
+	// MetricLabelDenom is the label carrying the fee denom.
+	MetricLabelDenom = "denom"
+)
@@ -0,0 +1,34 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"go.opentelemetry.io/otel/trace"
+	"go.opentelemetry.io/otel/trace/noop"
+)
+
+const (
+	// SpanUpdateFeeMarket is the name of the span emitted by the EndBlock fee market update.
+	SpanUpdateFeeMarket = "feemarket.UpdateFeeMarket"
+	// SpanFeeCheck is the name of the span emitted by the ante handler fee check.
+	SpanFeeCheck = "feemarket.FeeCheck"
+
+	SpanAttributeKeyHeight             = "feemarket.height"
+	SpanAttributeKeyBaseGasPrice       = "feemarket.base_gas_price"
+	SpanAttributeKeyLearningRate       = "feemarket.learning_rate"
+	SpanAttributeKeyAverageUtilization = "feemarket.average_utilization"
+	SpanAttributeKeyMinGasPrice        = "feemarket.min_gas_price"
+	SpanAttributeKeyGasLimit           = "feemarket.gas_limit"
+	SpanAttributeKeyFee                = "feemarket.fee"
+	SpanAttributeKeyTxPriority         = "feemarket.priority"
+)
+
+// StartSpan starts a new span with the given name using the provided tracer. If no tracer is
+// configured, a no-op span is returned so that tracing adds no overhead when unused.
+func StartSpan(ctx sdk.Context, tracer trace.Tracer, name string) trace.Span {
+	if tracer == nil {
+		return noop.Span{}
+	}
+
+	_, span := tracer.Start(ctx.Context(), name)
+	return span
+}
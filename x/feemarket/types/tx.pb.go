@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
@@ -38,6 +39,11 @@ type MsgParams struct {
 	// Authority defines the authority that is updating the feemarket module
 	// parameters.
 	Authority string `protobuf:"bytes,2,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ResetState resets the state to a zeroed window of the new window length, the
+	// new min base gas price and the new min learning rate once the params are
+	// stored. If unset, the window and base gas price are kept, resized to the new
+	// window length.
+	ResetState bool `protobuf:"varint,3,opt,name=reset_state,json=resetState,proto3" json:"reset_state,omitempty"`
 }
 
 func (m *MsgParams) Reset()         { *m = MsgParams{} }
@@ -87,6 +93,13 @@ func (m *MsgParams) GetAuthority() string {
 	return ""
 }
 
+func (m *MsgParams) GetResetState() bool {
+	if m != nil {
+		return m.ResetState
+	}
+	return false
+}
+
 // MsgParamsResponse defines the Msg/Params response type.
 type MsgParamsResponse struct {
 }
@@ -124,35 +137,443 @@ func (m *MsgParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgParamsResponse proto.InternalMessageInfo
 
+// MsgResetCommunityPoolContributions defines the
+// Msg/ResetCommunityPoolContributions request type. It resets the cumulative
+// community pool contributions to zero as of the current height.
+type MsgResetCommunityPoolContributions struct {
+	// Authority defines the authority that is resetting the contributions.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+}
+
+func (m *MsgResetCommunityPoolContributions) Reset()         { *m = MsgResetCommunityPoolContributions{} }
+func (m *MsgResetCommunityPoolContributions) String() string { return proto.CompactTextString(m) }
+func (*MsgResetCommunityPoolContributions) ProtoMessage()    {}
+func (*MsgResetCommunityPoolContributions) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{2}
+}
+func (m *MsgResetCommunityPoolContributions) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCommunityPoolContributions) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCommunityPoolContributions.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetCommunityPoolContributions) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCommunityPoolContributions.Merge(m, src)
+}
+func (m *MsgResetCommunityPoolContributions) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCommunityPoolContributions) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCommunityPoolContributions.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCommunityPoolContributions proto.InternalMessageInfo
+
+func (m *MsgResetCommunityPoolContributions) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+// MsgResetCommunityPoolContributionsResponse defines the
+// Msg/ResetCommunityPoolContributions response type.
+type MsgResetCommunityPoolContributionsResponse struct {
+}
+
+func (m *MsgResetCommunityPoolContributionsResponse) Reset() {
+	*m = MsgResetCommunityPoolContributionsResponse{}
+}
+func (m *MsgResetCommunityPoolContributionsResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*MsgResetCommunityPoolContributionsResponse) ProtoMessage() {}
+func (*MsgResetCommunityPoolContributionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{3}
+}
+func (m *MsgResetCommunityPoolContributionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResetCommunityPoolContributionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResetCommunityPoolContributionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResetCommunityPoolContributionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResetCommunityPoolContributionsResponse.Merge(m, src)
+}
+func (m *MsgResetCommunityPoolContributionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResetCommunityPoolContributionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResetCommunityPoolContributionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResetCommunityPoolContributionsResponse proto.InternalMessageInfo
+
+// MsgSetResolver defines the Msg/SetResolver request type. It selects the denom
+// resolver the fee market converts fees with by the name it was registered
+// under.
+type MsgSetResolver struct {
+	// Authority defines the authority that is selecting the resolver.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Name is the name the denom resolver was registered under.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *MsgSetResolver) Reset()         { *m = MsgSetResolver{} }
+func (m *MsgSetResolver) String() string { return proto.CompactTextString(m) }
+func (*MsgSetResolver) ProtoMessage()    {}
+func (*MsgSetResolver) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{4}
+}
+func (m *MsgSetResolver) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetResolver) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetResolver.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetResolver) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetResolver.Merge(m, src)
+}
+func (m *MsgSetResolver) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetResolver) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetResolver.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetResolver proto.InternalMessageInfo
+
+func (m *MsgSetResolver) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetResolver) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// MsgSetResolverResponse defines the Msg/SetResolver response type.
+type MsgSetResolverResponse struct {
+}
+
+func (m *MsgSetResolverResponse) Reset()         { *m = MsgSetResolverResponse{} }
+func (m *MsgSetResolverResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetResolverResponse) ProtoMessage()    {}
+func (*MsgSetResolverResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{5}
+}
+func (m *MsgSetResolverResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetResolverResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetResolverResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetResolverResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetResolverResponse.Merge(m, src)
+}
+func (m *MsgSetResolverResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetResolverResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetResolverResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetResolverResponse proto.InternalMessageInfo
+
+// MsgSetMsgTypeMultiplier defines the Msg/SetMsgTypeMultiplier request type. It
+// sets the multiplier applied to the base gas price for the given message type.
+type MsgSetMsgTypeMultiplier struct {
+	// Authority defines the authority that is setting the multiplier.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// MsgTypeUrl is the type URL of the message type the multiplier applies to.
+	MsgTypeUrl string `protobuf:"bytes,2,opt,name=msg_type_url,json=msgTypeUrl,proto3" json:"msg_type_url,omitempty"`
+	// Multiplier is the non-negative multiplier applied to the base gas price
+	// for the message type. A multiplier of one removes it.
+	Multiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=multiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"multiplier"`
+}
+
+func (m *MsgSetMsgTypeMultiplier) Reset()         { *m = MsgSetMsgTypeMultiplier{} }
+func (m *MsgSetMsgTypeMultiplier) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMsgTypeMultiplier) ProtoMessage()    {}
+func (*MsgSetMsgTypeMultiplier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{6}
+}
+func (m *MsgSetMsgTypeMultiplier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMsgTypeMultiplier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMsgTypeMultiplier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMsgTypeMultiplier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMsgTypeMultiplier.Merge(m, src)
+}
+func (m *MsgSetMsgTypeMultiplier) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMsgTypeMultiplier) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMsgTypeMultiplier.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMsgTypeMultiplier proto.InternalMessageInfo
+
+func (m *MsgSetMsgTypeMultiplier) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetMsgTypeMultiplier) GetMsgTypeUrl() string {
+	if m != nil {
+		return m.MsgTypeUrl
+	}
+	return ""
+}
+
+// MsgSetMsgTypeMultiplierResponse defines the Msg/SetMsgTypeMultiplier response
+// type.
+type MsgSetMsgTypeMultiplierResponse struct {
+}
+
+func (m *MsgSetMsgTypeMultiplierResponse) Reset()         { *m = MsgSetMsgTypeMultiplierResponse{} }
+func (m *MsgSetMsgTypeMultiplierResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMsgTypeMultiplierResponse) ProtoMessage()    {}
+func (*MsgSetMsgTypeMultiplierResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{7}
+}
+func (m *MsgSetMsgTypeMultiplierResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMsgTypeMultiplierResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMsgTypeMultiplierResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMsgTypeMultiplierResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMsgTypeMultiplierResponse.Merge(m, src)
+}
+func (m *MsgSetMsgTypeMultiplierResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMsgTypeMultiplierResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMsgTypeMultiplierResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMsgTypeMultiplierResponse proto.InternalMessageInfo
+
+// MsgSetDenomMinGasPrice defines the Msg/SetDenomMinGasPrice request type. It
+// sets the minimum gas price enforced for the given denom on top of the
+// market-derived price.
+type MsgSetDenomMinGasPrice struct {
+	// Authority defines the authority that is setting the minimum gas price.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Denom is the denom the minimum gas price applies to.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// MinGasPrice is the non-negative minimum gas price of the denom. A zero
+	// price removes it.
+	MinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=min_gas_price,json=minGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_gas_price"`
+}
+
+func (m *MsgSetDenomMinGasPrice) Reset()         { *m = MsgSetDenomMinGasPrice{} }
+func (m *MsgSetDenomMinGasPrice) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomMinGasPrice) ProtoMessage()    {}
+func (*MsgSetDenomMinGasPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{8}
+}
+func (m *MsgSetDenomMinGasPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomMinGasPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomMinGasPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomMinGasPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomMinGasPrice.Merge(m, src)
+}
+func (m *MsgSetDenomMinGasPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomMinGasPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomMinGasPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomMinGasPrice proto.InternalMessageInfo
+
+func (m *MsgSetDenomMinGasPrice) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetDenomMinGasPrice) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgSetDenomMinGasPriceResponse defines the Msg/SetDenomMinGasPrice response
+// type.
+type MsgSetDenomMinGasPriceResponse struct {
+}
+
+func (m *MsgSetDenomMinGasPriceResponse) Reset()         { *m = MsgSetDenomMinGasPriceResponse{} }
+func (m *MsgSetDenomMinGasPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetDenomMinGasPriceResponse) ProtoMessage()    {}
+func (*MsgSetDenomMinGasPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1bbf67a633e47917, []int{9}
+}
+func (m *MsgSetDenomMinGasPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetDenomMinGasPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetDenomMinGasPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetDenomMinGasPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetDenomMinGasPriceResponse.Merge(m, src)
+}
+func (m *MsgSetDenomMinGasPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetDenomMinGasPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetDenomMinGasPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetDenomMinGasPriceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgParams)(nil), "feemarket.feemarket.v1.MsgParams")
 	proto.RegisterType((*MsgParamsResponse)(nil), "feemarket.feemarket.v1.MsgParamsResponse")
+	proto.RegisterType((*MsgResetCommunityPoolContributions)(nil), "feemarket.feemarket.v1.MsgResetCommunityPoolContributions")
+	proto.RegisterType((*MsgResetCommunityPoolContributionsResponse)(nil), "feemarket.feemarket.v1.MsgResetCommunityPoolContributionsResponse")
+	proto.RegisterType((*MsgSetResolver)(nil), "feemarket.feemarket.v1.MsgSetResolver")
+	proto.RegisterType((*MsgSetResolverResponse)(nil), "feemarket.feemarket.v1.MsgSetResolverResponse")
+	proto.RegisterType((*MsgSetMsgTypeMultiplier)(nil), "feemarket.feemarket.v1.MsgSetMsgTypeMultiplier")
+	proto.RegisterType((*MsgSetMsgTypeMultiplierResponse)(nil), "feemarket.feemarket.v1.MsgSetMsgTypeMultiplierResponse")
+	proto.RegisterType((*MsgSetDenomMinGasPrice)(nil), "feemarket.feemarket.v1.MsgSetDenomMinGasPrice")
+	proto.RegisterType((*MsgSetDenomMinGasPriceResponse)(nil), "feemarket.feemarket.v1.MsgSetDenomMinGasPriceResponse")
 }
 
 func init() { proto.RegisterFile("feemarket/feemarket/v1/tx.proto", fileDescriptor_1bbf67a633e47917) }
 
 var fileDescriptor_1bbf67a633e47917 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4f, 0x4b, 0x4d, 0xcd,
-	0x4d, 0x2c, 0xca, 0x4e, 0x2d, 0xd1, 0x47, 0xb0, 0xca, 0x0c, 0xf5, 0x4b, 0x2a, 0xf4, 0x0a, 0x8a,
-	0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0xe0, 0xc2, 0x7a, 0x08, 0x56, 0x99, 0xa1, 0x94, 0x32, 0x0e, 0x8d,
-	0x05, 0x89, 0x45, 0x89, 0xb9, 0xc5, 0x10, 0xcd, 0x52, 0x92, 0xc9, 0xf9, 0xc5, 0xb9, 0xf9, 0xc5,
-	0xf1, 0x60, 0x9e, 0x3e, 0x84, 0x03, 0x95, 0x12, 0x87, 0xf0, 0xf4, 0x73, 0x8b, 0xd3, 0x41, 0xda,
-	0x72, 0x8b, 0xd3, 0xa1, 0x12, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x10, 0x0d, 0x20, 0x16, 0x44, 0x54,
-	0x69, 0x22, 0x23, 0x17, 0xa7, 0x6f, 0x71, 0x7a, 0x00, 0xd8, 0x74, 0x21, 0x1b, 0x2e, 0x36, 0x88,
-	0x3d, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0xdc, 0x46, 0x72, 0x7a, 0xd8, 0x5d, 0xa9, 0x07, 0x51, 0xef,
-	0xc4, 0x72, 0xe2, 0x9e, 0x3c, 0x43, 0x10, 0x54, 0x8f, 0x90, 0x19, 0x17, 0x67, 0x62, 0x69, 0x49,
-	0x46, 0x7e, 0x51, 0x66, 0x49, 0xa5, 0x04, 0x93, 0x02, 0xa3, 0x06, 0xa7, 0x93, 0xc4, 0xa5, 0x2d,
-	0xba, 0x22, 0x50, 0xf7, 0x39, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x07, 0x97, 0x14, 0x65, 0xe6,
-	0xa5, 0x07, 0x21, 0x94, 0x5a, 0xf1, 0x35, 0x3d, 0xdf, 0xa0, 0x85, 0xe0, 0x2b, 0x09, 0x73, 0x09,
-	0xc2, 0x9d, 0x14, 0x94, 0x5a, 0x5c, 0x90, 0x9f, 0x57, 0x9c, 0x6a, 0x94, 0xc2, 0xc5, 0xec, 0x5b,
-	0x9c, 0x2e, 0x14, 0xc6, 0xc5, 0x06, 0x75, 0xab, 0x22, 0x2e, 0xb7, 0xc1, 0xf5, 0x4a, 0x69, 0x12,
-	0x54, 0x02, 0x33, 0x5e, 0x8a, 0xb5, 0xe1, 0xf9, 0x06, 0x2d, 0x46, 0x27, 0xcf, 0x13, 0x8f, 0xe4,
-	0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f,
-	0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0xd2, 0x4f, 0xcf, 0x2c, 0xc9, 0x28, 0x4d, 0xd2, 0x4b,
-	0xce, 0xcf, 0xd5, 0x2f, 0xce, 0xce, 0x2c, 0xd0, 0xcd, 0x4d, 0x2d, 0x43, 0x8a, 0xa1, 0x0a, 0x24,
-	0x76, 0x49, 0x65, 0x41, 0x6a, 0x71, 0x12, 0x1b, 0x38, 0x80, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff,
-	0xff, 0xac, 0xa3, 0x3e, 0x01, 0x0a, 0x02, 0x00, 0x00,
+	// 653 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x4b, 0x1b, 0x4f,
+	0x18, 0xcf, 0xfc, 0x7d, 0xe1, 0x9f, 0x27, 0xad, 0xd0, 0x35, 0x68, 0xba, 0x85, 0x4d, 0x4c, 0xa1,
+	0x58, 0xa9, 0xbb, 0x68, 0xc1, 0x82, 0xf4, 0xd2, 0x28, 0x94, 0x42, 0x17, 0xec, 0x5a, 0x7b, 0xe8,
+	0x25, 0xac, 0x9b, 0xe9, 0x38, 0xb8, 0xb3, 0xb3, 0xec, 0x4c, 0x82, 0x39, 0x14, 0xa4, 0x9f, 0xa0,
+	0x5f, 0xa1, 0xdf, 0x40, 0x8a, 0x1f, 0xc2, 0x4b, 0x41, 0x3c, 0x94, 0xd2, 0x83, 0x14, 0x3d, 0x78,
+	0xee, 0x37, 0x28, 0x9b, 0xd9, 0x6c, 0xb6, 0x26, 0x1a, 0x4d, 0x6f, 0xcf, 0x3c, 0xf3, 0xfc, 0xde,
+	0x36, 0xfb, 0x64, 0xa1, 0xfc, 0x01, 0x63, 0xe6, 0x46, 0xbb, 0x58, 0x5a, 0xbd, 0xaa, 0xb5, 0x64,
+	0xc9, 0x3d, 0x33, 0x8c, 0xb8, 0xe4, 0xda, 0x4c, 0xda, 0x36, 0x7b, 0x55, 0x6b, 0x49, 0x7f, 0x78,
+	0x05, 0x30, 0x74, 0x23, 0x97, 0x09, 0x05, 0xd6, 0xef, 0x7b, 0x5c, 0x30, 0x2e, 0xea, 0x9d, 0x93,
+	0xa5, 0x0e, 0xc9, 0xd5, 0xac, 0x3a, 0x59, 0x4c, 0x90, 0x18, 0xc6, 0x04, 0x49, 0x2e, 0x8a, 0x84,
+	0x13, 0xae, 0x00, 0x71, 0xa5, 0xba, 0xd5, 0xaf, 0x08, 0xf2, 0xb6, 0x20, 0x1b, 0x1d, 0x76, 0xed,
+	0x39, 0x4c, 0x2a, 0x9d, 0x12, 0xaa, 0xa0, 0xf9, 0xc2, 0xb2, 0x61, 0x0e, 0x76, 0x69, 0xaa, 0xf9,
+	0xda, 0xf8, 0xd1, 0x69, 0x39, 0xe7, 0x24, 0x18, 0x6d, 0x05, 0xf2, 0x6e, 0x53, 0xee, 0xf0, 0x88,
+	0xca, 0x76, 0xe9, 0xbf, 0x0a, 0x9a, 0xcf, 0xd7, 0x4a, 0x27, 0x87, 0x8b, 0xc5, 0xc4, 0xdf, 0x8b,
+	0x46, 0x23, 0xc2, 0x42, 0x6c, 0xca, 0x88, 0x06, 0xc4, 0xe9, 0x8d, 0x6a, 0x65, 0x28, 0x44, 0x58,
+	0x60, 0x59, 0x17, 0xd2, 0x95, 0xb8, 0x34, 0x56, 0x41, 0xf3, 0xff, 0x3b, 0xd0, 0x69, 0x6d, 0xc6,
+	0x9d, 0xd5, 0xa9, 0x4f, 0x17, 0x07, 0x0b, 0x3d, 0x40, 0x75, 0x1a, 0xee, 0xa5, 0x9e, 0x1d, 0x2c,
+	0x42, 0x1e, 0x08, 0x5c, 0xf5, 0xa1, 0x6a, 0x0b, 0xe2, 0xc4, 0xa8, 0x35, 0xce, 0x58, 0x33, 0xa0,
+	0xb2, 0xbd, 0xc1, 0xb9, 0xbf, 0xc6, 0x03, 0x19, 0xd1, 0xed, 0xa6, 0xa4, 0x3c, 0xb8, 0xe4, 0x11,
+	0xdd, 0xd8, 0x63, 0x9f, 0x85, 0x27, 0xb0, 0x30, 0x5c, 0x2d, 0xe3, 0x6d, 0xca, 0x16, 0x64, 0x13,
+	0x4b, 0x07, 0x0b, 0xee, 0xb7, 0x70, 0x34, 0xaa, 0x0f, 0x4d, 0x83, 0xf1, 0xc0, 0x65, 0x58, 0x3d,
+	0x5e, 0xa7, 0x53, 0xf7, 0x79, 0x2b, 0xc1, 0xcc, 0xdf, 0x6a, 0xa9, 0x8f, 0xef, 0x08, 0x66, 0xd5,
+	0x95, 0x2d, 0xc8, 0xdb, 0x76, 0x88, 0xed, 0xa6, 0x2f, 0x69, 0xe8, 0xd3, 0x7f, 0x70, 0x54, 0x81,
+	0x3b, 0x4c, 0x90, 0xba, 0x6c, 0x87, 0xb8, 0xde, 0x8c, 0xfc, 0xc4, 0x19, 0x30, 0x25, 0xb0, 0x15,
+	0xf9, 0xda, 0x1b, 0x00, 0x96, 0xea, 0x74, 0x7e, 0xde, 0x7c, 0x6d, 0x29, 0x7e, 0x73, 0x7e, 0x9e,
+	0x96, 0x1f, 0x28, 0x7a, 0xd1, 0xd8, 0x35, 0x29, 0xb7, 0x98, 0x2b, 0x77, 0xcc, 0xd7, 0x98, 0xb8,
+	0x5e, 0x7b, 0x1d, 0x7b, 0x27, 0x87, 0x8b, 0x90, 0xa8, 0xaf, 0x63, 0xcf, 0xc9, 0x90, 0xf4, 0x45,
+	0x9e, 0x83, 0xf2, 0x15, 0xb9, 0xd2, 0xec, 0xdf, 0x50, 0xf7, 0xb1, 0xac, 0xe3, 0x80, 0x33, 0x9b,
+	0x06, 0x2f, 0x5d, 0xb1, 0x11, 0x51, 0x0f, 0x8f, 0x1c, 0xbd, 0x08, 0x13, 0x8d, 0x98, 0x2b, 0xc9,
+	0xac, 0x0e, 0xda, 0x16, 0xdc, 0x65, 0x34, 0xa8, 0x13, 0x37, 0xde, 0x4f, 0xea, 0xe1, 0xd1, 0x13,
+	0x17, 0x58, 0xcf, 0x64, 0x5f, 0xe4, 0x0a, 0x18, 0x83, 0xe3, 0x74, 0x13, 0x2f, 0xff, 0x1e, 0x87,
+	0x31, 0x5b, 0x10, 0xed, 0x1d, 0x4c, 0x26, 0xfb, 0x3d, 0x77, 0xd5, 0x3e, 0xa7, 0xeb, 0xa4, 0x3f,
+	0x1e, 0x3a, 0xd2, 0xe5, 0xd7, 0xbe, 0x20, 0x28, 0x0f, 0xdb, 0xb7, 0xd5, 0x6b, 0xe8, 0x86, 0x60,
+	0xf5, 0xda, 0xe8, 0xd8, 0xd4, 0x23, 0x86, 0x42, 0x76, 0xed, 0x1e, 0x5d, 0x43, 0x99, 0x99, 0xd3,
+	0xcd, 0x9b, 0xcd, 0xa5, 0x32, 0xfb, 0x08, 0x8a, 0x03, 0xb7, 0xca, 0xba, 0x9e, 0xa8, 0x0f, 0xa0,
+	0x3f, 0xbb, 0x25, 0x20, 0xb5, 0xf0, 0x11, 0xa6, 0x07, 0xbd, 0xdb, 0x43, 0x92, 0x5c, 0x9e, 0xd7,
+	0x57, 0x6e, 0x37, 0xdf, 0x95, 0xd7, 0x27, 0xf6, 0x2f, 0x0e, 0x16, 0x50, 0xed, 0xd5, 0xd1, 0x99,
+	0x81, 0x8e, 0xcf, 0x0c, 0xf4, 0xeb, 0xcc, 0x40, 0x9f, 0xcf, 0x8d, 0xdc, 0xf1, 0xb9, 0x91, 0xfb,
+	0x71, 0x6e, 0xe4, 0xde, 0x5b, 0x84, 0xca, 0x9d, 0xe6, 0xb6, 0xe9, 0x71, 0x66, 0x89, 0x5d, 0x1a,
+	0x2e, 0x32, 0xdc, 0xca, 0x7c, 0xe2, 0xf6, 0x32, 0x75, 0xfc, 0x5f, 0x22, 0xb6, 0x27, 0x3b, 0x5f,
+	0xa8, 0xa7, 0x7f, 0x06, 0x00, 0x49, 0x81, 0xc1, 0xc7, 0x4b, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -169,6 +590,18 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// Params defines a method for updating the feemarket module parameters.
 	Params(ctx context.Context, in *MsgParams, opts ...grpc.CallOption) (*MsgParamsResponse, error)
+	// ResetCommunityPoolContributions defines a method for resetting the
+	// cumulative community pool contributions.
+	ResetCommunityPoolContributions(ctx context.Context, in *MsgResetCommunityPoolContributions, opts ...grpc.CallOption) (*MsgResetCommunityPoolContributionsResponse, error)
+	// SetResolver defines a method for selecting the denom resolver by the name
+	// it was registered under.
+	SetResolver(ctx context.Context, in *MsgSetResolver, opts ...grpc.CallOption) (*MsgSetResolverResponse, error)
+	// SetMsgTypeMultiplier defines a method for setting the gas price multiplier
+	// applied to a message type.
+	SetMsgTypeMultiplier(ctx context.Context, in *MsgSetMsgTypeMultiplier, opts ...grpc.CallOption) (*MsgSetMsgTypeMultiplierResponse, error)
+	// SetDenomMinGasPrice defines a method for setting the minimum gas price
+	// enforced for a denom.
+	SetDenomMinGasPrice(ctx context.Context, in *MsgSetDenomMinGasPrice, opts ...grpc.CallOption) (*MsgSetDenomMinGasPriceResponse, error)
 }
 
 type msgClient struct {
@@ -188,10 +621,58 @@ func (c *msgClient) Params(ctx context.Context, in *MsgParams, opts ...grpc.Call
 	return out, nil
 }
 
+func (c *msgClient) ResetCommunityPoolContributions(ctx context.Context, in *MsgResetCommunityPoolContributions, opts ...grpc.CallOption) (*MsgResetCommunityPoolContributionsResponse, error) {
+	out := new(MsgResetCommunityPoolContributionsResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/ResetCommunityPoolContributions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetResolver(ctx context.Context, in *MsgSetResolver, opts ...grpc.CallOption) (*MsgSetResolverResponse, error) {
+	out := new(MsgSetResolverResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/SetResolver", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetMsgTypeMultiplier(ctx context.Context, in *MsgSetMsgTypeMultiplier, opts ...grpc.CallOption) (*MsgSetMsgTypeMultiplierResponse, error) {
+	out := new(MsgSetMsgTypeMultiplierResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/SetMsgTypeMultiplier", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetDenomMinGasPrice(ctx context.Context, in *MsgSetDenomMinGasPrice, opts ...grpc.CallOption) (*MsgSetDenomMinGasPriceResponse, error) {
+	out := new(MsgSetDenomMinGasPriceResponse)
+	err := c.cc.Invoke(ctx, "/feemarket.feemarket.v1.Msg/SetDenomMinGasPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Params defines a method for updating the feemarket module parameters.
 	Params(context.Context, *MsgParams) (*MsgParamsResponse, error)
+	// ResetCommunityPoolContributions defines a method for resetting the
+	// cumulative community pool contributions.
+	ResetCommunityPoolContributions(context.Context, *MsgResetCommunityPoolContributions) (*MsgResetCommunityPoolContributionsResponse, error)
+	// SetResolver defines a method for selecting the denom resolver by the name
+	// it was registered under.
+	SetResolver(context.Context, *MsgSetResolver) (*MsgSetResolverResponse, error)
+	// SetMsgTypeMultiplier defines a method for setting the gas price multiplier
+	// applied to a message type.
+	SetMsgTypeMultiplier(context.Context, *MsgSetMsgTypeMultiplier) (*MsgSetMsgTypeMultiplierResponse, error)
+	// SetDenomMinGasPrice defines a method for setting the minimum gas price
+	// enforced for a denom.
+	SetDenomMinGasPrice(context.Context, *MsgSetDenomMinGasPrice) (*MsgSetDenomMinGasPriceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -201,6 +682,18 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) Params(ctx context.Context, req *MsgParams) (*MsgParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedMsgServer) ResetCommunityPoolContributions(ctx context.Context, req *MsgResetCommunityPoolContributions) (*MsgResetCommunityPoolContributionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetCommunityPoolContributions not implemented")
+}
+func (*UnimplementedMsgServer) SetResolver(ctx context.Context, req *MsgSetResolver) (*MsgSetResolverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetResolver not implemented")
+}
+func (*UnimplementedMsgServer) SetMsgTypeMultiplier(ctx context.Context, req *MsgSetMsgTypeMultiplier) (*MsgSetMsgTypeMultiplierResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMsgTypeMultiplier not implemented")
+}
+func (*UnimplementedMsgServer) SetDenomMinGasPrice(ctx context.Context, req *MsgSetDenomMinGasPrice) (*MsgSetDenomMinGasPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDenomMinGasPrice not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -224,21 +717,110 @@ func _Msg_Params_Handler(srv interface{}, ctx context.Context, dec func(interfac
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "feemarket.feemarket.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Msg_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "feemarket/feemarket/v1/tx.proto",
-}
-
-func (m *MsgParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+func _Msg_ResetCommunityPoolContributions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResetCommunityPoolContributions)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResetCommunityPoolContributions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/ResetCommunityPoolContributions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResetCommunityPoolContributions(ctx, req.(*MsgResetCommunityPoolContributions))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetResolver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetResolver)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetResolver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/SetResolver",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetResolver(ctx, req.(*MsgSetResolver))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetMsgTypeMultiplier_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMsgTypeMultiplier)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMsgTypeMultiplier(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/SetMsgTypeMultiplier",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMsgTypeMultiplier(ctx, req.(*MsgSetMsgTypeMultiplier))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetDenomMinGasPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetDenomMinGasPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetDenomMinGasPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/feemarket.feemarket.v1.Msg/SetDenomMinGasPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetDenomMinGasPrice(ctx, req.(*MsgSetDenomMinGasPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "feemarket.feemarket.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Msg_Params_Handler,
+		},
+		{
+			MethodName: "ResetCommunityPoolContributions",
+			Handler:    _Msg_ResetCommunityPoolContributions_Handler,
+		},
+		{
+			MethodName: "SetResolver",
+			Handler:    _Msg_SetResolver_Handler,
+		},
+		{
+			MethodName: "SetMsgTypeMultiplier",
+			Handler:    _Msg_SetMsgTypeMultiplier_Handler,
+		},
+		{
+			MethodName: "SetDenomMinGasPrice",
+			Handler:    _Msg_SetDenomMinGasPrice_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "feemarket/feemarket/v1/tx.proto",
+}
+
+func (m *MsgParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -257,6 +839,16 @@ func (m *MsgParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ResetState {
+		i--
+		if m.ResetState {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -269,79 +861,1118 @@ func (m *MsgParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		if err != nil {
 			return 0, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCommunityPoolContributions) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCommunityPoolContributions) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCommunityPoolContributions) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResetCommunityPoolContributionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResetCommunityPoolContributionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResetCommunityPoolContributionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetResolver) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetResolver) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetResolver) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetResolverResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetResolverResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetResolverResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMsgTypeMultiplier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMsgTypeMultiplier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMsgTypeMultiplier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Multiplier.Size()
+		i -= size
+		if _, err := m.Multiplier.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MsgTypeUrl) > 0 {
+		i -= len(m.MsgTypeUrl)
+		copy(dAtA[i:], m.MsgTypeUrl)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MsgTypeUrl)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMsgTypeMultiplierResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMsgTypeMultiplierResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMsgTypeMultiplierResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMinGasPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMinGasPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMinGasPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MinGasPrice.Size()
+		i -= size
+		if _, err := m.MinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetDenomMinGasPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetDenomMinGasPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetDenomMinGasPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ResetState {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResetCommunityPoolContributions) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResetCommunityPoolContributionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetResolver) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetResolverResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetMsgTypeMultiplier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MsgTypeUrl)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Multiplier.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMsgTypeMultiplierResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetDenomMinGasPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MinGasPrice.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetDenomMinGasPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResetState", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ResetState = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetCommunityPoolContributions) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetCommunityPoolContributions: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetCommunityPoolContributions: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResetCommunityPoolContributionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResetCommunityPoolContributionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResetCommunityPoolContributionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetResolver) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetResolver: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetResolver: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetResolverResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetResolverResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetResolverResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMsgTypeMultiplier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMsgTypeMultiplier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMsgTypeMultiplier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MsgTypeUrl", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MsgTypeUrl = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Multiplier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Multiplier.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
 
-func (m *MsgParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *MsgParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgSetMsgTypeMultiplierResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMsgTypeMultiplierResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMsgTypeMultiplierResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMinGasPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -364,17 +1995,17 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMinGasPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMinGasPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -384,28 +2015,27 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -433,7 +2063,41 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -456,7 +2120,7 @@ func (m *MsgParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetDenomMinGasPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -479,10 +2143,10 @@ func (m *MsgParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetDenomMinGasPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetDenomMinGasPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// UpgradePlanFragment is the fee market configuration to embed in the info of an upgrade
+// plan, so that a chain can coordinate the activation of the fee market in an upgrade.
+type UpgradePlanFragment struct {
+	Params        Params `json:"params"`
+	EnabledHeight int64  `json:"enabled_height"`
+}
+
+// upgradePlanInfo is the upgrade plan info that the fragment is embedded in, keyed by the
+// module name so that it can be combined with the fragments of other modules.
+type upgradePlanInfo struct {
+	FeeMarket *UpgradePlanFragment `json:"feemarket"`
+}
+
+// NewUpgradePlanFragment returns a validated upgrade plan fragment for the given params and
+// the height at which the fee market is enabled.
+func NewUpgradePlanFragment(params Params, enabledHeight int64) (UpgradePlanFragment, error) {
+	fragment := UpgradePlanFragment{
+		Params:        params,
+		EnabledHeight: enabledHeight,
+	}
+
+	if err := fragment.Validate(); err != nil {
+		return UpgradePlanFragment{}, err
+	}
+
+	return fragment, nil
+}
+
+// Validate performs basic validation of the fragment. The params must be valid, and the
+// enabled height must be positive if the fee market is enabled and -1 otherwise.
+func (f UpgradePlanFragment) Validate() error {
+	if err := f.Params.ValidateBasic(); err != nil {
+		return fmt.Errorf("invalid params: %w", err)
+	}
+
+	if f.Params.Enabled && f.EnabledHeight <= 0 {
+		return fmt.Errorf("enabled height must be positive if the fee market is enabled; got %d", f.EnabledHeight)
+	}
+
+	if !f.Params.Enabled && f.EnabledHeight != -1 {
+		return fmt.Errorf("enabled height must be -1 if the fee market is disabled; got %d", f.EnabledHeight)
+	}
+
+	return nil
+}
+
+// PlanInfo returns the fragment encoded as the JSON info of an upgrade plan, with the
+// fragment under the "feemarket" key.
+func (f UpgradePlanFragment) PlanInfo() (string, error) {
+	bz, err := json.Marshal(upgradePlanInfo{FeeMarket: &f})
+	if err != nil {
+		return "", err
+	}
+
+	return string(bz), nil
+}
+
+// ParseUpgradePlanFragment returns the validated fragment from the JSON info of an upgrade
+// plan. An error is returned if the info holds no fee market fragment.
+func ParseUpgradePlanFragment(info string) (UpgradePlanFragment, error) {
+	var planInfo upgradePlanInfo
+	if err := json.Unmarshal([]byte(info), &planInfo); err != nil {
+		return UpgradePlanFragment{}, fmt.Errorf("invalid upgrade plan info: %w", err)
+	}
+
+	if planInfo.FeeMarket == nil {
+		return UpgradePlanFragment{}, fmt.Errorf("upgrade plan info has no %s fragment", ModuleName)
+	}
+
+	if err := planInfo.FeeMarket.Validate(); err != nil {
+		return UpgradePlanFragment{}, err
+	}
+
+	return *planInfo.FeeMarket, nil
+}
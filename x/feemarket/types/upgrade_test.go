@@ -0,0 +1,63 @@
+package types_test
+
+import (
+	"testing"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/skip-mev/feemarket/x/feemarket/types"
+)
+
+func TestUpgradePlanFragment(t *testing.T) {
+	t.Run("round-trips through an upgrade plan", func(t *testing.T) {
+		params := types.DefaultAIMDParams()
+
+		fragment, err := types.NewUpgradePlanFragment(params, 1_000)
+		require.NoError(t, err)
+
+		info, err := fragment.PlanInfo()
+		require.NoError(t, err)
+
+		plan := upgradetypes.Plan{Name: "v2", Height: 1_000, Info: info}
+		require.NoError(t, plan.ValidateBasic())
+
+		bz, err := plan.Marshal()
+		require.NoError(t, err)
+		var decoded upgradetypes.Plan
+		require.NoError(t, decoded.Unmarshal(bz))
+
+		got, err := types.ParseUpgradePlanFragment(decoded.Info)
+		require.NoError(t, err)
+		require.Equal(t, fragment, got)
+	})
+
+	t.Run("a disabled fee market has no enabled height", func(t *testing.T) {
+		params := types.DefaultParams()
+		params.Enabled = false
+
+		_, err := types.NewUpgradePlanFragment(params, -1)
+		require.NoError(t, err)
+
+		_, err = types.NewUpgradePlanFragment(params, 1_000)
+		require.Error(t, err)
+	})
+
+	t.Run("rejects invalid fragments", func(t *testing.T) {
+		_, err := types.NewUpgradePlanFragment(types.DefaultParams(), 0)
+		require.Error(t, err)
+
+		params := types.DefaultParams()
+		params.Window = 0
+		_, err = types.NewUpgradePlanFragment(params, 1_000)
+		require.Error(t, err)
+	})
+
+	t.Run("rejects plan info without a fragment", func(t *testing.T) {
+		_, err := types.ParseUpgradePlanFragment(`{"other": {}}`)
+		require.Error(t, err)
+
+		_, err = types.ParseUpgradePlanFragment("not json")
+		require.Error(t, err)
+	})
+}